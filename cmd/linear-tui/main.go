@@ -1,13 +1,19 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"syscall"
 
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/cache"
+	"github.com/roeyazroel/linear-tui/internal/cli"
 	"github.com/roeyazroel/linear-tui/internal/config"
 	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/live"
 	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/outbox"
+	"github.com/roeyazroel/linear-tui/internal/store"
 	"github.com/roeyazroel/linear-tui/internal/tui"
 )
 
@@ -18,28 +24,14 @@ func main() {
 		os.Exit(0)
 	}
 
-	// Load configuration from settings file + API key
-	settingsPath, err := config.ConfigFilePath()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error determining settings path: %v\n", err)
-		os.Exit(1)
+	args := os.Args[1:]
+	if cli.IsHelp(args) {
+		cli.Usage(os.Stdout)
+		os.Exit(cli.ExitOK)
 	}
 
-	settings, err := config.EnsureSettingsFile(settingsPath)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading settings file: %v\n", err)
-		os.Exit(1)
-	}
-
-	apiKey := os.Getenv(config.LinearAPIKeyEnv)
-	cfg, err := config.ConfigFromSettings(apiKey, settings)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
-		if apiKey == "" {
-			fmt.Fprintf(os.Stderr, "Please set the %s environment variable.\n", config.LinearAPIKeyEnv)
-		}
-		os.Exit(1)
-	}
+	// Load configuration from settings file + API key
+	cfg := loadConfig()
 
 	// Initialize logger
 	logLevel := parseLogLevel(cfg.LogLevel)
@@ -64,6 +56,23 @@ func main() {
 		Timeout:  cfg.Timeout,
 	})
 
+	// Run headless subcommands (issue list, comment add, ...) without starting the TUI
+	if cli.IsCommand(args) {
+		runner := &cli.Runner{
+			Client:   apiClient,
+			Metadata: cache.NewTeamCache(apiClient, cfg.CacheTTL),
+			Stdin:    os.Stdin,
+			Stdout:   os.Stdout,
+			Stderr:   os.Stderr,
+			PageSize: cfg.PageSize,
+		}
+		code := runner.Run(context.Background(), args)
+		if err := logger.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error closing logger: %v\n", err)
+		}
+		os.Exit(code) //nolint:gocritic // logger closed explicitly above
+	}
+
 	promptTemplates := config.DefaultAgentPromptTemplates()
 	promptsPath, err := config.PromptTemplatesFilePath()
 	if err != nil {
@@ -80,6 +89,51 @@ func main() {
 	// Create and run tview application
 	app := tui.NewApp(apiClient, cfg, promptTemplates)
 
+	// Attach the offline issue store so the UI starts with last-known data
+	storePath, err := store.IssueStoreFilePath()
+	if err != nil {
+		logger.Warning("app.main: failed to resolve issue store path: %v", err)
+	} else if issueStore, err := store.OpenIssueStore(storePath); err != nil {
+		logger.Warning("app.main: failed to open issue store path=%s error=%v", storePath, err)
+	} else {
+		app.SetIssueStore(issueStore)
+	}
+
+	// Attach the outbox so edits made while offline are queued and replayed
+	outboxPath, err := outbox.FilePath()
+	if err != nil {
+		logger.Warning("app.main: failed to resolve outbox path: %v", err)
+	} else if ob, err := outbox.Open(outboxPath); err != nil {
+		logger.Warning("app.main: failed to open outbox path=%s error=%v", outboxPath, err)
+	} else {
+		app.SetOutbox(ob)
+	}
+
+	// Attach the agent run history so transcripts outlive their runs
+	historyPath, err := agents.HistoryDirPath()
+	if err != nil {
+		logger.Warning("app.main: failed to resolve agent history path: %v", err)
+	} else if history, err := agents.OpenHistory(historyPath); err != nil {
+		logger.Warning("app.main: failed to open agent history path=%s error=%v", historyPath, err)
+	} else {
+		app.SetAgentHistory(history)
+	}
+
+	// Load saved views for the navigation tree's Views section
+	viewsPath, err := config.SavedViewsFilePath()
+	if err != nil {
+		logger.Warning("app.main: failed to resolve saved views path: %v", err)
+	} else if views, err := config.LoadSavedViews(viewsPath); err != nil {
+		logger.Warning("app.main: failed to load saved views path=%s error=%v", viewsPath, err)
+	} else {
+		app.SetSavedViews(viewsPath, views)
+	}
+
+	// Receive live updates from Linear webhooks forwarded to the local listener
+	if cfg.WebhookListen != "" {
+		app.SetLiveSource(live.NewWebhookSource(cfg.WebhookListen, cfg.WebhookSecret))
+	}
+
 	if err := app.Run(); err != nil {
 		logger.ErrorWithErr(err, "app.main: application error")
 		fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
@@ -91,26 +145,33 @@ func main() {
 		os.Exit(1) //nolint:gocritic // defer cleanup handled explicitly above
 	}
 
-	// If the TUI set a pending exec command (e.g. agent launch), replace the
-	// process with that command so the agent runs interactively in the terminal.
-	if pending := app.PendingExec(); pending != nil {
-		logger.Info("app.main: exec agent binary=%s", pending.Binary)
-		if err := logger.Close(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error closing logger: %v\n", err)
-		}
-		if pending.Dir != "" {
-			if err := os.Chdir(pending.Dir); err != nil {
-				fmt.Fprintf(os.Stderr, "Error changing directory: %v\n", err)
-				os.Exit(1)
-			}
-		}
-		if err := syscall.Exec(pending.Binary, pending.Args, os.Environ()); err != nil {
-			fmt.Fprintf(os.Stderr, "Error executing agent: %v\n", err)
-			os.Exit(1)
-		}
+	logger.Info("app.main: application shutdown")
+}
+
+// loadConfig loads settings and the API key, exiting with an error message on failure.
+func loadConfig() config.Config {
+	settingsPath, err := config.ConfigFilePath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error determining settings path: %v\n", err)
+		os.Exit(1)
 	}
 
-	logger.Info("app.main: application shutdown")
+	settings, err := config.EnsureSettingsFile(settingsPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading settings file: %v\n", err)
+		os.Exit(1)
+	}
+
+	apiKey := os.Getenv(config.LinearAPIKeyEnv)
+	cfg, err := config.ConfigFromSettings(apiKey, settings)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		if apiKey == "" {
+			fmt.Fprintf(os.Stderr, "Please set the %s environment variable.\n", config.LinearAPIKeyEnv)
+		}
+		os.Exit(1)
+	}
+	return cfg
 }
 
 // parseLogLevel converts a string log level to a logger.LogLevel.
@@ -3,35 +3,97 @@ package agents
 import (
 	"fmt"
 	"os/exec"
-	"strings"
+	"path/filepath"
 )
 
-// ParseCommand splits the command template into tokens, then substitutes {prompt}
-// and {branch} placeholders as single arguments (preserving multiline content and
-// whitespace). It resolves the binary via exec.LookPath and returns the absolute path.
-func ParseCommand(commandTemplate, fullPrompt, branchName string) (binary string, args []string, err error) {
-	tokens := strings.Fields(commandTemplate)
+// CommandProvider runs a configured agent command template non-interactively.
+// Templates for known CLIs (claude, cursor-agent) get that provider's stream-json
+// flags and event parser; any other command streams its raw output lines.
+type CommandProvider struct {
+	tokens   []string
+	lookPath func(string) (string, error)
+	stream   Provider // Provider supplying streaming flags and parsing, or nil
+}
+
+// NewCommandProvider creates a provider for a command template such as
+// "codex exec --branch {branch} {{if .Parent}}--parent={{.Parent.Identifier}}{{end}} {prompt}",
+// rendering its template actions against the issue data.
+func NewCommandProvider(commandTemplate string, data TemplateData, lookPath func(string) (string, error)) (*CommandProvider, error) {
+	tokens, err := RenderCommandTemplate(commandTemplate, data)
+	if err != nil {
+		return nil, err
+	}
 	if len(tokens) == 0 {
-		return "", nil, fmt.Errorf("empty command template")
+		return nil, fmt.Errorf("empty command template")
+	}
+	if lookPath == nil {
+		lookPath = exec.LookPath
+	}
+
+	p := &CommandProvider{tokens: tokens, lookPath: lookPath}
+	switch filepath.Base(tokens[0]) {
+	case "claude":
+		p.stream = NewClaudeProvider(lookPath)
+	case "cursor-agent", "agent":
+		p.stream = NewCursorProvider(lookPath)
+	}
+	return p, nil
+}
+
+// Name returns the streaming provider name, or the command binary name.
+func (p *CommandProvider) Name() string {
+	if p.stream != nil {
+		return p.stream.Name()
 	}
+	return filepath.Base(p.tokens[0])
+}
 
-	resolved, err := exec.LookPath(tokens[0])
+// ResolveBinary finds the template's binary on PATH.
+func (p *CommandProvider) ResolveBinary() (string, bool) {
+	path, err := p.lookPath(p.tokens[0])
 	if err != nil {
-		return "", nil, fmt.Errorf("binary %q not found: %w", tokens[0], err)
+		return "", false
 	}
+	return path, true
+}
 
-	args = make([]string, 0, len(tokens))
-	args = append(args, resolved)
-	for _, tok := range tokens[1:] {
+// BuildArgs keeps the template's own flags and, for known CLIs, appends the
+// provider's non-interactive streaming args (which carry the prompt).
+func (p *CommandProvider) BuildArgs(prompt string, issueContext string, options AgentRunOptions) []string {
+	args := make([]string, 0, len(p.tokens))
+	for _, tok := range p.tokens[1:] {
 		switch tok {
 		case "{prompt}":
-			args = append(args, fullPrompt)
-		case "{branch}":
-			args = append(args, branchName)
+			if p.stream == nil {
+				args = append(args, BuildAgentPrompt(prompt, issueContext))
+			}
+		case "-p", "--print":
+			// Already part of the streaming args
+			if p.stream == nil {
+				args = append(args, tok)
+			}
 		default:
 			args = append(args, tok)
 		}
 	}
+	if p.stream != nil {
+		args = append(args, p.stream.BuildArgs(prompt, issueContext, options)...)
+	}
+	return args
+}
 
-	return resolved, args, nil
+// ParseStreamLine delegates to the streaming provider, or passes raw lines through.
+func (p *CommandProvider) ParseStreamLine(line []byte) (string, bool) {
+	if p.stream != nil {
+		return p.stream.ParseStreamLine(line)
+	}
+	return string(line), true
+}
+
+// ParseEvent delegates structured parsing to the streaming provider when it supports it.
+func (p *CommandProvider) ParseEvent(line []byte) (*AgentEvent, bool) {
+	if parser, ok := p.stream.(EventParser); ok {
+		return parser.ParseEvent(line)
+	}
+	return nil, false
 }
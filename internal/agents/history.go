@@ -0,0 +1,313 @@
+package agents
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// historyMaxRuns is how many finished runs are kept before the oldest are pruned.
+const historyMaxRuns = 200
+
+// RunRecord is the persisted metadata of an agent run.
+type RunRecord struct {
+	ID            string    `json:"id"`
+	IssueID       string    `json:"issue_id"`
+	Identifier    string    `json:"identifier"`
+	Provider      string    `json:"provider"`
+	Command       string    `json:"command,omitempty"`
+	Prompt        string    `json:"prompt"`
+	Workspace     string    `json:"workspace,omitempty"`
+	StartedAt     time.Time `json:"started_at"`
+	FinishedAt    time.Time `json:"finished_at,omitempty"`
+	Status        JobState  `json:"status"`
+	Error         string    `json:"error,omitempty"`
+	SessionID     string    `json:"session_id,omitempty"`
+	ResumeCommand string    `json:"resume_command,omitempty"`
+}
+
+// Duration returns how long the run took, or zero while it is unfinished.
+func (r RunRecord) Duration() time.Duration {
+	if r.FinishedAt.IsZero() || r.StartedAt.IsZero() {
+		return 0
+	}
+	return r.FinishedAt.Sub(r.StartedAt)
+}
+
+// History persists agent runs as one metadata file plus an append-only event log per run:
+// <dir>/<id>.json and <dir>/<id>.events.jsonl. Events are written as they arrive so a
+// transcript survives crashes.
+type History struct {
+	mu    sync.Mutex
+	dir   string
+	runs  map[string]string // Job ID -> run ID for runs started in this process
+	clock func() time.Time
+}
+
+// HistoryDirPath returns the default agent run history directory.
+func HistoryDirPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".linear-tui", "agent-runs"), nil
+}
+
+// OpenHistory opens (creating if needed) the history directory.
+func OpenHistory(dir string) (*History, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("history directory is empty")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create history directory: %w", err)
+	}
+	return &History{dir: dir, runs: make(map[string]string), clock: time.Now}, nil
+}
+
+// RecordJob creates or updates the run record for a job snapshot. Finished jobs
+// trigger pruning of the oldest runs.
+func (h *History) RecordJob(job Job) error {
+	if job.StartedAt.IsZero() {
+		// Jobs cancelled while queued never ran and are not recorded
+		return nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	id := h.runIDLocked(job)
+	record, err := h.loadRecordLocked(id)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	record.ID = id
+	record.IssueID = job.IssueID
+	record.Identifier = job.Identifier
+	record.Provider = job.Provider
+	record.Command = job.Command
+	record.Prompt = job.Prompt
+	record.Workspace = job.Workspace
+	record.StartedAt = job.StartedAt
+	record.FinishedAt = job.FinishedAt
+	record.Status = job.State
+	record.Error = job.Error
+	if job.SessionID != "" {
+		record.SessionID = job.SessionID
+	}
+	if job.ResumeCommand != "" {
+		record.ResumeCommand = job.ResumeCommand
+	}
+	if err := h.saveRecordLocked(record); err != nil {
+		return err
+	}
+	if !job.State.Active() {
+		return h.pruneLocked()
+	}
+	return nil
+}
+
+// AppendEvent appends an event to the job's transcript.
+func (h *History) AppendEvent(job Job, event AgentEvent) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal agent event: %w", err)
+	}
+	path := h.eventsPathLocked(h.runIDLocked(job))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open transcript: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("append transcript: %w", err)
+	}
+	return nil
+}
+
+// Runs returns run records, newest first. A non-empty issueID limits results to that issue.
+func (h *History) Runs(issueID string) ([]RunRecord, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	records, err := h.listLocked()
+	if err != nil {
+		return nil, err
+	}
+	if issueID == "" {
+		return records, nil
+	}
+	filtered := records[:0]
+	for _, record := range records {
+		if record.IssueID == issueID {
+			filtered = append(filtered, record)
+		}
+	}
+	return filtered, nil
+}
+
+// Get returns a run record by ID.
+func (h *History) Get(id string) (RunRecord, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.loadRecordLocked(id)
+}
+
+// Events returns the recorded event sequence of a run.
+func (h *History) Events(id string) ([]AgentEvent, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	f, err := os.Open(h.eventsPathLocked(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open transcript: %w", err)
+	}
+	defer f.Close()
+
+	var events []AgentEvent
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineBytes)
+	for scanner.Scan() {
+		var event AgentEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			// Skip a line torn by a crash mid-write
+			continue
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return events, fmt.Errorf("read transcript: %w", err)
+	}
+	return events, nil
+}
+
+// runIDLocked returns the run ID for a job, assigning one on first use. Callers must hold mu.
+func (h *History) runIDLocked(job Job) string {
+	if id, ok := h.runs[job.ID]; ok {
+		return id
+	}
+	started := job.StartedAt
+	if started.IsZero() {
+		started = h.clock()
+	}
+	// Job IDs restart every session, so the start time keeps run IDs unique on disk
+	id := fmt.Sprintf("%s-%s-%s", started.UTC().Format("20060102T150405.000"), sanitizeRunIDPart(job.Identifier), job.ID)
+	h.runs[job.ID] = id
+	return id
+}
+
+// sanitizeRunIDPart keeps only characters safe for file names.
+func sanitizeRunIDPart(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' {
+			return r
+		}
+		return '_'
+	}, value)
+}
+
+// recordPathLocked returns the metadata file path for a run.
+func (h *History) recordPathLocked(id string) string {
+	return filepath.Join(h.dir, id+".json")
+}
+
+// eventsPathLocked returns the transcript file path for a run.
+func (h *History) eventsPathLocked(id string) string {
+	return filepath.Join(h.dir, id+".events.jsonl")
+}
+
+// loadRecordLocked reads a run's metadata. Callers must hold mu.
+func (h *History) loadRecordLocked(id string) (RunRecord, error) {
+	data, err := os.ReadFile(h.recordPathLocked(id))
+	if err != nil {
+		return RunRecord{}, fmt.Errorf("read run record: %w", err)
+	}
+	var record RunRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return RunRecord{}, fmt.Errorf("parse run record: %w", err)
+	}
+	return record, nil
+}
+
+// saveRecordLocked writes a run's metadata via a temp file and rename. Callers must hold mu.
+func (h *History) saveRecordLocked(record RunRecord) error {
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal run record: %w", err)
+	}
+	data = append(data, '\n')
+
+	path := h.recordPathLocked(record.ID)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("write run record: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("replace run record: %w", err)
+	}
+	return nil
+}
+
+// listLocked loads all run records, newest first. Callers must hold mu.
+func (h *History) listLocked() ([]RunRecord, error) {
+	entries, err := os.ReadDir(h.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read history directory: %w", err)
+	}
+	live := make(map[string]bool, len(h.runs))
+	for _, id := range h.runs {
+		live[id] = true
+	}
+	records := make([]RunRecord, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		record, err := h.loadRecordLocked(strings.TrimSuffix(name, ".json"))
+		if err != nil {
+			continue
+		}
+		if record.Status.Active() && !live[record.ID] {
+			// The process that ran it exited before the run finished
+			record.Status = JobFailed
+			record.Error = "interrupted"
+		}
+		records = append(records, record)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].StartedAt.After(records[j].StartedAt)
+	})
+	return records, nil
+}
+
+// pruneLocked deletes the oldest finished runs beyond historyMaxRuns. Callers must hold mu.
+func (h *History) pruneLocked() error {
+	records, err := h.listLocked()
+	if err != nil {
+		return err
+	}
+	for i := historyMaxRuns; i < len(records); i++ {
+		if records[i].Status.Active() {
+			continue
+		}
+		if err := os.Remove(h.recordPathLocked(records[i].ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("remove run record: %w", err)
+		}
+		if err := os.Remove(h.eventsPathLocked(records[i].ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("remove transcript: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package agents
+
+import (
+	"testing"
+	"time"
+)
+
+// TestHistory_RecordsRunsAndEvents verifies run metadata and events round-trip and list per issue.
+func TestHistory_RecordsRunsAndEvents(t *testing.T) {
+	dir := t.TempDir()
+	history, err := OpenHistory(dir)
+	if err != nil {
+		t.Fatalf("OpenHistory() error: %v", err)
+	}
+
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	job := Job{ID: "1", IssueID: "issue-1", Identifier: "ENG-1", Provider: "claude", Command: "claude {prompt}", Prompt: "Fix it", Workspace: "/tmp/ws", State: JobRunning, StartedAt: started}
+
+	// Events can arrive before the running notification
+	if err := history.AppendEvent(job, AgentEvent{Type: AgentEventSystem, SessionID: "s-1", ResumeCommand: "claude --resume s-1"}); err != nil {
+		t.Fatalf("AppendEvent() error: %v", err)
+	}
+	if err := history.RecordJob(job); err != nil {
+		t.Fatalf("RecordJob() error: %v", err)
+	}
+	if err := history.AppendEvent(job, AgentEvent{Type: AgentEventToolCall, Tool: &AgentToolCall{Name: "read", Path: "main.go"}}); err != nil {
+		t.Fatalf("AppendEvent() error: %v", err)
+	}
+	job.State = JobSucceeded
+	job.FinishedAt = started.Add(90 * time.Second)
+	job.SessionID = "s-1"
+	job.ResumeCommand = "claude --resume s-1"
+	if err := history.RecordJob(job); err != nil {
+		t.Fatalf("RecordJob() error: %v", err)
+	}
+
+	other := Job{ID: "2", IssueID: "issue-2", Identifier: "ENG-2", Provider: "claude", State: JobFailed, StartedAt: started.Add(time.Hour), FinishedAt: started.Add(2 * time.Hour), Error: "boom"}
+	if err := history.RecordJob(other); err != nil {
+		t.Fatalf("RecordJob() error: %v", err)
+	}
+	// Cancelled while queued: never ran, so not recorded
+	if err := history.RecordJob(Job{ID: "3", IssueID: "issue-1", Identifier: "ENG-1", State: JobCancelled}); err != nil {
+		t.Fatalf("RecordJob() error: %v", err)
+	}
+
+	// Reopen to read back from disk
+	reopened, err := OpenHistory(dir)
+	if err != nil {
+		t.Fatalf("OpenHistory() error: %v", err)
+	}
+	all, err := reopened.Runs("")
+	if err != nil {
+		t.Fatalf("Runs() error: %v", err)
+	}
+	if len(all) != 2 || all[0].Identifier != "ENG-2" {
+		t.Fatalf("Runs(\"\") should list both runs newest first: %+v", all)
+	}
+	runs, err := reopened.Runs("issue-1")
+	if err != nil {
+		t.Fatalf("Runs() error: %v", err)
+	}
+	if len(runs) != 1 {
+		t.Fatalf("Runs(issue-1) = %d runs, want 1", len(runs))
+	}
+	run := runs[0]
+	if run.Status != JobSucceeded || run.Prompt != "Fix it" || run.Command != "claude {prompt}" || run.Workspace != "/tmp/ws" {
+		t.Fatalf("unexpected record: %+v", run)
+	}
+	if run.ResumeCommand != "claude --resume s-1" || run.Duration() != 90*time.Second {
+		t.Fatalf("unexpected resume/duration: %+v", run)
+	}
+
+	events, err := reopened.Events(run.ID)
+	if err != nil {
+		t.Fatalf("Events() error: %v", err)
+	}
+	if len(events) != 2 || events[0].SessionID != "s-1" || events[1].Tool == nil || events[1].Tool.Path != "main.go" {
+		t.Fatalf("unexpected events: %+v", events)
+	}
+}
+
+// TestHistory_MarksInterruptedRuns verifies runs left running by an exited process read as failed.
+func TestHistory_MarksInterruptedRuns(t *testing.T) {
+	dir := t.TempDir()
+	history, err := OpenHistory(dir)
+	if err != nil {
+		t.Fatalf("OpenHistory() error: %v", err)
+	}
+	job := Job{ID: "1", IssueID: "issue-1", Identifier: "ENG-1", Provider: "claude", State: JobRunning, StartedAt: time.Now()}
+	if err := history.RecordJob(job); err != nil {
+		t.Fatalf("RecordJob() error: %v", err)
+	}
+	if runs, _ := history.Runs(""); len(runs) != 1 || runs[0].Status != JobRunning {
+		t.Fatalf("live run should stay running: %+v", runs)
+	}
+
+	reopened, err := OpenHistory(dir)
+	if err != nil {
+		t.Fatalf("OpenHistory() error: %v", err)
+	}
+	runs, _ := reopened.Runs("")
+	if len(runs) != 1 || runs[0].Status != JobFailed || runs[0].Error != "interrupted" {
+		t.Fatalf("expected interrupted run: %+v", runs)
+	}
+}
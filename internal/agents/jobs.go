@@ -0,0 +1,380 @@
+package agents
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// JobState is the lifecycle state of an agent job.
+type JobState string
+
+const (
+	JobQueued    JobState = "queued"
+	JobRunning   JobState = "running"
+	JobSucceeded JobState = "succeeded"
+	JobFailed    JobState = "failed"
+	JobCancelled JobState = "cancelled"
+)
+
+// Active reports whether the job is still queued or running.
+func (s JobState) Active() bool {
+	return s == JobQueued || s == JobRunning
+}
+
+// RunFunc executes a provider run. Runner.Run satisfies it.
+type RunFunc func(ctx context.Context, p Provider, prompt string, issueContext string, options AgentRunOptions, onEvent func(AgentEvent), onLine func(string), onErr func(error)) error
+
+// JobSpec describes an agent run to schedule.
+type JobSpec struct {
+	IssueID      string
+	Identifier   string
+	Command      string // Configured command template, for display and history
+	Provider     Provider
+	Prompt       string
+	IssueContext string
+	Options      AgentRunOptions
+}
+
+// Job is a snapshot of a scheduled agent run.
+type Job struct {
+	ID            string
+	IssueID       string
+	Identifier    string
+	Provider      string
+	Command       string
+	Prompt        string
+	Workspace     string
+	State         JobState
+	QueuedAt      time.Time
+	StartedAt     time.Time
+	FinishedAt    time.Time
+	SessionID     string
+	ResumeCommand string
+	Error         string
+}
+
+// Elapsed returns the queue wait for queued jobs, otherwise the run time so far (or in total).
+func (j Job) Elapsed(now time.Time) time.Duration {
+	switch {
+	case j.StartedAt.IsZero():
+		return now.Sub(j.QueuedAt)
+	case j.FinishedAt.IsZero():
+		return now.Sub(j.StartedAt)
+	default:
+		return j.FinishedAt.Sub(j.StartedAt)
+	}
+}
+
+// JobHandlers receive job notifications. They are called from job goroutines
+// (OnEvent and OnLine possibly concurrently for stdout and stderr), never while
+// the manager holds its lock.
+type JobHandlers struct {
+	OnChange func(job Job)
+	OnEvent  func(job Job, event AgentEvent)
+	OnLine   func(job Job, line string)
+}
+
+// jobEntry is the manager's mutable record for a job.
+type jobEntry struct {
+	job       Job
+	spec      JobSpec
+	cancel    context.CancelFunc
+	resultErr bool // Final result event reported is_error
+}
+
+// JobManager schedules agent runs under a concurrency limit, allowing one active job per issue.
+type JobManager struct {
+	mu       sync.Mutex
+	run      RunFunc
+	limit    int
+	handlers JobHandlers
+	jobs     []*jobEntry // Submission order
+	running  int
+	seq      int
+	now      func() time.Time
+}
+
+// NewJobManager creates a manager that runs at most limit jobs at once.
+func NewJobManager(run RunFunc, limit int) *JobManager {
+	if limit < 1 {
+		limit = 1
+	}
+	return &JobManager{run: run, limit: limit, now: time.Now}
+}
+
+// SetHandlers installs notification callbacks.
+func (m *JobManager) SetHandlers(handlers JobHandlers) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers = handlers
+}
+
+// SetLimit changes the concurrency limit, starting queued jobs if it grew.
+func (m *JobManager) SetLimit(limit int) {
+	if limit < 1 {
+		limit = 1
+	}
+	m.mu.Lock()
+	m.limit = limit
+	started := m.startQueuedLocked()
+	m.mu.Unlock()
+	m.launch(started)
+}
+
+// Submit queues a job and starts it if a slot is free.
+func (m *JobManager) Submit(spec JobSpec) (Job, error) {
+	if spec.Provider == nil {
+		return Job{}, fmt.Errorf("provider is nil")
+	}
+
+	m.mu.Lock()
+	for _, entry := range m.jobs {
+		if entry.job.IssueID == spec.IssueID && entry.job.State.Active() {
+			m.mu.Unlock()
+			return Job{}, fmt.Errorf("agent already %s for %s", entry.job.State, spec.Identifier)
+		}
+	}
+	m.seq++
+	entry := &jobEntry{
+		spec: spec,
+		job: Job{
+			ID:         strconv.Itoa(m.seq),
+			IssueID:    spec.IssueID,
+			Identifier: spec.Identifier,
+			Provider:   spec.Provider.Name(),
+			Command:    spec.Command,
+			Prompt:     spec.Prompt,
+			Workspace:  spec.Options.Workspace,
+			State:      JobQueued,
+			QueuedAt:   m.now(),
+		},
+	}
+	m.jobs = append(m.jobs, entry)
+	queued := entry.job
+	started := m.startQueuedLocked()
+	m.mu.Unlock()
+
+	logger.Info("agents.jobs: job submitted id=%s issue=%s provider=%s", queued.ID, queued.Identifier, queued.Provider)
+	if len(started) == 0 || started[0].job.ID != queued.ID {
+		m.notify([]Job{queued})
+	}
+	m.launch(started)
+	return queued, nil
+}
+
+// Cancel stops a running job via its context, or drops a queued one.
+func (m *JobManager) Cancel(id string) error {
+	m.mu.Lock()
+	entry := m.findLocked(id)
+	if entry == nil {
+		m.mu.Unlock()
+		return fmt.Errorf("agent job %s not found", id)
+	}
+	switch entry.job.State {
+	case JobQueued:
+		entry.job.State = JobCancelled
+		entry.job.FinishedAt = m.now()
+		job := entry.job
+		m.mu.Unlock()
+		logger.Info("agents.jobs: queued job cancelled id=%s issue=%s", job.ID, job.Identifier)
+		m.notify([]Job{job})
+		return nil
+	case JobRunning:
+		cancel := entry.cancel
+		m.mu.Unlock()
+		logger.Info("agents.jobs: cancelling job id=%s issue=%s", id, entry.spec.Identifier)
+		cancel()
+		return nil
+	default:
+		state := entry.job.State
+		m.mu.Unlock()
+		return fmt.Errorf("agent job %s already %s", id, state)
+	}
+}
+
+// CancelAll cancels every queued and running job.
+func (m *JobManager) CancelAll() {
+	for _, job := range m.Jobs() {
+		if job.State.Active() {
+			_ = m.Cancel(job.ID)
+		}
+	}
+}
+
+// Jobs returns snapshots of all jobs, newest first.
+func (m *JobManager) Jobs() []Job {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	jobs := make([]Job, 0, len(m.jobs))
+	for i := len(m.jobs) - 1; i >= 0; i-- {
+		jobs = append(jobs, m.jobs[i].job)
+	}
+	return jobs
+}
+
+// Get returns a snapshot of a job by ID.
+func (m *JobManager) Get(id string) (Job, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if entry := m.findLocked(id); entry != nil {
+		return entry.job, true
+	}
+	return Job{}, false
+}
+
+// ActiveForIssue returns the queued or running job for an issue.
+func (m *JobManager) ActiveForIssue(issueID string) (Job, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, entry := range m.jobs {
+		if entry.job.IssueID == issueID && entry.job.State.Active() {
+			return entry.job, true
+		}
+	}
+	return Job{}, false
+}
+
+// ActiveStates returns the state of each issue's queued or running job.
+func (m *JobManager) ActiveStates() map[string]JobState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	states := make(map[string]JobState)
+	for _, entry := range m.jobs {
+		if entry.job.State.Active() {
+			states[entry.job.IssueID] = entry.job.State
+		}
+	}
+	return states
+}
+
+// findLocked returns the entry for id. Callers must hold mu.
+func (m *JobManager) findLocked(id string) *jobEntry {
+	for _, entry := range m.jobs {
+		if entry.job.ID == id {
+			return entry
+		}
+	}
+	return nil
+}
+
+// startedJob is a job moved to running that still has to be launched.
+type startedJob struct {
+	job   Job // Snapshot in the running state
+	entry *jobEntry
+	ctx   context.Context
+}
+
+// startQueuedLocked moves queued jobs to running in submission order while slots are
+// free. The returned jobs must be passed to launch once mu is released. Callers must hold mu.
+func (m *JobManager) startQueuedLocked() []startedJob {
+	var started []startedJob
+	for _, entry := range m.jobs {
+		if m.running >= m.limit {
+			break
+		}
+		if entry.job.State != JobQueued {
+			continue
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		entry.cancel = cancel
+		entry.job.State = JobRunning
+		entry.job.StartedAt = m.now()
+		m.running++
+		started = append(started, startedJob{job: entry.job, entry: entry, ctx: ctx})
+	}
+	return started
+}
+
+// launch reports each started job as running, then runs it. Running the job only after
+// the notification keeps a job that finishes at once from being reported finished first.
+func (m *JobManager) launch(started []startedJob) {
+	for _, s := range started {
+		m.notify([]Job{s.job})
+		go m.execute(s.ctx, s.entry)
+	}
+}
+
+// execute runs a job to completion and schedules the next queued job.
+func (m *JobManager) execute(ctx context.Context, entry *jobEntry) {
+	spec := entry.spec
+	logger.Debug("agents.jobs: job started id=%s issue=%s", entry.job.ID, spec.Identifier)
+
+	onEvent := func(event AgentEvent) {
+		m.mu.Lock()
+		if event.SessionID != "" {
+			entry.job.SessionID = event.SessionID
+		}
+		if event.ResumeCommand != "" {
+			entry.job.ResumeCommand = event.ResumeCommand
+		}
+		if event.Type == AgentEventResult {
+			entry.resultErr = event.IsError
+		}
+		job := entry.job
+		handler := m.handlers.OnEvent
+		m.mu.Unlock()
+		if handler != nil {
+			handler(job, event)
+		}
+	}
+	onLine := func(line string) {
+		m.mu.Lock()
+		job := entry.job
+		handler := m.handlers.OnLine
+		m.mu.Unlock()
+		if handler != nil {
+			handler(job, line)
+		}
+	}
+	onErr := func(err error) {
+		logger.ErrorWithErr(err, "agents.jobs: stream error id=%s issue=%s", entry.job.ID, spec.Identifier)
+	}
+
+	err := m.run(ctx, spec.Provider, spec.Prompt, spec.IssueContext, spec.Options, onEvent, onLine, onErr)
+	cancelled := ctx.Err() != nil
+	entry.cancel()
+
+	m.mu.Lock()
+	entry.job.FinishedAt = m.now()
+	switch {
+	case cancelled:
+		entry.job.State = JobCancelled
+	case err != nil:
+		entry.job.State = JobFailed
+		entry.job.Error = err.Error()
+	case entry.resultErr:
+		entry.job.State = JobFailed
+		entry.job.Error = "agent reported an error"
+	default:
+		entry.job.State = JobSucceeded
+	}
+	m.running--
+	finished := entry.job
+	started := m.startQueuedLocked()
+	m.mu.Unlock()
+
+	logger.Info("agents.jobs: job finished id=%s issue=%s state=%s", finished.ID, finished.Identifier, finished.State)
+	m.notify([]Job{finished})
+	m.launch(started)
+}
+
+// notify reports job changes to the OnChange handler.
+func (m *JobManager) notify(jobs []Job) {
+	if len(jobs) == 0 {
+		return
+	}
+	m.mu.Lock()
+	handler := m.handlers.OnChange
+	m.mu.Unlock()
+	if handler == nil {
+		return
+	}
+	for _, job := range jobs {
+		handler(job)
+	}
+}
@@ -0,0 +1,232 @@
+package agents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockingRun is a RunFunc whose runs block until released or cancelled.
+type blockingRun struct {
+	mu      sync.Mutex
+	release map[string]chan error // keyed by prompt
+	started chan string
+}
+
+// newBlockingRun creates a blockingRun.
+func newBlockingRun() *blockingRun {
+	return &blockingRun{release: make(map[string]chan error), started: make(chan string, 10)}
+}
+
+// channel returns the release channel for a prompt.
+func (b *blockingRun) channel(prompt string) chan error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ch, ok := b.release[prompt]
+	if !ok {
+		ch = make(chan error, 1)
+		b.release[prompt] = ch
+	}
+	return ch
+}
+
+// Run emits a session event, then waits for release or cancellation.
+func (b *blockingRun) Run(ctx context.Context, p Provider, prompt string, issueContext string, options AgentRunOptions, onEvent func(AgentEvent), onLine func(string), onErr func(error)) error {
+	onEvent(AgentEvent{Type: AgentEventSystem, SessionID: "session-" + prompt, ResumeCommand: "resume " + prompt})
+	b.started <- prompt
+	select {
+	case err := <-b.channel(prompt):
+		onEvent(AgentEvent{Type: AgentEventResult, Subtype: "success"})
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// waitStarted waits for the run with the given prompt to start.
+func waitStarted(t *testing.T, b *blockingRun, want string) {
+	t.Helper()
+	select {
+	case got := <-b.started:
+		if got != want {
+			t.Fatalf("started %q, want %q", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("run %q did not start", want)
+	}
+}
+
+// waitJobState polls until a job reaches the given state.
+func waitJobState(t *testing.T, m *JobManager, id string, want JobState) Job {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if job, ok := m.Get(id); ok && job.State == want {
+			return job
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	job, _ := m.Get(id)
+	t.Fatalf("job %s state = %s, want %s", id, job.State, want)
+	return Job{}
+}
+
+// TestJobManager_ConcurrencyLimitQueues verifies jobs beyond the limit wait and start in order.
+func TestJobManager_ConcurrencyLimitQueues(t *testing.T) {
+	run := newBlockingRun()
+	m := NewJobManager(run.Run, 1)
+	provider := testProvider{binary: "helper"}
+
+	first, err := m.Submit(JobSpec{IssueID: "issue-1", Identifier: "ENG-1", Provider: provider, Prompt: "one"})
+	if err != nil {
+		t.Fatalf("Submit() error: %v", err)
+	}
+	second, err := m.Submit(JobSpec{IssueID: "issue-2", Identifier: "ENG-2", Provider: provider, Prompt: "two"})
+	if err != nil {
+		t.Fatalf("Submit() error: %v", err)
+	}
+	waitStarted(t, run, "one")
+	if job, _ := m.Get(second.ID); job.State != JobQueued {
+		t.Fatalf("second job state = %s, want queued", job.State)
+	}
+	if states := m.ActiveStates(); states["issue-1"] != JobRunning || states["issue-2"] != JobQueued {
+		t.Fatalf("ActiveStates() = %v", states)
+	}
+
+	run.channel("one") <- nil
+	done := waitJobState(t, m, first.ID, JobSucceeded)
+	if done.SessionID != "session-one" || done.ResumeCommand != "resume one" {
+		t.Fatalf("session not captured: %+v", done)
+	}
+	waitStarted(t, run, "two")
+	waitJobState(t, m, second.ID, JobRunning)
+
+	if jobs := m.Jobs(); len(jobs) != 2 || jobs[0].ID != second.ID {
+		t.Fatalf("Jobs() should list newest first: %+v", jobs)
+	}
+	run.channel("two") <- nil
+	waitJobState(t, m, second.ID, JobSucceeded)
+}
+
+// TestJobManager_OneActiveJobPerIssue verifies a second submission for a busy issue is rejected.
+func TestJobManager_OneActiveJobPerIssue(t *testing.T) {
+	run := newBlockingRun()
+	m := NewJobManager(run.Run, 2)
+	provider := testProvider{binary: "helper"}
+
+	job, err := m.Submit(JobSpec{IssueID: "issue-1", Identifier: "ENG-1", Provider: provider, Prompt: "one"})
+	if err != nil {
+		t.Fatalf("Submit() error: %v", err)
+	}
+	if _, err := m.Submit(JobSpec{IssueID: "issue-1", Identifier: "ENG-1", Provider: provider, Prompt: "again"}); err == nil {
+		t.Fatal("expected error for second job on the same issue")
+	}
+
+	waitStarted(t, run, "one")
+	if err := m.Cancel(job.ID); err != nil {
+		t.Fatalf("Cancel() error: %v", err)
+	}
+	waitJobState(t, m, job.ID, JobCancelled)
+	if _, ok := m.ActiveForIssue("issue-1"); ok {
+		t.Fatal("expected no active job after cancel")
+	}
+}
+
+// TestJobManager_CancelQueuedAndFailures verifies queued cancellation and failure states.
+func TestJobManager_CancelQueuedAndFailures(t *testing.T) {
+	run := newBlockingRun()
+	m := NewJobManager(run.Run, 1)
+	provider := testProvider{binary: "helper"}
+
+	var changesMu sync.Mutex
+	var changes []JobState
+	m.SetHandlers(JobHandlers{OnChange: func(job Job) {
+		changesMu.Lock()
+		changes = append(changes, job.State)
+		changesMu.Unlock()
+	}})
+
+	first, _ := m.Submit(JobSpec{IssueID: "issue-1", Identifier: "ENG-1", Provider: provider, Prompt: "one"})
+	queued, _ := m.Submit(JobSpec{IssueID: "issue-2", Identifier: "ENG-2", Provider: provider, Prompt: "two"})
+	waitStarted(t, run, "one")
+
+	if err := m.Cancel(queued.ID); err != nil {
+		t.Fatalf("Cancel() error: %v", err)
+	}
+	waitJobState(t, m, queued.ID, JobCancelled)
+
+	run.channel("one") <- context.DeadlineExceeded
+	failed := waitJobState(t, m, first.ID, JobFailed)
+	if failed.Error == "" {
+		t.Fatal("expected error message on failed job")
+	}
+	if err := m.Cancel(first.ID); err == nil {
+		t.Fatal("expected error cancelling a finished job")
+	}
+
+	changesMu.Lock()
+	defer changesMu.Unlock()
+	want := []JobState{JobRunning, JobQueued, JobCancelled, JobFailed}
+	if len(changes) != len(want) {
+		t.Fatalf("changes = %v, want %v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Fatalf("changes = %v, want %v", changes, want)
+		}
+	}
+}
+
+// TestJobManager_NotifiesInOrderForInstantRuns verifies a run that ends at once is reported
+// running before it is reported finished.
+func TestJobManager_NotifiesInOrderForInstantRuns(t *testing.T) {
+	failing := func(ctx context.Context, p Provider, prompt string, issueContext string, options AgentRunOptions, onEvent func(AgentEvent), onLine func(string), onErr func(error)) error {
+		return errors.New("executable file not found")
+	}
+	m := NewJobManager(failing, 2)
+	var mu sync.Mutex
+	states := make(map[string][]JobState)
+	m.SetHandlers(JobHandlers{OnChange: func(job Job) {
+		mu.Lock()
+		defer mu.Unlock()
+		states[job.ID] = append(states[job.ID], job.State)
+	}})
+
+	var ids []string
+	for i := 0; i < 20; i++ {
+		job, err := m.Submit(JobSpec{IssueID: fmt.Sprintf("issue-%d", i), Identifier: "ENG", Provider: testProvider{binary: "missing"}, Prompt: "go"})
+		if err != nil {
+			t.Fatalf("Submit() error: %v", err)
+		}
+		ids = append(ids, job.ID)
+	}
+	for _, id := range ids {
+		waitJobState(t, m, id, JobFailed)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for _, id := range ids {
+		for {
+			mu.Lock()
+			got := states[id]
+			mu.Unlock()
+			if len(got) > 0 && got[len(got)-1] == JobFailed {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("job %s notifications = %v, want failed last", id, got)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		mu.Lock()
+		got := states[id]
+		mu.Unlock()
+		if running := slices.Index(got, JobRunning); running < 0 || running != len(got)-2 {
+			t.Fatalf("job %s notifications = %v, want running just before failed", id, got)
+		}
+	}
+}
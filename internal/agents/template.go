@@ -0,0 +1,205 @@
+package agents
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TemplateData is the issue data available to prompt and command templates,
+// e.g. "Fix {{.Identifier}}: {{.Title}}{{range .Labels}} #{{.}}{{end}}".
+type TemplateData struct {
+	Identifier  string
+	Title       string
+	Description string
+	URL         string
+	State       string
+	Assignee    string
+	Priority    string
+	Branch      string
+	TeamKey     string
+	Project     string
+	Labels      []string
+	Parent      *TemplateIssueRef // nil for top-level issues
+	Children    []TemplateIssueRef
+	Comments    []TemplateComment
+}
+
+// TemplateIssueRef is a parent or child issue in template data.
+type TemplateIssueRef struct {
+	Identifier string
+	Title      string
+	State      string
+}
+
+// TemplateComment is an issue comment in template data.
+type TemplateComment struct {
+	Author    string
+	Body      string
+	CreatedAt string
+}
+
+// templateFuncs are the helper functions available to templates.
+var templateFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"trim":  strings.TrimSpace,
+	"default": func(fallback, value string) string {
+		if strings.TrimSpace(value) == "" {
+			return fallback
+		}
+		return value
+	},
+}
+
+// NewTemplateData builds template data from an issue. The team key is the
+// identifier prefix; the project name must be resolved by the caller.
+func NewTemplateData(issue linearapi.Issue, project string) TemplateData {
+	teamKey, _, _ := strings.Cut(issue.Identifier, "-")
+	data := TemplateData{
+		Identifier:  issue.Identifier,
+		Title:       issue.Title,
+		Description: issue.Description,
+		URL:         issue.URL,
+		State:       issue.State,
+		Assignee:    issue.Assignee,
+		Priority:    linearapi.PriorityLabel(issue.Priority),
+		Branch:      issue.BranchName,
+		TeamKey:     teamKey,
+		Project:     project,
+	}
+	for _, label := range issue.Labels {
+		data.Labels = append(data.Labels, label.Name)
+	}
+	if issue.Parent != nil {
+		data.Parent = &TemplateIssueRef{Identifier: issue.Parent.Identifier, Title: issue.Parent.Title, State: issue.Parent.State}
+	}
+	for _, child := range issue.Children {
+		data.Children = append(data.Children, TemplateIssueRef{Identifier: child.Identifier, Title: child.Title, State: child.State})
+	}
+	for _, comment := range issue.Comments {
+		data.Comments = append(data.Comments, TemplateComment{
+			Author:    formatAuthor(comment.Author),
+			Body:      comment.Body,
+			CreatedAt: formatTimestamp(comment.CreatedAt),
+		})
+	}
+	return data
+}
+
+// parseTemplate parses template text with the template helpers.
+func parseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// RenderTemplate renders template text against issue data. Text without
+// template actions is returned unchanged.
+func RenderTemplate(text string, data TemplateData) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	tmpl, err := parseTemplate("prompt", text)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("render template: %w", err)
+	}
+	return b.String(), nil
+}
+
+// ValidateTemplate reports syntax errors and references to unknown fields, and
+// rejects templates that fail on issues without a parent, labels or comments.
+func ValidateTemplate(text string) error {
+	if _, err := RenderTemplate(text, sampleTemplateData()); err != nil {
+		return err
+	}
+	if _, err := RenderTemplate(text, TemplateData{}); err != nil {
+		return fmt.Errorf("%w (guard optional fields such as .Parent with {{if}})", err)
+	}
+	return nil
+}
+
+// sampleTemplateData returns data with every field set, for validation.
+func sampleTemplateData() TemplateData {
+	return TemplateData{
+		Identifier:  "ENG-1",
+		Title:       "Title",
+		Description: "Description",
+		URL:         "https://linear.app/issue/ENG-1",
+		State:       "Todo",
+		Assignee:    "Assignee",
+		Priority:    "Normal",
+		Branch:      "eng-1-title",
+		TeamKey:     "ENG",
+		Project:     "Project",
+		Labels:      []string{"Label"},
+		Parent:      &TemplateIssueRef{Identifier: "ENG-0", Title: "Parent", State: "In Progress"},
+		Children:    []TemplateIssueRef{{Identifier: "ENG-2", Title: "Child", State: "Todo"}},
+		Comments:    []TemplateComment{{Author: "Author", Body: "Body", CreatedAt: "2024-01-01T00:00:00Z"}},
+	}
+}
+
+// splitTemplateFields splits a command template on whitespace outside {{ }} actions,
+// so "--title={{ .Title }}" stays one argument.
+func splitTemplateFields(text string) []string {
+	var fields []string
+	var current strings.Builder
+	depth := 0
+	for i := 0; i < len(text); i++ {
+		switch {
+		case strings.HasPrefix(text[i:], "{{"):
+			depth++
+			current.WriteString("{{")
+			i++
+		case depth > 0 && strings.HasPrefix(text[i:], "}}"):
+			depth--
+			current.WriteString("}}")
+			i++
+		case depth == 0 && (text[i] == ' ' || text[i] == '\t' || text[i] == '\n' || text[i] == '\r'):
+			if current.Len() > 0 {
+				fields = append(fields, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteByte(text[i])
+		}
+	}
+	if current.Len() > 0 {
+		fields = append(fields, current.String())
+	}
+	return fields
+}
+
+// RenderCommandTemplate splits a command template into arguments and renders the
+// template actions in each. {prompt} is left in place for the provider; {branch}
+// becomes the issue branch. Templated arguments that render empty are dropped.
+func RenderCommandTemplate(commandTemplate string, data TemplateData) ([]string, error) {
+	fields := splitTemplateFields(commandTemplate)
+	tokens := make([]string, 0, len(fields))
+	for _, field := range fields {
+		switch {
+		case field == "{branch}":
+			tokens = append(tokens, data.Branch)
+		case strings.Contains(field, "{{"):
+			rendered, err := RenderTemplate(field, data)
+			if err != nil {
+				return nil, fmt.Errorf("command argument %q: %w", field, err)
+			}
+			if rendered != "" {
+				tokens = append(tokens, rendered)
+			}
+		default:
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens, nil
+}
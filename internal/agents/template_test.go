@@ -0,0 +1,73 @@
+package agents
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestRenderTemplate_VariablesConditionalsAndLoops verifies issue fields, {{if}} and {{range}} render.
+func TestRenderTemplate_VariablesConditionalsAndLoops(t *testing.T) {
+	issue := linearapi.Issue{
+		Identifier: "ENG-42",
+		Title:      "Fix login",
+		URL:        "https://linear.app/acme/issue/ENG-42",
+		State:      "Todo",
+		Labels:     []linearapi.IssueLabel{{Name: "bug"}, {Name: "auth"}},
+		Parent:     &linearapi.IssueRef{Identifier: "ENG-40", Title: "Auth epic", State: "In Progress"},
+		Children:   []linearapi.IssueChildRef{{Identifier: "ENG-43", Title: "Add test", State: "Done"}},
+		Comments:   []linearapi.Comment{{Body: "Repro attached", Author: linearapi.User{Name: "Dana"}}},
+	}
+	data := NewTemplateData(issue, "Web")
+
+	text := "{{.TeamKey}}/{{.Project}} {{.Identifier}}: {{.Title}} [{{join .Labels \", \"}}]" +
+		"{{if .Parent}} part of {{.Parent.Identifier}} ({{.Parent.State}}){{end}}" +
+		"{{range .Children}} child {{.Identifier}} ({{.State}}){{end}}" +
+		"{{range .Comments}} {{.Author}} said {{.Body}}{{end}} assignee={{default \"nobody\" .Assignee}}"
+	got, err := RenderTemplate(text, data)
+	if err != nil {
+		t.Fatalf("RenderTemplate() error: %v", err)
+	}
+	want := "ENG/Web ENG-42: Fix login [bug, auth] part of ENG-40 (In Progress) child ENG-43 (Done) Dana said Repro attached assignee=nobody"
+	if got != want {
+		t.Fatalf("RenderTemplate() = %q, want %q", got, want)
+	}
+
+	// Plain prompts pass through untouched
+	if got, err := RenderTemplate("Summarize {prompt}", data); err != nil || got != "Summarize {prompt}" {
+		t.Fatalf("plain prompt = %q, %v", got, err)
+	}
+}
+
+// TestValidateTemplate verifies syntax errors, unknown fields and unguarded optional fields are rejected.
+func TestValidateTemplate(t *testing.T) {
+	if err := ValidateTemplate("Plan {{.Identifier}}{{if .Parent}} under {{.Parent.Title}}{{end}}"); err != nil {
+		t.Fatalf("expected valid template, got %v", err)
+	}
+	for _, text := range []string{
+		"Plan {{.Identifier",
+		"Plan {{.Nope}}",
+		"Plan {{range .Labels}}",
+		"Parent {{.Parent.Title}}",
+	} {
+		if err := ValidateTemplate(text); err == nil {
+			t.Errorf("ValidateTemplate(%q) = nil, want error", text)
+		}
+	}
+}
+
+// TestRenderCommandTemplate verifies template actions stay one argument and empty ones are dropped.
+func TestRenderCommandTemplate(t *testing.T) {
+	data := TemplateData{Identifier: "ENG-1", Title: "Fix the thing", Branch: "eng-1"}
+	tokens, err := RenderCommandTemplate("codex exec --title={{ .Title }} {{if .Project}}--project={{.Project}}{{end}} --branch {branch} {prompt}", data)
+	if err != nil {
+		t.Fatalf("RenderCommandTemplate() error: %v", err)
+	}
+	if got := strings.Join(tokens, "|"); got != "codex|exec|--title=Fix the thing|--branch|eng-1|{prompt}" {
+		t.Fatalf("tokens = %s", got)
+	}
+	if _, err := RenderCommandTemplate("codex {{.Missing}}", data); err == nil {
+		t.Fatal("expected error for unknown field")
+	}
+}
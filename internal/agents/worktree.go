@@ -0,0 +1,204 @@
+package agents
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// Worktree is a git worktree as reported by `git worktree list --porcelain`.
+type Worktree struct {
+	Path     string
+	Branch   string // Short branch name; empty when detached
+	Head     string
+	Main     bool // The repository's main working tree
+	Locked   bool
+	Prunable bool // Its directory is gone and git can prune it
+}
+
+// WorktreeRootPath returns the default root directory for per-issue worktrees.
+func WorktreeRootPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".linear-tui", "worktrees"), nil
+}
+
+// RepoRoot returns the top-level directory of the git repository containing dir.
+func RepoRoot(ctx context.Context, dir string) (string, error) {
+	out, err := runGit(ctx, dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", fmt.Errorf("%s is not in a git repository: %w", dir, err)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// WorktreePath returns where the worktree for branch lives under root.
+// Worktrees are grouped by repository so one root can serve several repos.
+func WorktreePath(root, repoRoot, branch string) string {
+	return filepath.Join(root, filepath.Base(repoRoot), sanitizeBranchDir(branch))
+}
+
+// EnsureWorktree returns a worktree with branch checked out, creating it under root
+// if none exists. An existing worktree for the branch is reused wherever it lives.
+// The branch is created from HEAD when it does not exist yet.
+func EnsureWorktree(ctx context.Context, repoDir, root, branch string) (string, bool, error) {
+	branch = strings.TrimSpace(branch)
+	if branch == "" {
+		return "", false, fmt.Errorf("issue has no branch name")
+	}
+	repoRoot, err := RepoRoot(ctx, repoDir)
+	if err != nil {
+		return "", false, err
+	}
+
+	worktrees, err := ListWorktrees(ctx, repoRoot)
+	if err != nil {
+		return "", false, err
+	}
+	path := WorktreePath(root, repoRoot, branch)
+	for _, wt := range worktrees {
+		if wt.Prunable {
+			continue
+		}
+		if wt.Branch == branch || filepath.Clean(wt.Path) == filepath.Clean(path) {
+			logger.Debug("agents.worktree: reusing worktree path=%s branch=%s", wt.Path, branch)
+			return wt.Path, false, nil
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", false, fmt.Errorf("create worktree root: %w", err)
+	}
+	// A stale registration for the path would make `worktree add` fail
+	if _, err := runGit(ctx, repoRoot, "worktree", "prune"); err != nil {
+		return "", false, fmt.Errorf("prune worktrees: %w", err)
+	}
+	args := []string{"worktree", "add", path, branch}
+	if _, err := runGit(ctx, repoRoot, "show-ref", "--verify", "--quiet", "refs/heads/"+branch); err != nil {
+		args = []string{"worktree", "add", "-b", branch, path}
+	}
+	if _, err := runGit(ctx, repoRoot, args...); err != nil {
+		return "", false, fmt.Errorf("create worktree for %s: %w", branch, err)
+	}
+	logger.Info("agents.worktree: created worktree path=%s branch=%s", path, branch)
+	return path, true, nil
+}
+
+// ListWorktrees returns the worktrees of the repository containing repoDir.
+func ListWorktrees(ctx context.Context, repoDir string) ([]Worktree, error) {
+	out, err := runGit(ctx, repoDir, "worktree", "list", "--porcelain")
+	if err != nil {
+		return nil, fmt.Errorf("list worktrees: %w", err)
+	}
+	return parseWorktreeList(out), nil
+}
+
+// parseWorktreeList parses `git worktree list --porcelain` output.
+func parseWorktreeList(out string) []Worktree {
+	var worktrees []Worktree
+	for _, block := range strings.Split(strings.TrimSpace(out), "\n\n") {
+		var wt Worktree
+		for _, line := range strings.Split(block, "\n") {
+			key, value, _ := strings.Cut(strings.TrimSpace(line), " ")
+			switch key {
+			case "worktree":
+				wt.Path = value
+			case "HEAD":
+				wt.Head = value
+			case "branch":
+				wt.Branch = strings.TrimPrefix(value, "refs/heads/")
+			case "locked":
+				wt.Locked = true
+			case "prunable":
+				wt.Prunable = true
+			}
+		}
+		if wt.Path == "" {
+			continue
+		}
+		// git lists the main working tree first
+		wt.Main = len(worktrees) == 0
+		worktrees = append(worktrees, wt)
+	}
+	return worktrees
+}
+
+// RemoveWorktree removes a worktree. Without force, git refuses if it has local changes.
+func RemoveWorktree(ctx context.Context, repoDir, path string, force bool) error {
+	args := []string{"worktree", "remove", path}
+	if force {
+		args = []string{"worktree", "remove", "--force", path}
+	}
+	if _, err := runGit(ctx, repoDir, args...); err != nil {
+		return fmt.Errorf("remove worktree %s: %w", path, err)
+	}
+	logger.Info("agents.worktree: removed worktree path=%s", path)
+	return nil
+}
+
+// PruneWorktrees drops registrations of worktrees whose directories are gone.
+func PruneWorktrees(ctx context.Context, repoDir string) error {
+	if _, err := runGit(ctx, repoDir, "worktree", "prune"); err != nil {
+		return fmt.Errorf("prune worktrees: %w", err)
+	}
+	return nil
+}
+
+// MergedBranches returns the local branches already merged into the HEAD of the repository's main working tree.
+func MergedBranches(ctx context.Context, repoDir string) (map[string]bool, error) {
+	out, err := runGit(ctx, repoDir, "branch", "--merged", "HEAD", "--format=%(refname:short)")
+	if err != nil {
+		return nil, fmt.Errorf("list merged branches: %w", err)
+	}
+	merged := make(map[string]bool)
+	for _, line := range strings.Split(out, "\n") {
+		if branch := strings.TrimSpace(line); branch != "" {
+			merged[branch] = true
+		}
+	}
+	return merged, nil
+}
+
+// IsWithin reports whether path is root or inside it.
+func IsWithin(root, path string) bool {
+	rel, err := filepath.Rel(filepath.Clean(root), filepath.Clean(path))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// sanitizeBranchDir turns a branch name into a single directory name.
+func sanitizeBranchDir(branch string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', ' ':
+			return '-'
+		}
+		return r
+	}, branch)
+}
+
+// runGit runs git in dir and returns stdout, folding stderr into the error.
+func runGit(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				return "", fmt.Errorf("git %s: %s", args[0], msg)
+			}
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,107 @@
+package agents
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// initTestRepo creates a git repository with one commit, skipping when git is unavailable.
+func initTestRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := filepath.Join(t.TempDir(), "repo")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("create repo dir: %v", err)
+	}
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "init"},
+	} {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	return dir
+}
+
+// TestEnsureWorktree_CreatesAndReuses verifies a worktree is created for a new branch and reused afterwards.
+func TestEnsureWorktree_CreatesAndReuses(t *testing.T) {
+	repo := initTestRepo(t)
+	root := t.TempDir()
+	ctx := context.Background()
+
+	path, created, err := EnsureWorktree(ctx, repo, root, "eng-1/fix-login")
+	if err != nil {
+		t.Fatalf("EnsureWorktree() error: %v", err)
+	}
+	if !created || path != filepath.Join(root, "repo", "eng-1-fix-login") {
+		t.Fatalf("EnsureWorktree() = %q, %v", path, created)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("worktree directory missing: %v", err)
+	}
+
+	again, created, err := EnsureWorktree(ctx, repo, root, "eng-1/fix-login")
+	if err != nil {
+		t.Fatalf("EnsureWorktree() second call error: %v", err)
+	}
+	if created || again != path {
+		t.Fatalf("second EnsureWorktree() = %q, %v; want reuse of %q", again, created, path)
+	}
+
+	worktrees, err := ListWorktrees(ctx, repo)
+	if err != nil {
+		t.Fatalf("ListWorktrees() error: %v", err)
+	}
+	if len(worktrees) != 2 || !worktrees[0].Main || worktrees[1].Branch != "eng-1/fix-login" {
+		t.Fatalf("ListWorktrees() = %+v", worktrees)
+	}
+
+	if _, _, err := EnsureWorktree(ctx, repo, root, ""); err == nil {
+		t.Fatal("expected error for empty branch name")
+	}
+}
+
+// TestRemoveWorktree_AndPruneStale verifies removal and detection of worktrees whose directory is gone.
+func TestRemoveWorktree_AndPruneStale(t *testing.T) {
+	repo := initTestRepo(t)
+	root := t.TempDir()
+	ctx := context.Background()
+
+	first, _, err := EnsureWorktree(ctx, repo, root, "eng-1")
+	if err != nil {
+		t.Fatalf("EnsureWorktree() error: %v", err)
+	}
+	second, _, err := EnsureWorktree(ctx, repo, root, "eng-2")
+	if err != nil {
+		t.Fatalf("EnsureWorktree() error: %v", err)
+	}
+
+	if err := RemoveWorktree(ctx, repo, first, false); err != nil {
+		t.Fatalf("RemoveWorktree() error: %v", err)
+	}
+	if err := os.RemoveAll(second); err != nil {
+		t.Fatalf("remove worktree dir: %v", err)
+	}
+
+	worktrees, err := ListWorktrees(ctx, repo)
+	if err != nil {
+		t.Fatalf("ListWorktrees() error: %v", err)
+	}
+	if len(worktrees) != 2 || !worktrees[1].Prunable {
+		t.Fatalf("expected the deleted worktree to be prunable: %+v", worktrees)
+	}
+
+	if err := PruneWorktrees(ctx, repo); err != nil {
+		t.Fatalf("PruneWorktrees() error: %v", err)
+	}
+	if worktrees, _ := ListWorktrees(ctx, repo); len(worktrees) != 1 {
+		t.Fatalf("expected only the main worktree after prune: %+v", worktrees)
+	}
+}
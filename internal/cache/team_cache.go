@@ -10,7 +10,7 @@ import (
 )
 
 // TeamCache provides TTL-based caching for team-scoped metadata.
-// It caches teams, users, projects, workflow states, and labels to reduce API calls.
+// It caches teams, users, projects, workflow states, labels, and cycles to reduce API calls.
 type TeamCache struct {
 	client *linearapi.Client
 	ttl    time.Duration
@@ -36,6 +36,9 @@ type TeamCache struct {
 	// Label caches (merged team + workspace labels per team)
 	labels       map[string][]linearapi.IssueLabel
 	labelsExpiry map[string]time.Time
+
+	cycles       map[string][]linearapi.Cycle
+	cyclesExpiry map[string]time.Time
 }
 
 // NewTeamCache creates a new team cache with the given client and TTL.
@@ -51,6 +54,8 @@ func NewTeamCache(client *linearapi.Client, ttl time.Duration) *TeamCache {
 		statesExpiry:   make(map[string]time.Time),
 		labels:         make(map[string][]linearapi.IssueLabel),
 		labelsExpiry:   make(map[string]time.Time),
+		cycles:         make(map[string][]linearapi.Cycle),
+		cyclesExpiry:   make(map[string]time.Time),
 	}
 }
 
@@ -159,6 +164,11 @@ func (c *TeamCache) GetIssueLabels(ctx context.Context, teamID string) ([]linear
 	return getCachedOrFetch(ctx, c, teamID, c.labels, c.labelsExpiry, c.client.ListIssueLabels)
 }
 
+// GetCycles returns cached cycles for a team or fetches them from the API.
+func (c *TeamCache) GetCycles(ctx context.Context, teamID string) ([]linearapi.Cycle, error) {
+	return getCachedOrFetch(ctx, c, teamID, c.cycles, c.cyclesExpiry, c.client.ListCycles)
+}
+
 // InvalidateTeams clears the teams cache.
 func (c *TeamCache) InvalidateTeams() {
 	c.mu.Lock()
@@ -199,6 +209,14 @@ func (c *TeamCache) InvalidateIssueLabels(teamID string) {
 	delete(c.labelsExpiry, teamID)
 }
 
+// InvalidateCycles clears the cycles cache for a specific team.
+func (c *TeamCache) InvalidateCycles(teamID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.cycles, teamID)
+	delete(c.cyclesExpiry, teamID)
+}
+
 // InvalidateAll clears all caches.
 func (c *TeamCache) InvalidateAll() {
 	c.mu.Lock()
@@ -217,6 +235,8 @@ func (c *TeamCache) InvalidateAll() {
 	c.statesExpiry = make(map[string]time.Time)
 	c.labels = make(map[string][]linearapi.IssueLabel)
 	c.labelsExpiry = make(map[string]time.Time)
+	c.cycles = make(map[string][]linearapi.Cycle)
+	c.cyclesExpiry = make(map[string]time.Time)
 }
 
 // PreloadTeamMetadata preloads all metadata for a team (users, projects, states, labels).
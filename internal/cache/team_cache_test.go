@@ -332,3 +332,22 @@ func TestNewTeamCache_LabelsInitialized(t *testing.T) {
 		t.Error("labelsExpiry map should be initialized")
 	}
 }
+
+func TestTeamCache_GetCycles_CacheHitAndInvalidate(t *testing.T) {
+	cache := NewTeamCache(nil, 5*time.Minute)
+	cache.cycles["team-1"] = []linearapi.Cycle{{ID: "cycle-1", Number: 12, IsActive: true}}
+	cache.cyclesExpiry["team-1"] = time.Now().Add(time.Hour)
+
+	cycles, err := cache.GetCycles(context.Background(), "team-1")
+	if err != nil {
+		t.Fatalf("GetCycles() error = %v", err)
+	}
+	if len(cycles) != 1 || cycles[0].ID != "cycle-1" {
+		t.Errorf("GetCycles() = %+v, want cached cycle-1", cycles)
+	}
+
+	cache.InvalidateCycles("team-1")
+	if _, ok := cache.cycles["team-1"]; ok {
+		t.Error("team-1 cycles should be removed")
+	}
+}
@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// Exit codes returned by Run.
+const (
+	ExitOK    = 0
+	ExitError = 1 // API or runtime failure
+	ExitUsage = 2 // invalid arguments
+)
+
+// Client is the subset of the Linear API client used by CLI commands.
+type Client interface {
+	FetchIssuesPage(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error)
+	FetchIssueByID(ctx context.Context, id string) (linearapi.Issue, error)
+	CreateIssue(ctx context.Context, input linearapi.CreateIssueInput) (linearapi.Issue, error)
+	UpdateIssue(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error)
+	CreateComment(ctx context.Context, input linearapi.CreateCommentInput) (linearapi.Comment, error)
+}
+
+// Metadata resolves team-scoped names to IDs. It is satisfied by *cache.TeamCache.
+type Metadata interface {
+	GetTeams(ctx context.Context) ([]linearapi.Team, error)
+	GetCurrentUser(ctx context.Context) (linearapi.User, error)
+	GetUsers(ctx context.Context, teamID string) ([]linearapi.User, error)
+	GetProjects(ctx context.Context, teamID string) ([]linearapi.Project, error)
+	GetWorkflowStates(ctx context.Context, teamID string) ([]linearapi.WorkflowState, error)
+	GetIssueLabels(ctx context.Context, teamID string) ([]linearapi.IssueLabel, error)
+}
+
+// Runner executes headless subcommands against the Linear API.
+type Runner struct {
+	Client   Client
+	Metadata Metadata
+	Stdin    io.Reader
+	Stdout   io.Writer
+	Stderr   io.Writer
+	// PageSize is the default number of issues listed (from settings).
+	PageSize int
+}
+
+// usageError reports invalid command-line arguments.
+type usageError struct {
+	msg string
+}
+
+// Error implements the error interface.
+func (e usageError) Error() string {
+	return e.msg
+}
+
+// usagef returns a usageError with a formatted message.
+func usagef(format string, args ...any) error {
+	return usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// IsCommand reports whether args start with a CLI subcommand rather than TUI flags.
+func IsCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	switch args[0] {
+	case "issue", "comment", "help", "--help", "-h":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsHelp reports whether args only ask for usage, which needs no API access.
+func IsHelp(args []string) bool {
+	return len(args) > 0 && (args[0] == "help" || args[0] == "--help" || args[0] == "-h")
+}
+
+// Usage writes the CLI usage text to w.
+func Usage(w io.Writer) {
+	fmt.Fprint(w, `Usage:
+  linear-tui                                   Start the terminal UI
+  linear-tui issue list [flags]                List issues
+  linear-tui issue show <ID> [flags]           Show an issue with comments
+  linear-tui issue create --team <TEAM> --title <TITLE> [flags]
+  linear-tui issue update <ID> [flags]         Update issue fields
+  linear-tui comment add <ID> --body <TEXT>    Add a comment ("-" reads stdin)
+
+Common flags:
+  -o, --output table|json|plain                Output format (default table)
+
+Run "linear-tui <command> <subcommand> --help" for command flags.
+`)
+}
+
+// Run executes the subcommand in args and returns the process exit code.
+func (r *Runner) Run(ctx context.Context, args []string) int {
+	err := r.dispatch(ctx, args)
+	if err == nil {
+		return ExitOK
+	}
+	if errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	}
+
+	var uErr usageError
+	if errors.As(err, &uErr) {
+		fmt.Fprintf(r.Stderr, "Error: %v\n\n", err)
+		Usage(r.Stderr)
+		return ExitUsage
+	}
+
+	logger.ErrorWithErr(err, "cli: command failed args=%s", strings.Join(args, " "))
+	fmt.Fprintf(r.Stderr, "Error: %v\n", err)
+	return ExitError
+}
+
+// dispatch routes args to the matching command handler.
+func (r *Runner) dispatch(ctx context.Context, args []string) error {
+	if len(args) == 0 || IsHelp(args) {
+		Usage(r.Stdout)
+		return nil
+	}
+	if len(args) < 2 {
+		return usagef("missing subcommand for %q", args[0])
+	}
+
+	group, sub, rest := args[0], args[1], args[2:]
+	switch group + " " + sub {
+	case "issue list":
+		return r.issueList(ctx, rest)
+	case "issue show":
+		return r.issueShow(ctx, rest)
+	case "issue create":
+		return r.issueCreate(ctx, rest)
+	case "issue update":
+		return r.issueUpdate(ctx, rest)
+	case "comment add":
+		return r.commentAdd(ctx, rest)
+	default:
+		return usagef("unknown command %q", group+" "+sub)
+	}
+}
+
+// newFlagSet creates a flag set that reports errors instead of exiting.
+func (r *Runner) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(r.Stderr)
+	return fs
+}
+
+// parseFlags parses args, accepting a single positional argument before or after the flags.
+// It returns the positional argument, which is required when wantPositional is set.
+func parseFlags(fs *flag.FlagSet, args []string, wantPositional string) (string, error) {
+	positional := ""
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		positional = args[0]
+		args = args[1:]
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return "", err
+		}
+		return "", usageError{msg: err.Error()}
+	}
+	if positional == "" && fs.NArg() > 0 {
+		positional = fs.Arg(0)
+		if err := fs.Parse(fs.Args()[1:]); err != nil {
+			return "", usageError{msg: err.Error()}
+		}
+	}
+	if fs.NArg() > 0 {
+		return "", usagef("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	if wantPositional != "" && positional == "" {
+		return "", usagef("%s: missing %s", fs.Name(), wantPositional)
+	}
+	if wantPositional == "" && positional != "" {
+		return "", usagef("%s: unexpected argument %q", fs.Name(), positional)
+	}
+	return positional, nil
+}
+
+// readText returns value, or all of stdin when value is "-".
+func (r *Runner) readText(value string) (string, error) {
+	if value != "-" {
+		return value, nil
+	}
+	if r.Stdin == nil {
+		return "", fmt.Errorf("stdin is not available")
+	}
+	data, err := io.ReadAll(r.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("read stdin: %w", err)
+	}
+	return strings.TrimRight(string(data), "\n"), nil
+}
@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// fakeClient is an in-memory Client for CLI tests.
+type fakeClient struct {
+	issues     map[string]linearapi.Issue
+	fetchErr   error
+	lastParams linearapi.FetchIssuesParams
+	lastUpdate linearapi.UpdateIssueInput
+	lastCreate linearapi.CreateIssueInput
+	comments   []linearapi.CreateCommentInput
+}
+
+func (f *fakeClient) FetchIssuesPage(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+	f.lastParams = params
+	if f.fetchErr != nil {
+		return linearapi.IssuePage{}, f.fetchErr
+	}
+	issues := make([]linearapi.Issue, 0, len(f.issues))
+	for _, issue := range f.issues {
+		issues = append(issues, issue)
+	}
+	return linearapi.IssuePage{Issues: issues}, nil
+}
+
+func (f *fakeClient) FetchIssueByID(ctx context.Context, id string) (linearapi.Issue, error) {
+	if f.fetchErr != nil {
+		return linearapi.Issue{}, f.fetchErr
+	}
+	for _, issue := range f.issues {
+		if issue.ID == id || issue.Identifier == id {
+			return issue, nil
+		}
+	}
+	return linearapi.Issue{}, errors.New("Entity not found")
+}
+
+func (f *fakeClient) CreateIssue(ctx context.Context, input linearapi.CreateIssueInput) (linearapi.Issue, error) {
+	f.lastCreate = input
+	return linearapi.Issue{ID: "issue-new", Identifier: "ENG-9", Title: input.Title, TeamID: input.TeamID}, nil
+}
+
+func (f *fakeClient) UpdateIssue(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+	f.lastUpdate = input
+	return f.issues[input.ID], nil
+}
+
+func (f *fakeClient) CreateComment(ctx context.Context, input linearapi.CreateCommentInput) (linearapi.Comment, error) {
+	f.comments = append(f.comments, input)
+	return linearapi.Comment{ID: "comment-1", IssueID: input.IssueID, Body: input.Body}, nil
+}
+
+// fakeMetadata serves fixed team metadata.
+type fakeMetadata struct{}
+
+func (fakeMetadata) GetTeams(ctx context.Context) ([]linearapi.Team, error) {
+	return []linearapi.Team{{ID: "team-1", Key: "ENG", Name: "Engineering"}}, nil
+}
+
+func (fakeMetadata) GetCurrentUser(ctx context.Context) (linearapi.User, error) {
+	return linearapi.User{ID: "user-me", Name: "Me", IsMe: true}, nil
+}
+
+func (fakeMetadata) GetUsers(ctx context.Context, teamID string) ([]linearapi.User, error) {
+	return []linearapi.User{{ID: "user-2", Name: "Alice Smith", DisplayName: "alice", Email: "alice@example.com"}}, nil
+}
+
+func (fakeMetadata) GetProjects(ctx context.Context, teamID string) ([]linearapi.Project, error) {
+	return []linearapi.Project{{ID: "project-1", Name: "Launch", TeamID: teamID}}, nil
+}
+
+func (fakeMetadata) GetWorkflowStates(ctx context.Context, teamID string) ([]linearapi.WorkflowState, error) {
+	return []linearapi.WorkflowState{{ID: "state-todo", Name: "Todo"}, {ID: "state-done", Name: "Done"}}, nil
+}
+
+func (fakeMetadata) GetIssueLabels(ctx context.Context, teamID string) ([]linearapi.IssueLabel, error) {
+	return []linearapi.IssueLabel{{ID: "label-bug", Name: "Bug"}}, nil
+}
+
+// newTestRunner returns a runner over a single issue plus its output buffers.
+func newTestRunner(stdin string) (*Runner, *fakeClient, *bytes.Buffer, *bytes.Buffer) {
+	client := &fakeClient{issues: map[string]linearapi.Issue{
+		"issue-1": {
+			ID: "issue-1", Identifier: "ENG-1", Title: "Fix login", State: "Todo", StateID: "state-todo",
+			Priority: 2, TeamID: "team-1", Labels: []linearapi.IssueLabel{{ID: "label-bug", Name: "Bug"}},
+			Comments: []linearapi.Comment{{ID: "comment-0", Body: "first", Author: linearapi.User{Name: "Bob"}}},
+		},
+	}}
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	runner := &Runner{
+		Client:   client,
+		Metadata: fakeMetadata{},
+		Stdin:    strings.NewReader(stdin),
+		Stdout:   stdout,
+		Stderr:   stderr,
+		PageSize: 25,
+	}
+	return runner, client, stdout, stderr
+}
+
+// TestRun_IssueListTable verifies filters are resolved and rendered as a table.
+func TestRun_IssueListTable(t *testing.T) {
+	runner, client, stdout, stderr := newTestRunner("")
+
+	code := runner.Run(context.Background(), []string{"issue", "list", "--team", "eng", "--state", "todo", "--assignee", "me"})
+	if code != ExitOK {
+		t.Fatalf("Run() = %d, stderr = %s", code, stderr.String())
+	}
+	params := client.lastParams
+	if params.TeamID != "team-1" || params.StateID != "state-todo" || params.AssigneeID != "user-me" || params.First != 25 {
+		t.Fatalf("FetchIssuesPage params = %+v", params)
+	}
+	out := stdout.String()
+	if !strings.Contains(out, "ID") || !strings.Contains(out, "ENG-1") || !strings.Contains(out, "High") {
+		t.Fatalf("table output = %q", out)
+	}
+}
+
+// TestRun_IssueShowJSON verifies JSON output includes comments, with flags after the ID.
+func TestRun_IssueShowJSON(t *testing.T) {
+	runner, _, stdout, stderr := newTestRunner("")
+
+	code := runner.Run(context.Background(), []string{"issue", "show", "ENG-1", "-o", "json"})
+	if code != ExitOK {
+		t.Fatalf("Run() = %d, stderr = %s", code, stderr.String())
+	}
+	var got issueJSON
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v, output = %s", err, stdout.String())
+	}
+	if got.Identifier != "ENG-1" || got.PriorityStr != "High" || len(got.Comments) != 1 || got.Labels[0] != "Bug" {
+		t.Fatalf("issue JSON = %+v", got)
+	}
+}
+
+// TestRun_IssueUpdate verifies only provided fields are sent and names resolve within the issue's team.
+func TestRun_IssueUpdate(t *testing.T) {
+	runner, client, stdout, stderr := newTestRunner("")
+
+	code := runner.Run(context.Background(), []string{"issue", "update", "ENG-1", "--state", "Done", "--assignee", "none", "--labels", "bug", "-o", "plain"})
+	if code != ExitOK {
+		t.Fatalf("Run() = %d, stderr = %s", code, stderr.String())
+	}
+	input := client.lastUpdate
+	if input.ID != "issue-1" || input.StateID == nil || *input.StateID != "state-done" {
+		t.Fatalf("update input = %+v", input)
+	}
+	if input.AssigneeID == nil || *input.AssigneeID != "" {
+		t.Fatalf("AssigneeID = %v, want cleared", input.AssigneeID)
+	}
+	if input.LabelIDs == nil || len(*input.LabelIDs) != 1 || (*input.LabelIDs)[0] != "label-bug" {
+		t.Fatalf("LabelIDs = %v", input.LabelIDs)
+	}
+	if input.Title != nil || input.Priority != nil {
+		t.Fatalf("unexpected fields set: %+v", input)
+	}
+	if !strings.HasPrefix(stdout.String(), "ENG-1\t") {
+		t.Fatalf("plain output = %q", stdout.String())
+	}
+}
+
+// TestRun_IssueCreate verifies create resolves team, assignee and priority names.
+func TestRun_IssueCreate(t *testing.T) {
+	runner, client, _, stderr := newTestRunner("")
+
+	code := runner.Run(context.Background(), []string{"issue", "create", "--team", "ENG", "--title", "New bug", "--assignee", "alice@example.com", "--priority", "urgent", "--parent", "ENG-1"})
+	if code != ExitOK {
+		t.Fatalf("Run() = %d, stderr = %s", code, stderr.String())
+	}
+	input := client.lastCreate
+	if input.TeamID != "team-1" || input.AssigneeID != "user-2" || input.Priority != 1 || input.ParentID != "issue-1" {
+		t.Fatalf("create input = %+v", input)
+	}
+}
+
+// TestRun_CommentAddFromStdin verifies "-" reads the comment body from stdin.
+func TestRun_CommentAddFromStdin(t *testing.T) {
+	runner, client, stdout, stderr := newTestRunner("Looks good\n")
+
+	code := runner.Run(context.Background(), []string{"comment", "add", "ENG-1", "--body", "-"})
+	if code != ExitOK {
+		t.Fatalf("Run() = %d, stderr = %s", code, stderr.String())
+	}
+	if len(client.comments) != 1 || client.comments[0].IssueID != "issue-1" || client.comments[0].Body != "Looks good" {
+		t.Fatalf("comments = %+v", client.comments)
+	}
+	if !strings.Contains(stdout.String(), "comment-1") {
+		t.Fatalf("output = %q", stdout.String())
+	}
+}
+
+// TestRun_ExitCodes verifies usage and API failures map to distinct exit codes.
+func TestRun_ExitCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		fetchErr error
+		want     int
+	}{
+		{name: "unknown command", args: []string{"issue", "delete"}, want: ExitUsage},
+		{name: "missing issue ID", args: []string{"issue", "show"}, want: ExitUsage},
+		{name: "nothing to update", args: []string{"issue", "update", "ENG-1"}, want: ExitUsage},
+		{name: "bad output format", args: []string{"issue", "list", "-o", "yaml"}, want: ExitUsage},
+		{name: "unknown team", args: []string{"issue", "list", "--team", "OPS"}, want: ExitError},
+		{name: "api error", args: []string{"issue", "show", "ENG-1"}, fetchErr: errors.New("boom"), want: ExitError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner, client, _, stderr := newTestRunner("")
+			client.fetchErr = tt.fetchErr
+
+			if got := runner.Run(context.Background(), tt.args); got != tt.want {
+				t.Fatalf("Run() = %d, want %d (stderr = %s)", got, tt.want, stderr.String())
+			}
+			if !strings.Contains(stderr.String(), "Error:") {
+				t.Fatalf("stderr = %q, want an error message", stderr.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// commentAdd handles "comment add <ID>".
+func (r *Runner) commentAdd(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("comment add")
+	body := fs.String("body", "", `markdown comment body ("-" reads stdin, required)`)
+	format := outputFlag(fs)
+	id, err := parseFlags(fs, args, "issue ID")
+	if err != nil {
+		return err
+	}
+	if err := validateFormat(*format); err != nil {
+		return err
+	}
+	if *body == "" {
+		return usagef("comment add requires --body")
+	}
+
+	text, err := r.readText(*body)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(text) == "" {
+		return usagef("comment body is empty")
+	}
+
+	// Resolve identifiers such as ENG-123 to the issue ID expected by commentCreate.
+	issue, err := r.Client.FetchIssueByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("fetch issue %s: %w", id, err)
+	}
+
+	comment, err := r.Client.CreateComment(ctx, linearapi.CreateCommentInput{
+		IssueID: issue.ID,
+		Body:    text,
+	})
+	if err != nil {
+		return fmt.Errorf("add comment to %s: %w", issue.Identifier, err)
+	}
+	return writeComment(r.Stdout, *format, comment)
+}
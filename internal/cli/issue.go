@@ -0,0 +1,267 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// defaultListLimit is used when neither --limit nor a configured page size is set.
+const defaultListLimit = 50
+
+// issueList handles "issue list".
+func (r *Runner) issueList(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("issue list")
+	team := fs.String("team", "", "team key, name or ID")
+	project := fs.String("project", "", "project name or ID (requires --team)")
+	state := fs.String("state", "", "workflow state name or ID (requires --team)")
+	assignee := fs.String("assignee", "", `assignee name, email or "me"`)
+	search := fs.String("search", "", "full-text search")
+	sortBy := fs.String("sort", "updated", "sort order: updated, created or priority")
+	limit := fs.Int("limit", r.PageSize, "maximum number of issues")
+	format := outputFlag(fs)
+	if _, err := parseFlags(fs, args, ""); err != nil {
+		return err
+	}
+	if err := validateFormat(*format); err != nil {
+		return err
+	}
+
+	params := linearapi.FetchIssuesParams{Search: *search}
+	switch *sortBy {
+	case "updated":
+		params.OrderBy = string(linearapi.OrderByUpdatedAt)
+	case "created":
+		params.OrderBy = string(linearapi.OrderByCreatedAt)
+	case "priority":
+		params.OrderBy = "priority"
+	default:
+		return usagef("invalid sort %q (want updated, created or priority)", *sortBy)
+	}
+	params.First = *limit
+	if params.First <= 0 {
+		params.First = defaultListLimit
+	}
+
+	if (*project != "" || *state != "") && *team == "" {
+		return usagef("--project and --state require --team")
+	}
+	if *team != "" {
+		resolved, err := r.resolveTeam(ctx, *team)
+		if err != nil {
+			return err
+		}
+		params.TeamID = resolved.ID
+	}
+	if *project != "" {
+		id, err := r.resolveProject(ctx, params.TeamID, *project)
+		if err != nil {
+			return err
+		}
+		params.ProjectID = id
+	}
+	if *state != "" {
+		id, err := r.resolveState(ctx, params.TeamID, *state)
+		if err != nil {
+			return err
+		}
+		params.StateID = id
+	}
+	if *assignee != "" {
+		id, err := r.resolveUser(ctx, params.TeamID, *assignee)
+		if err != nil {
+			return err
+		}
+		params.AssigneeID = id
+	}
+
+	page, err := r.Client.FetchIssuesPage(ctx, params, nil)
+	if err != nil {
+		return fmt.Errorf("list issues: %w", err)
+	}
+	issues := page.Issues
+	if len(issues) > params.First {
+		issues = issues[:params.First]
+	}
+	return writeIssues(r.Stdout, *format, issues)
+}
+
+// issueShow handles "issue show <ID>".
+func (r *Runner) issueShow(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("issue show")
+	format := outputFlag(fs)
+	id, err := parseFlags(fs, args, "issue ID")
+	if err != nil {
+		return err
+	}
+	if err := validateFormat(*format); err != nil {
+		return err
+	}
+
+	issue, err := r.Client.FetchIssueByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("fetch issue %s: %w", id, err)
+	}
+	return writeIssue(r.Stdout, *format, issue)
+}
+
+// issueCreate handles "issue create".
+func (r *Runner) issueCreate(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("issue create")
+	team := fs.String("team", "", "team key, name or ID (required)")
+	title := fs.String("title", "", "issue title (required)")
+	description := fs.String("description", "", `markdown description ("-" reads stdin)`)
+	project := fs.String("project", "", "project name or ID")
+	state := fs.String("state", "", "workflow state name or ID")
+	assignee := fs.String("assignee", "", `assignee name, email or "me"`)
+	priority := fs.String("priority", "", "priority: 0-4 or none, urgent, high, normal, low")
+	parent := fs.String("parent", "", "parent issue ID")
+	format := outputFlag(fs)
+	if _, err := parseFlags(fs, args, ""); err != nil {
+		return err
+	}
+	if err := validateFormat(*format); err != nil {
+		return err
+	}
+	if *team == "" || *title == "" {
+		return usagef("issue create requires --team and --title")
+	}
+
+	resolvedTeam, err := r.resolveTeam(ctx, *team)
+	if err != nil {
+		return err
+	}
+	input := linearapi.CreateIssueInput{TeamID: resolvedTeam.ID, Title: *title}
+	if input.Description, err = r.readText(*description); err != nil {
+		return err
+	}
+	if *project != "" {
+		if input.ProjectID, err = r.resolveProject(ctx, resolvedTeam.ID, *project); err != nil {
+			return err
+		}
+	}
+	if *state != "" {
+		if input.StateID, err = r.resolveState(ctx, resolvedTeam.ID, *state); err != nil {
+			return err
+		}
+	}
+	if *assignee != "" {
+		if input.AssigneeID, err = r.resolveUser(ctx, resolvedTeam.ID, *assignee); err != nil {
+			return err
+		}
+	}
+	if *priority != "" {
+		if input.Priority, err = parsePriority(*priority); err != nil {
+			return err
+		}
+	}
+	if *parent != "" {
+		parentIssue, err := r.Client.FetchIssueByID(ctx, *parent)
+		if err != nil {
+			return fmt.Errorf("fetch parent issue %s: %w", *parent, err)
+		}
+		input.ParentID = parentIssue.ID
+	}
+
+	issue, err := r.Client.CreateIssue(ctx, input)
+	if err != nil {
+		return fmt.Errorf("create issue: %w", err)
+	}
+	return writeIssue(r.Stdout, *format, issue)
+}
+
+// issueUpdate handles "issue update <ID>".
+func (r *Runner) issueUpdate(ctx context.Context, args []string) error {
+	fs := r.newFlagSet("issue update")
+	title := fs.String("title", "", "new title")
+	description := fs.String("description", "", `new markdown description ("-" reads stdin)`)
+	state := fs.String("state", "", "workflow state name or ID")
+	assignee := fs.String("assignee", "", `assignee name, email, "me" or "none"`)
+	priority := fs.String("priority", "", "priority: 0-4 or none, urgent, high, normal, low")
+	labels := fs.String("labels", "", `comma-separated label names (replaces existing; "none" clears)`)
+	parent := fs.String("parent", "", `parent issue ID or "none"`)
+	format := outputFlag(fs)
+	id, err := parseFlags(fs, args, "issue ID")
+	if err != nil {
+		return err
+	}
+	if err := validateFormat(*format); err != nil {
+		return err
+	}
+
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if !set["title"] && !set["description"] && !set["state"] && !set["assignee"] &&
+		!set["priority"] && !set["labels"] && !set["parent"] {
+		return usagef("issue update: nothing to update")
+	}
+
+	// Fetch first to resolve the identifier and scope name lookups to the issue's team.
+	issue, err := r.Client.FetchIssueByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("fetch issue %s: %w", id, err)
+	}
+
+	input := linearapi.UpdateIssueInput{ID: issue.ID}
+	if set["title"] {
+		input.Title = title
+	}
+	if set["description"] {
+		text, err := r.readText(*description)
+		if err != nil {
+			return err
+		}
+		input.Description = &text
+	}
+	if set["state"] {
+		stateID, err := r.resolveState(ctx, issue.TeamID, *state)
+		if err != nil {
+			return err
+		}
+		input.StateID = &stateID
+	}
+	if set["assignee"] {
+		assigneeID := ""
+		if !isNone(*assignee) {
+			if assigneeID, err = r.resolveUser(ctx, issue.TeamID, *assignee); err != nil {
+				return err
+			}
+		}
+		input.AssigneeID = &assigneeID
+	}
+	if set["priority"] {
+		p, err := parsePriority(*priority)
+		if err != nil {
+			return err
+		}
+		input.Priority = &p
+	}
+	if set["labels"] {
+		labelIDs := []string{}
+		if !isNone(*labels) {
+			if labelIDs, err = r.resolveLabels(ctx, issue.TeamID, *labels); err != nil {
+				return err
+			}
+		}
+		input.LabelIDs = &labelIDs
+	}
+	if set["parent"] {
+		parentID := ""
+		if !isNone(*parent) {
+			parentIssue, err := r.Client.FetchIssueByID(ctx, *parent)
+			if err != nil {
+				return fmt.Errorf("fetch parent issue %s: %w", *parent, err)
+			}
+			parentID = parentIssue.ID
+		}
+		input.ParentID = &parentID
+	}
+
+	updated, err := r.Client.UpdateIssue(ctx, input)
+	if err != nil {
+		return fmt.Errorf("update issue %s: %w", issue.Identifier, err)
+	}
+	return writeIssue(r.Stdout, *format, updated)
+}
@@ -0,0 +1,227 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// Output formats accepted by --output.
+const (
+	FormatTable = "table"
+	FormatJSON  = "json"
+	FormatPlain = "plain"
+)
+
+// outputFlag registers -o/--output on fs and returns the bound value.
+func outputFlag(fs *flag.FlagSet) *string {
+	format := fs.String("output", FormatTable, "output format: table, json or plain")
+	fs.StringVar(format, "o", FormatTable, "shorthand for --output")
+	return format
+}
+
+// validateFormat checks an --output value.
+func validateFormat(format string) error {
+	switch format {
+	case FormatTable, FormatJSON, FormatPlain:
+		return nil
+	default:
+		return usagef("invalid output format %q (want table, json or plain)", format)
+	}
+}
+
+// issueJSON is the stable JSON representation of an issue.
+type issueJSON struct {
+	ID          string        `json:"id"`
+	Identifier  string        `json:"identifier"`
+	Title       string        `json:"title"`
+	Description string        `json:"description,omitempty"`
+	State       string        `json:"state"`
+	StateID     string        `json:"state_id"`
+	Assignee    string        `json:"assignee,omitempty"`
+	AssigneeID  string        `json:"assignee_id,omitempty"`
+	Priority    int           `json:"priority"`
+	PriorityStr string        `json:"priority_label"`
+	TeamID      string        `json:"team_id"`
+	ProjectID   string        `json:"project_id,omitempty"`
+	Labels      []string      `json:"labels"`
+	Parent      string        `json:"parent,omitempty"`
+	URL         string        `json:"url"`
+	BranchName  string        `json:"branch_name,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+	Comments    []commentJSON `json:"comments,omitempty"`
+}
+
+// commentJSON is the stable JSON representation of a comment.
+type commentJSON struct {
+	ID        string    `json:"id"`
+	IssueID   string    `json:"issue_id,omitempty"`
+	Author    string    `json:"author,omitempty"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// toIssueJSON converts an issue to its JSON representation.
+func toIssueJSON(issue linearapi.Issue) issueJSON {
+	out := issueJSON{
+		ID:          issue.ID,
+		Identifier:  issue.Identifier,
+		Title:       issue.Title,
+		Description: issue.Description,
+		State:       issue.State,
+		StateID:     issue.StateID,
+		Assignee:    issue.Assignee,
+		AssigneeID:  issue.AssigneeID,
+		Priority:    issue.Priority,
+		PriorityStr: linearapi.PriorityLabel(issue.Priority),
+		TeamID:      issue.TeamID,
+		ProjectID:   issue.ProjectID,
+		Labels:      labelNames(issue.Labels),
+		URL:         issue.URL,
+		BranchName:  issue.BranchName,
+		CreatedAt:   issue.CreatedAt,
+		UpdatedAt:   issue.UpdatedAt,
+	}
+	if issue.Parent != nil {
+		out.Parent = issue.Parent.Identifier
+	}
+	for _, comment := range issue.Comments {
+		out.Comments = append(out.Comments, toCommentJSON(comment))
+	}
+	return out
+}
+
+// toCommentJSON converts a comment to its JSON representation.
+func toCommentJSON(comment linearapi.Comment) commentJSON {
+	return commentJSON{
+		ID:        comment.ID,
+		IssueID:   comment.IssueID,
+		Author:    commentAuthor(comment),
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+	}
+}
+
+// labelNames returns label names, never nil so JSON renders an empty array.
+func labelNames(labels []linearapi.IssueLabel) []string {
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+	return names
+}
+
+// commentAuthor returns the best available display name for a comment author.
+func commentAuthor(comment linearapi.Comment) string {
+	if comment.Author.DisplayName != "" {
+		return comment.Author.DisplayName
+	}
+	return comment.Author.Name
+}
+
+// writeJSON writes v as indented JSON.
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// issueRecord returns the columns shown for an issue in table and plain output.
+func issueRecord(issue linearapi.Issue) []string {
+	assignee := issue.Assignee
+	if assignee == "" {
+		assignee = "Unassigned"
+	}
+	return []string{issue.Identifier, issue.State, linearapi.PriorityLabel(issue.Priority), assignee, issue.Title}
+}
+
+// writeIssues renders a list of issues in the requested format.
+func writeIssues(w io.Writer, format string, issues []linearapi.Issue) error {
+	switch format {
+	case FormatJSON:
+		out := make([]issueJSON, 0, len(issues))
+		for _, issue := range issues {
+			out = append(out, toIssueJSON(issue))
+		}
+		return writeJSON(w, out)
+	case FormatPlain:
+		for _, issue := range issues {
+			if _, err := fmt.Fprintln(w, strings.Join(issueRecord(issue), "\t")); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tSTATE\tPRIORITY\tASSIGNEE\tTITLE")
+		for _, issue := range issues {
+			fmt.Fprintln(tw, strings.Join(issueRecord(issue), "\t"))
+		}
+		return tw.Flush()
+	}
+}
+
+// writeIssue renders a single issue, including description and comments, in the requested format.
+func writeIssue(w io.Writer, format string, issue linearapi.Issue) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, toIssueJSON(issue))
+	case FormatPlain:
+		_, err := fmt.Fprintln(w, strings.Join(issueRecord(issue), "\t"))
+		return err
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		record := issueRecord(issue)
+		fmt.Fprintf(tw, "ID:\t%s\n", record[0])
+		fmt.Fprintf(tw, "Title:\t%s\n", issue.Title)
+		fmt.Fprintf(tw, "State:\t%s\n", record[1])
+		fmt.Fprintf(tw, "Priority:\t%s\n", record[2])
+		fmt.Fprintf(tw, "Assignee:\t%s\n", record[3])
+		if len(issue.Labels) > 0 {
+			fmt.Fprintf(tw, "Labels:\t%s\n", strings.Join(labelNames(issue.Labels), ", "))
+		}
+		if issue.Parent != nil {
+			fmt.Fprintf(tw, "Parent:\t%s %s\n", issue.Parent.Identifier, issue.Parent.Title)
+		}
+		if !issue.UpdatedAt.IsZero() {
+			fmt.Fprintf(tw, "Updated:\t%s\n", issue.UpdatedAt.Local().Format("2006-01-02 15:04"))
+		}
+		if issue.URL != "" {
+			fmt.Fprintf(tw, "URL:\t%s\n", issue.URL)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+		if issue.Description != "" {
+			fmt.Fprintf(w, "\n%s\n", issue.Description)
+		}
+		if len(issue.Comments) > 0 {
+			fmt.Fprintf(w, "\nComments (%d):\n", len(issue.Comments))
+			for _, comment := range issue.Comments {
+				fmt.Fprintf(w, "\n%s · %s\n%s\n", commentAuthor(comment), comment.CreatedAt.Local().Format("2006-01-02 15:04"), comment.Body)
+			}
+		}
+		return nil
+	}
+}
+
+// writeComment renders a created comment in the requested format.
+func writeComment(w io.Writer, format string, comment linearapi.Comment) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, toCommentJSON(comment))
+	case FormatPlain:
+		_, err := fmt.Fprintln(w, comment.ID)
+		return err
+	default:
+		_, err := fmt.Fprintf(w, "Comment added (%s)\n", comment.ID)
+		return err
+	}
+}
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// resolveTeam finds a team by ID, key or name (case-insensitive).
+func (r *Runner) resolveTeam(ctx context.Context, ref string) (linearapi.Team, error) {
+	teams, err := r.Metadata.GetTeams(ctx)
+	if err != nil {
+		return linearapi.Team{}, fmt.Errorf("list teams: %w", err)
+	}
+	for _, team := range teams {
+		if team.ID == ref || strings.EqualFold(team.Key, ref) || strings.EqualFold(team.Name, ref) {
+			return team, nil
+		}
+	}
+	return linearapi.Team{}, fmt.Errorf("team %q not found", ref)
+}
+
+// resolveState finds a workflow state of a team by ID or name.
+func (r *Runner) resolveState(ctx context.Context, teamID, ref string) (string, error) {
+	states, err := r.Metadata.GetWorkflowStates(ctx, teamID)
+	if err != nil {
+		return "", fmt.Errorf("list workflow states: %w", err)
+	}
+	for _, state := range states {
+		if state.ID == ref || strings.EqualFold(state.Name, ref) {
+			return state.ID, nil
+		}
+	}
+	return "", fmt.Errorf("state %q not found", ref)
+}
+
+// resolveUser finds a team member by ID, email, display name or name. "me" is the current user.
+func (r *Runner) resolveUser(ctx context.Context, teamID, ref string) (string, error) {
+	if strings.EqualFold(ref, "me") {
+		user, err := r.Metadata.GetCurrentUser(ctx)
+		if err != nil {
+			return "", fmt.Errorf("get current user: %w", err)
+		}
+		return user.ID, nil
+	}
+	users, err := r.Metadata.GetUsers(ctx, teamID)
+	if err != nil {
+		return "", fmt.Errorf("list users: %w", err)
+	}
+	for _, user := range users {
+		if user.ID == ref || strings.EqualFold(user.Email, ref) ||
+			strings.EqualFold(user.DisplayName, ref) || strings.EqualFold(user.Name, ref) {
+			return user.ID, nil
+		}
+	}
+	return "", fmt.Errorf("user %q not found", ref)
+}
+
+// resolveProject finds a team project by ID or name.
+func (r *Runner) resolveProject(ctx context.Context, teamID, ref string) (string, error) {
+	projects, err := r.Metadata.GetProjects(ctx, teamID)
+	if err != nil {
+		return "", fmt.Errorf("list projects: %w", err)
+	}
+	for _, project := range projects {
+		if project.ID == ref || strings.EqualFold(project.Name, ref) {
+			return project.ID, nil
+		}
+	}
+	return "", fmt.Errorf("project %q not found", ref)
+}
+
+// resolveLabels maps a comma-separated list of label names or IDs to label IDs.
+func (r *Runner) resolveLabels(ctx context.Context, teamID, refs string) ([]string, error) {
+	ids := make([]string, 0)
+	if strings.TrimSpace(refs) == "" {
+		return ids, nil
+	}
+	labels, err := r.Metadata.GetIssueLabels(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("list labels: %w", err)
+	}
+	for _, ref := range strings.Split(refs, ",") {
+		ref = strings.TrimSpace(ref)
+		found := false
+		for _, label := range labels {
+			if label.ID == ref || strings.EqualFold(label.Name, ref) {
+				ids = append(ids, label.ID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("label %q not found", ref)
+		}
+	}
+	return ids, nil
+}
+
+// parsePriority accepts 0-4 or a priority name (none, urgent, high, normal/medium, low).
+func parsePriority(value string) (int, error) {
+	if n, err := strconv.Atoi(value); err == nil {
+		if n < 0 || n > 4 {
+			return 0, usagef("priority must be between 0 and 4, got %d", n)
+		}
+		return n, nil
+	}
+	switch strings.ToLower(value) {
+	case "none", "no priority":
+		return 0, nil
+	case "urgent":
+		return 1, nil
+	case "high":
+		return 2, nil
+	case "normal", "medium":
+		return 3, nil
+	case "low":
+		return 4, nil
+	default:
+		return 0, usagef("invalid priority %q", value)
+	}
+}
+
+// isNone reports whether a flag value asks to clear a field.
+func isNone(value string) bool {
+	return strings.EqualFold(value, "none")
+}
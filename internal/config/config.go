@@ -33,12 +33,64 @@ const (
 	DensityComfortable = "comfortable"
 	DensityCompact     = "compact"
 	DefaultDensity     = DensityComfortable
+	// DefaultAgentConcurrency is how many agent jobs may run at once.
+	DefaultAgentConcurrency = 2
+	// DefaultPollInterval is how often the issues list is checked for changes; zero disables polling.
+	DefaultPollInterval = time.Minute
+	// MinPollInterval is the shortest allowed polling interval.
+	MinPollInterval = 10 * time.Second
+	// Notification alert styles emitted when new inbox notifications arrive.
+	NotificationAlertNone    = "none"
+	NotificationAlertBell    = "bell"
+	NotificationAlertOSC9    = "osc9"
+	NotificationAlertOSC777  = "osc777"
+	DefaultNotificationAlert = NotificationAlertNone
 )
 
 // AgentCommand defines a user-configurable agent command.
 type AgentCommand struct {
-	Name    string `json:"name"`    // Display name, e.g. "Claude (skip permissions)"
-	Command string `json:"command"` // Command template with {prompt} placeholder
+	Name        string `json:"name"`                   // Display name, e.g. "Claude (skip permissions)"
+	Command     string `json:"command"`                // Command template with {prompt} placeholder
+	PostSummary bool   `json:"post_summary,omitempty"` // Comment the run summary on the issue when it succeeds
+	// Lifecycle sets issue fields when runs start and end. Nil uses DefaultAgentLifecycle;
+	// an empty object disables all changes.
+	Lifecycle *AgentLifecycle `json:"lifecycle,omitempty"`
+}
+
+// AgentLifecycle holds the issue changes applied at each point of an agent run.
+type AgentLifecycle struct {
+	OnStart   *AgentLifecycleAction `json:"on_start,omitempty"`
+	OnSuccess *AgentLifecycleAction `json:"on_success,omitempty"`
+	OnFailure *AgentLifecycleAction `json:"on_failure,omitempty"` // Not applied to cancelled runs
+}
+
+// AgentLifecycleAction describes issue changes. Empty fields leave the issue unchanged.
+type AgentLifecycleAction struct {
+	State        string   `json:"state,omitempty"`         // Workflow state name, or a state type such as "started"
+	Assignee     string   `json:"assignee,omitempty"`      // "me", "none", or a user's name, display name or email
+	AddLabels    []string `json:"add_labels,omitempty"`    // Label names to add
+	RemoveLabels []string `json:"remove_labels,omitempty"` // Label names to remove
+}
+
+// IsEmpty reports whether the action changes nothing.
+func (a *AgentLifecycleAction) IsEmpty() bool {
+	return a == nil || (a.State == "" && a.Assignee == "" && len(a.AddLabels) == 0 && len(a.RemoveLabels) == 0)
+}
+
+// DefaultAgentLifecycle moves the issue to a started state and assigns it to the
+// current user when a run starts.
+func DefaultAgentLifecycle() AgentLifecycle {
+	return AgentLifecycle{
+		OnStart: &AgentLifecycleAction{State: "started", Assignee: "me"},
+	}
+}
+
+// EffectiveLifecycle returns the command's lifecycle, or the default when unset.
+func (c AgentCommand) EffectiveLifecycle() AgentLifecycle {
+	if c.Lifecycle == nil {
+		return DefaultAgentLifecycle()
+	}
+	return *c.Lifecycle
 }
 
 // DefaultAgentCommands returns the default set of agent commands.
@@ -76,6 +128,9 @@ type Config struct {
 	// CacheTTL is the time-to-live for cached team metadata.
 	CacheTTL time.Duration
 
+	// PollInterval is how often the issues list is checked for changes (zero disables polling).
+	PollInterval time.Duration
+
 	// LogFile is the path to the log file (empty to disable logging).
 	LogFile string
 
@@ -93,6 +148,24 @@ type Config struct {
 
 	// AgentWorkspace is the default workspace path for agent runs.
 	AgentWorkspace string
+
+	// AgentConcurrency is the maximum number of agent jobs running at once.
+	AgentConcurrency int
+
+	// AgentWorktrees runs agents in a git worktree per issue branch by default.
+	AgentWorktrees bool
+
+	// AgentWorktreeRoot is where per-issue worktrees are created (empty uses ~/.linear-tui/worktrees).
+	AgentWorktreeRoot string
+
+	// WebhookListen is the local address receiving Linear webhooks for live updates (empty disables them).
+	WebhookListen string
+
+	// WebhookSecret is the Linear webhook signing secret used to verify deliveries.
+	WebhookSecret string
+
+	// NotificationAlert is how new inbox notifications are announced (none, bell, osc9, osc777).
+	NotificationAlert string
 }
 
 // LoadFromEnv loads configuration from environment variables.
@@ -105,17 +178,20 @@ func LoadFromEnv() (Config, error) {
 	}
 
 	cfg := Config{
-		LinearAPIKey:   apiKey,
-		APIEndpoint:    DefaultAPIEndpoint,
-		Timeout:        DefaultTimeout,
-		PageSize:       DefaultPageSize,
-		CacheTTL:       DefaultCacheTTL,
-		LogFile:        getDefaultLogFile(), // Default: $HOME/.linear-tui/app.log
-		LogLevel:       DefaultLogLevel,
-		Theme:          DefaultTheme,
-		Density:        DefaultDensity,
-		AgentCommands:  DefaultAgentCommands(),
-		AgentWorkspace: "",
+		LinearAPIKey:      apiKey,
+		APIEndpoint:       DefaultAPIEndpoint,
+		Timeout:           DefaultTimeout,
+		PageSize:          DefaultPageSize,
+		CacheTTL:          DefaultCacheTTL,
+		PollInterval:      DefaultPollInterval,
+		LogFile:           getDefaultLogFile(), // Default: $HOME/.linear-tui/app.log
+		LogLevel:          DefaultLogLevel,
+		Theme:             DefaultTheme,
+		Density:           DefaultDensity,
+		AgentCommands:     DefaultAgentCommands(),
+		AgentWorkspace:    "",
+		AgentConcurrency:  DefaultAgentConcurrency,
+		NotificationAlert: DefaultNotificationAlert,
 	}
 
 	// Parse optional API endpoint override.
@@ -12,16 +12,23 @@ import (
 
 // SettingsFile represents the on-disk JSON with optional fields.
 type SettingsFile struct {
-	APIEndpoint    *string         `json:"api_endpoint"`
-	Timeout        *string         `json:"timeout"`
-	PageSize       *int            `json:"page_size"`
-	CacheTTL       *string         `json:"cache_ttl"`
-	LogFile        *string         `json:"log_file"`
-	LogLevel       *string         `json:"log_level"`
-	Theme          *string         `json:"theme"`
-	Density        *string         `json:"density"`
-	AgentCommands  *[]AgentCommand `json:"agent_commands"`
-	AgentWorkspace *string         `json:"agent_workspace"`
+	APIEndpoint       *string         `json:"api_endpoint"`
+	Timeout           *string         `json:"timeout"`
+	PageSize          *int            `json:"page_size"`
+	CacheTTL          *string         `json:"cache_ttl"`
+	PollInterval      *string         `json:"poll_interval"`
+	LogFile           *string         `json:"log_file"`
+	LogLevel          *string         `json:"log_level"`
+	Theme             *string         `json:"theme"`
+	Density           *string         `json:"density"`
+	AgentCommands     *[]AgentCommand `json:"agent_commands"`
+	AgentWorkspace    *string         `json:"agent_workspace"`
+	AgentConcurrency  *int            `json:"agent_concurrency"`
+	AgentWorktrees    *bool           `json:"agent_worktrees"`
+	AgentWorktreeRoot *string         `json:"agent_worktree_root"`
+	WebhookListen     *string         `json:"webhook_listen"`
+	WebhookSecret     *string         `json:"webhook_secret"`
+	NotificationAlert *string         `json:"notification_alert"`
 	// Legacy fields (read-only for migration)
 	AgentProvider *string `json:"agent_provider"`
 	AgentSandbox  *string `json:"agent_sandbox"`
@@ -30,47 +37,68 @@ type SettingsFile struct {
 
 // Settings contains concrete settings values for UI and persistence.
 type Settings struct {
-	APIEndpoint    string         `json:"api_endpoint"`
-	Timeout        string         `json:"timeout"`
-	PageSize       int            `json:"page_size"`
-	CacheTTL       string         `json:"cache_ttl"`
-	LogFile        string         `json:"log_file"`
-	LogLevel       string         `json:"log_level"`
-	Theme          string         `json:"theme"`
-	Density        string         `json:"density"`
-	AgentCommands  []AgentCommand `json:"agent_commands"`
-	AgentWorkspace string         `json:"agent_workspace"`
+	APIEndpoint       string         `json:"api_endpoint"`
+	Timeout           string         `json:"timeout"`
+	PageSize          int            `json:"page_size"`
+	CacheTTL          string         `json:"cache_ttl"`
+	PollInterval      string         `json:"poll_interval"`
+	LogFile           string         `json:"log_file"`
+	LogLevel          string         `json:"log_level"`
+	Theme             string         `json:"theme"`
+	Density           string         `json:"density"`
+	AgentCommands     []AgentCommand `json:"agent_commands"`
+	AgentWorkspace    string         `json:"agent_workspace"`
+	AgentConcurrency  int            `json:"agent_concurrency"`
+	AgentWorktrees    bool           `json:"agent_worktrees"`
+	AgentWorktreeRoot string         `json:"agent_worktree_root"`
+	WebhookListen     string         `json:"webhook_listen"`
+	WebhookSecret     string         `json:"webhook_secret"`
+	NotificationAlert string         `json:"notification_alert"`
 }
 
 // DefaultSettings returns the default settings for the config file and UI.
 func DefaultSettings() Settings {
 	return Settings{
-		APIEndpoint:    DefaultAPIEndpoint,
-		Timeout:        DefaultTimeout.String(),
-		PageSize:       DefaultPageSize,
-		CacheTTL:       DefaultCacheTTL.String(),
-		LogFile:        getDefaultLogFile(),
-		LogLevel:       DefaultLogLevel,
-		Theme:          DefaultTheme,
-		Density:        DefaultDensity,
-		AgentCommands:  DefaultAgentCommands(),
-		AgentWorkspace: "",
+		APIEndpoint:       DefaultAPIEndpoint,
+		Timeout:           DefaultTimeout.String(),
+		PageSize:          DefaultPageSize,
+		CacheTTL:          DefaultCacheTTL.String(),
+		PollInterval:      DefaultPollInterval.String(),
+		LogFile:           getDefaultLogFile(),
+		LogLevel:          DefaultLogLevel,
+		Theme:             DefaultTheme,
+		Density:           DefaultDensity,
+		AgentCommands:     DefaultAgentCommands(),
+		AgentWorkspace:    "",
+		AgentConcurrency:  DefaultAgentConcurrency,
+		AgentWorktrees:    false,
+		AgentWorktreeRoot: "",
+		WebhookListen:     "",
+		WebhookSecret:     "",
+		NotificationAlert: DefaultNotificationAlert,
 	}
 }
 
 // SettingsFromConfig converts runtime config into settings values.
 func SettingsFromConfig(cfg Config) Settings {
 	return Settings{
-		APIEndpoint:    cfg.APIEndpoint,
-		Timeout:        cfg.Timeout.String(),
-		PageSize:       cfg.PageSize,
-		CacheTTL:       cfg.CacheTTL.String(),
-		LogFile:        cfg.LogFile,
-		LogLevel:       cfg.LogLevel,
-		Theme:          cfg.Theme,
-		Density:        cfg.Density,
-		AgentCommands:  cfg.AgentCommands,
-		AgentWorkspace: cfg.AgentWorkspace,
+		APIEndpoint:       cfg.APIEndpoint,
+		Timeout:           cfg.Timeout.String(),
+		PageSize:          cfg.PageSize,
+		CacheTTL:          cfg.CacheTTL.String(),
+		PollInterval:      cfg.PollInterval.String(),
+		LogFile:           cfg.LogFile,
+		LogLevel:          cfg.LogLevel,
+		Theme:             cfg.Theme,
+		Density:           cfg.Density,
+		AgentCommands:     cfg.AgentCommands,
+		AgentWorkspace:    cfg.AgentWorkspace,
+		AgentConcurrency:  cfg.AgentConcurrency,
+		AgentWorktrees:    cfg.AgentWorktrees,
+		AgentWorktreeRoot: cfg.AgentWorktreeRoot,
+		WebhookListen:     cfg.WebhookListen,
+		WebhookSecret:     cfg.WebhookSecret,
+		NotificationAlert: cfg.NotificationAlert,
 	}
 }
 
@@ -90,6 +118,17 @@ func ConfigFromSettings(apiKey string, settings Settings) (Config, error) {
 		return Config{}, err
 	}
 
+	pollInterval := DefaultPollInterval
+	if text := strings.TrimSpace(settings.PollInterval); text != "" {
+		pollInterval, err = parseDuration(text, "poll_interval")
+		if err != nil {
+			return Config{}, err
+		}
+	}
+	if err := validatePollInterval(pollInterval, "poll_interval"); err != nil {
+		return Config{}, err
+	}
+
 	if err := validatePageSize(settings.PageSize, "page_size"); err != nil {
 		return Config{}, err
 	}
@@ -119,18 +158,47 @@ func ConfigFromSettings(apiKey string, settings Settings) (Config, error) {
 		agentCommands = DefaultAgentCommands()
 	}
 
+	agentConcurrency := settings.AgentConcurrency
+	if agentConcurrency == 0 {
+		agentConcurrency = DefaultAgentConcurrency
+	}
+	if err := validateAgentConcurrency(agentConcurrency, "agent_concurrency"); err != nil {
+		return Config{}, err
+	}
+
+	webhookListen := strings.TrimSpace(settings.WebhookListen)
+	webhookSecret := strings.TrimSpace(settings.WebhookSecret)
+	if webhookListen != "" && webhookSecret == "" {
+		return Config{}, fmt.Errorf("webhook_secret must be set when webhook_listen is set")
+	}
+
+	notificationAlert := strings.TrimSpace(settings.NotificationAlert)
+	if notificationAlert == "" {
+		notificationAlert = DefaultNotificationAlert
+	}
+	if err := validateNotificationAlert(notificationAlert, "notification_alert"); err != nil {
+		return Config{}, err
+	}
+
 	return Config{
-		LinearAPIKey:   apiKey,
-		APIEndpoint:    settings.APIEndpoint,
-		Timeout:        timeout,
-		PageSize:       settings.PageSize,
-		CacheTTL:       cacheTTL,
-		LogFile:        settings.LogFile,
-		LogLevel:       settings.LogLevel,
-		Theme:          theme,
-		Density:        density,
-		AgentCommands:  agentCommands,
-		AgentWorkspace: settings.AgentWorkspace,
+		LinearAPIKey:      apiKey,
+		APIEndpoint:       settings.APIEndpoint,
+		Timeout:           timeout,
+		PageSize:          settings.PageSize,
+		CacheTTL:          cacheTTL,
+		PollInterval:      pollInterval,
+		LogFile:           settings.LogFile,
+		LogLevel:          settings.LogLevel,
+		Theme:             theme,
+		Density:           density,
+		AgentCommands:     agentCommands,
+		AgentWorkspace:    settings.AgentWorkspace,
+		AgentConcurrency:  agentConcurrency,
+		AgentWorktrees:    settings.AgentWorktrees,
+		AgentWorktreeRoot: strings.TrimSpace(settings.AgentWorktreeRoot),
+		WebhookListen:     webhookListen,
+		WebhookSecret:     webhookSecret,
+		NotificationAlert: notificationAlert,
 	}, nil
 }
 
@@ -193,6 +261,9 @@ func LoadSettings(path string) (Settings, error) {
 	if file.CacheTTL != nil {
 		settings.CacheTTL = *file.CacheTTL
 	}
+	if file.PollInterval != nil {
+		settings.PollInterval = *file.PollInterval
+	}
 	if file.LogFile != nil {
 		settings.LogFile = *file.LogFile
 	}
@@ -224,6 +295,24 @@ func LoadSettings(path string) (Settings, error) {
 	if file.AgentWorkspace != nil {
 		settings.AgentWorkspace = *file.AgentWorkspace
 	}
+	if file.AgentConcurrency != nil {
+		settings.AgentConcurrency = *file.AgentConcurrency
+	}
+	if file.AgentWorktrees != nil {
+		settings.AgentWorktrees = *file.AgentWorktrees
+	}
+	if file.AgentWorktreeRoot != nil {
+		settings.AgentWorktreeRoot = *file.AgentWorktreeRoot
+	}
+	if file.WebhookListen != nil {
+		settings.WebhookListen = *file.WebhookListen
+	}
+	if file.WebhookSecret != nil {
+		settings.WebhookSecret = *file.WebhookSecret
+	}
+	if file.NotificationAlert != nil {
+		settings.NotificationAlert = *file.NotificationAlert
+	}
 
 	return settings, nil
 }
@@ -324,6 +413,24 @@ func validatePageSize(pageSize int, label string) error {
 	return nil
 }
 
+// validatePollInterval validates that polling is disabled or not more frequent than MinPollInterval.
+func validatePollInterval(interval time.Duration, label string) error {
+	if interval != 0 && interval < MinPollInterval {
+		return fmt.Errorf("%s must be 0 (disabled) or at least %s, got %s", label, MinPollInterval, interval)
+	}
+
+	return nil
+}
+
+// validateAgentConcurrency validates the agent job concurrency limit.
+func validateAgentConcurrency(limit int, label string) error {
+	if limit < 1 || limit > 16 {
+		return fmt.Errorf("%s must be between 1 and 16, got %d", label, limit)
+	}
+
+	return nil
+}
+
 // validateLogLevel validates the allowed log level values.
 func validateLogLevel(logLevel string, label string) error {
 	switch logLevel {
@@ -353,3 +460,13 @@ func validateDensity(density string, label string) error {
 		return fmt.Errorf("invalid %s value %q: must be comfortable or compact", label, density)
 	}
 }
+
+// validateNotificationAlert validates the allowed notification alert values.
+func validateNotificationAlert(alert string, label string) error {
+	switch alert {
+	case NotificationAlertNone, NotificationAlertBell, NotificationAlertOSC9, NotificationAlertOSC777:
+		return nil
+	default:
+		return fmt.Errorf("invalid %s value %q: must be none, bell, osc9, or osc777", label, alert)
+	}
+}
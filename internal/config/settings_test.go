@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 )
 
 // TestEnsureSettingsFileCreatesDefaults verifies missing settings are created with defaults.
@@ -90,6 +91,13 @@ func TestConfigFromSettingsValidation(t *testing.T) {
 				return settings
 			},
 		},
+		{
+			name: "poll interval too short",
+			mutate: func(settings Settings) Settings {
+				settings.PollInterval = "2s"
+				return settings
+			},
+		},
 		{
 			name: "page size too low",
 			mutate: func(settings Settings) Settings {
@@ -125,6 +133,27 @@ func TestConfigFromSettingsValidation(t *testing.T) {
 				return settings
 			},
 		},
+		{
+			name: "webhook listen without secret",
+			mutate: func(settings Settings) Settings {
+				settings.WebhookListen = "127.0.0.1:8765"
+				return settings
+			},
+		},
+		{
+			name: "invalid notification alert",
+			mutate: func(settings Settings) Settings {
+				settings.NotificationAlert = "popup"
+				return settings
+			},
+		},
+		{
+			name: "agent concurrency too high",
+			mutate: func(settings Settings) Settings {
+				settings.AgentConcurrency = 20
+				return settings
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -138,6 +167,30 @@ func TestConfigFromSettingsValidation(t *testing.T) {
 	}
 }
 
+// TestConfigFromSettingsPollInterval verifies the poll interval default and that zero disables polling.
+func TestConfigFromSettingsPollInterval(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "", want: DefaultPollInterval},
+		{value: "0s", want: 0},
+		{value: "30s", want: 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		settings := DefaultSettings()
+		settings.PollInterval = tt.value
+		cfg, err := ConfigFromSettings("test-key", settings)
+		if err != nil {
+			t.Fatalf("ConfigFromSettings(%q) error: %v", tt.value, err)
+		}
+		if cfg.PollInterval != tt.want {
+			t.Errorf("PollInterval for %q = %s, want %s", tt.value, cfg.PollInterval, tt.want)
+		}
+	}
+}
+
 // TestConfigFromSettingsRequiresAPIKey verifies API key is mandatory.
 func TestConfigFromSettingsRequiresAPIKey(t *testing.T) {
 	_, err := ConfigFromSettings("", DefaultSettings())
@@ -167,6 +220,9 @@ func TestDefaultSettingsAgentDefaults(t *testing.T) {
 	if settings.Density != DefaultDensity {
 		t.Errorf("Density = %q, want %q", settings.Density, DefaultDensity)
 	}
+	if settings.AgentConcurrency != DefaultAgentConcurrency {
+		t.Errorf("AgentConcurrency = %d, want %d", settings.AgentConcurrency, DefaultAgentConcurrency)
+	}
 }
 
 // TestMigrateAgentCommands verifies backward compatibility migration.
@@ -270,6 +326,123 @@ func TestLoadSettingsWithAgentCommands(t *testing.T) {
 	}
 }
 
+// TestLoadSettingsWithAgentLifecycle verifies per-command lifecycle rules are loaded and defaulted.
+func TestLoadSettingsWithAgentLifecycle(t *testing.T) {
+	tmpDir := t.TempDir()
+	settingsPath := filepath.Join(tmpDir, "config.json")
+
+	data := []byte(`{"agent_commands": [
+		{"name": "Review", "command": "claude {prompt}", "lifecycle": {"on_success": {"state": "In Review", "add_labels": ["ai-assisted"]}}},
+		{"name": "Quiet", "command": "claude -p {prompt}", "lifecycle": {}},
+		{"name": "Default", "command": "claude --x {prompt}"}
+	]}`)
+	if err := os.WriteFile(settingsPath, data, 0644); err != nil {
+		t.Fatalf("write settings file: %v", err)
+	}
+
+	settings, err := LoadSettings(settingsPath)
+	if err != nil {
+		t.Fatalf("LoadSettings() error: %v", err)
+	}
+	review := settings.AgentCommands[0].EffectiveLifecycle()
+	if review.OnStart != nil || review.OnSuccess == nil || review.OnSuccess.State != "In Review" || len(review.OnSuccess.AddLabels) != 1 {
+		t.Errorf("review lifecycle = %+v", review)
+	}
+	quiet := settings.AgentCommands[1].EffectiveLifecycle()
+	if !quiet.OnStart.IsEmpty() || !quiet.OnSuccess.IsEmpty() || !quiet.OnFailure.IsEmpty() {
+		t.Errorf("empty lifecycle should change nothing: %+v", quiet)
+	}
+	if def := settings.AgentCommands[2].EffectiveLifecycle(); def.OnStart == nil || def.OnStart.State != "started" {
+		t.Errorf("default lifecycle = %+v", def)
+	}
+}
+
+// TestLoadSettingsWithAgentWorktrees verifies worktree settings are loaded and carried into config.
+func TestLoadSettingsWithAgentWorktrees(t *testing.T) {
+	tmpDir := t.TempDir()
+	settingsPath := filepath.Join(tmpDir, "config.json")
+
+	data := []byte(`{"agent_worktrees": true, "agent_worktree_root": " /tmp/trees "}`)
+	if err := os.WriteFile(settingsPath, data, 0644); err != nil {
+		t.Fatalf("write settings file: %v", err)
+	}
+
+	settings, err := LoadSettings(settingsPath)
+	if err != nil {
+		t.Fatalf("LoadSettings() error: %v", err)
+	}
+	if !settings.AgentWorktrees {
+		t.Error("AgentWorktrees = false, want true")
+	}
+
+	cfg, err := ConfigFromSettings("test-key", settings)
+	if err != nil {
+		t.Fatalf("ConfigFromSettings() error: %v", err)
+	}
+	if !cfg.AgentWorktrees || cfg.AgentWorktreeRoot != "/tmp/trees" {
+		t.Errorf("worktree config = %v %q, want true %q", cfg.AgentWorktrees, cfg.AgentWorktreeRoot, "/tmp/trees")
+	}
+}
+
+// TestLoadSettingsWithWebhook verifies webhook settings are loaded and carried into config.
+func TestLoadSettingsWithWebhook(t *testing.T) {
+	tmpDir := t.TempDir()
+	settingsPath := filepath.Join(tmpDir, "config.json")
+
+	data := []byte(`{"webhook_listen": " 127.0.0.1:8765 ", "webhook_secret": "s3cret"}`)
+	if err := os.WriteFile(settingsPath, data, 0644); err != nil {
+		t.Fatalf("write settings file: %v", err)
+	}
+
+	settings, err := LoadSettings(settingsPath)
+	if err != nil {
+		t.Fatalf("LoadSettings() error: %v", err)
+	}
+
+	cfg, err := ConfigFromSettings("test-key", settings)
+	if err != nil {
+		t.Fatalf("ConfigFromSettings() error: %v", err)
+	}
+	if cfg.WebhookListen != "127.0.0.1:8765" || cfg.WebhookSecret != "s3cret" {
+		t.Errorf("webhook config = %q %q, want %q %q", cfg.WebhookListen, cfg.WebhookSecret, "127.0.0.1:8765", "s3cret")
+	}
+	if got := SettingsFromConfig(cfg); got.WebhookListen != cfg.WebhookListen || got.WebhookSecret != cfg.WebhookSecret {
+		t.Errorf("SettingsFromConfig() webhook = %q %q", got.WebhookListen, got.WebhookSecret)
+	}
+}
+
+// TestLoadSettingsWithNotificationAlert verifies the notification alert is loaded and defaults to none.
+func TestLoadSettingsWithNotificationAlert(t *testing.T) {
+	tmpDir := t.TempDir()
+	settingsPath := filepath.Join(tmpDir, "config.json")
+
+	data := []byte(`{"notification_alert": "osc777"}`)
+	if err := os.WriteFile(settingsPath, data, 0644); err != nil {
+		t.Fatalf("write settings file: %v", err)
+	}
+
+	settings, err := LoadSettings(settingsPath)
+	if err != nil {
+		t.Fatalf("LoadSettings() error: %v", err)
+	}
+	cfg, err := ConfigFromSettings("test-key", settings)
+	if err != nil {
+		t.Fatalf("ConfigFromSettings() error: %v", err)
+	}
+	if cfg.NotificationAlert != NotificationAlertOSC777 {
+		t.Errorf("NotificationAlert = %q, want %q", cfg.NotificationAlert, NotificationAlertOSC777)
+	}
+
+	settings.NotificationAlert = ""
+	cfg, err = ConfigFromSettings("test-key", settings)
+	if err != nil {
+		t.Fatalf("ConfigFromSettings() error: %v", err)
+	}
+	if cfg.NotificationAlert != DefaultNotificationAlert {
+		t.Errorf("NotificationAlert for empty value = %q, want %q", cfg.NotificationAlert, DefaultNotificationAlert)
+	}
+}
+
 // TestLoadSettingsLegacyMigration verifies legacy fields are migrated when agent_commands is absent.
 func TestLoadSettingsLegacyMigration(t *testing.T) {
 	tmpDir := t.TempDir()
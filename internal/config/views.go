@@ -0,0 +1,160 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Saved view grouping modes.
+const (
+	GroupByAssignee = "assignee" // My Issues / Other Issues sections (default)
+	GroupByState    = "state"
+	GroupByPriority = "priority"
+	GroupByNone     = "none"
+)
+
+// Saved view sort fields, matching the issue list sort options.
+const (
+	SortUpdated  = "updatedAt"
+	SortCreated  = "createdAt"
+	SortPriority = "priority"
+)
+
+// SavedView is a named issue filter shown in the navigation tree's Views section.
+type SavedView struct {
+	Name        string   `json:"name"`
+	TeamID      string   `json:"team_id,omitempty"`
+	TeamName    string   `json:"team_name,omitempty"` // For display only
+	ProjectID   string   `json:"project_id,omitempty"`
+	ProjectName string   `json:"project_name,omitempty"` // For display only
+	States      []string `json:"states,omitempty"`       // Workflow state names or types
+	Assignee    string   `json:"assignee,omitempty"`     // "me", "none", or a name or email
+	Labels      []string `json:"labels,omitempty"`       // Matches issues with any of the labels
+	Priority    string   `json:"priority,omitempty"`     // e.g. "urgent" or ">=2"
+	Search      string   `json:"search,omitempty"`       // Free text or a filter query
+	Sort        string   `json:"sort,omitempty"`         // updatedAt (default), createdAt or priority
+	GroupBy     string   `json:"group_by,omitempty"`     // assignee (default), state, priority or none
+}
+
+// Validate checks the view has a name and known sort and grouping values.
+func (v SavedView) Validate() error {
+	if strings.TrimSpace(v.Name) == "" {
+		return fmt.Errorf("view name is required")
+	}
+	switch v.Sort {
+	case "", SortUpdated, SortCreated, SortPriority:
+	default:
+		return fmt.Errorf("view %q: unknown sort %q", v.Name, v.Sort)
+	}
+	switch v.GroupBy {
+	case "", GroupByAssignee, GroupByState, GroupByPriority, GroupByNone:
+	default:
+		return fmt.Errorf("view %q: unknown grouping %q", v.Name, v.GroupBy)
+	}
+	return nil
+}
+
+// SavedViewsFilePath returns the default saved views file path.
+func SavedViewsFilePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".linear-tui", "views.json"), nil
+}
+
+// LoadSavedViews loads saved views from a JSON file. A missing file has no views;
+// invalid entries are dropped.
+func LoadSavedViews(path string) ([]SavedView, error) {
+	if path == "" {
+		return nil, fmt.Errorf("views path is empty")
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read views file: %w", err)
+	}
+
+	var views []SavedView
+	if err := json.Unmarshal(data, &views); err != nil {
+		return nil, fmt.Errorf("parse views file: %w", err)
+	}
+
+	return normalizeSavedViews(views), nil
+}
+
+// SaveSavedViews writes saved views to a JSON file, creating directories as needed.
+func SaveSavedViews(path string, views []SavedView) error {
+	if path == "" {
+		return fmt.Errorf("views path is empty")
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create views directory: %w", err)
+	}
+
+	if views == nil {
+		views = []SavedView{}
+	}
+	data, err := json.MarshalIndent(views, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal views: %w", err)
+	}
+	data = append(data, '\n')
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("write views file: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("replace views file: %w", err)
+	}
+
+	return nil
+}
+
+// normalizeSavedViews trims views and drops invalid ones and duplicate names.
+func normalizeSavedViews(views []SavedView) []SavedView {
+	valid := make([]SavedView, 0, len(views))
+	seen := make(map[string]bool, len(views))
+	for _, view := range views {
+		view = NormalizeSavedView(view)
+		key := strings.ToLower(view.Name)
+		if view.Validate() != nil || seen[key] {
+			continue
+		}
+		seen[key] = true
+		valid = append(valid, view)
+	}
+	return valid
+}
+
+// NormalizeSavedView trims whitespace and drops empty list entries.
+func NormalizeSavedView(view SavedView) SavedView {
+	view.Name = strings.TrimSpace(view.Name)
+	view.Assignee = strings.TrimSpace(view.Assignee)
+	view.Priority = strings.TrimSpace(view.Priority)
+	view.Search = strings.TrimSpace(view.Search)
+	view.States = trimNonEmpty(view.States)
+	view.Labels = trimNonEmpty(view.Labels)
+	return view
+}
+
+// trimNonEmpty trims values and drops empty ones.
+func trimNonEmpty(values []string) []string {
+	var trimmed []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			trimmed = append(trimmed, value)
+		}
+	}
+	return trimmed
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestSavedViewsRoundTrip verifies views are saved and loaded, and a missing file has no views.
+func TestSavedViewsRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "views.json")
+
+	views, err := LoadSavedViews(path)
+	if err != nil || len(views) != 0 {
+		t.Fatalf("LoadSavedViews() on missing file = %v, %v", views, err)
+	}
+
+	want := []SavedView{{
+		Name:     "My bugs",
+		TeamID:   "team-1",
+		States:   []string{"started", "In Review"},
+		Assignee: "me",
+		Labels:   []string{"bug"},
+		Priority: ">=2",
+		Sort:     SortPriority,
+		GroupBy:  GroupByState,
+	}}
+	if err := SaveSavedViews(path, want); err != nil {
+		t.Fatalf("SaveSavedViews() error: %v", err)
+	}
+	got, err := LoadSavedViews(path)
+	if err != nil {
+		t.Fatalf("LoadSavedViews() error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("LoadSavedViews() = %+v, want %+v", got, want)
+	}
+}
+
+// TestLoadSavedViewsFiltersInvalid verifies unnamed, duplicate and unknown-grouping views are dropped.
+func TestLoadSavedViewsFiltersInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "views.json")
+	data := []byte(`[
+  {"name": "  "},
+  {"name": " Bugs ", "labels": ["bug", " "]},
+  {"name": "bugs"},
+  {"name": "Grouped", "group_by": "team"}
+]`)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("write views file: %v", err)
+	}
+
+	views, err := LoadSavedViews(path)
+	if err != nil {
+		t.Fatalf("LoadSavedViews() error: %v", err)
+	}
+	want := []SavedView{{Name: "Bugs", Labels: []string{"bug"}}}
+	if !reflect.DeepEqual(views, want) {
+		t.Fatalf("LoadSavedViews() = %+v, want %+v", views, want)
+	}
+}
@@ -3,7 +3,9 @@ package linearapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"reflect"
 	"sort"
@@ -124,6 +126,10 @@ type Team struct {
 	ID   string
 	Key  string
 	Name string
+	// Estimation settings: EstimationType is "notUsed", "exponential", "fibonacci", "linear" or "tShirt"
+	EstimationType      string
+	EstimationAllowZero bool
+	EstimationExtended  bool
 }
 
 // Project represents a Linear project.
@@ -163,6 +169,7 @@ type IssueRef struct {
 	ID         string
 	Identifier string
 	Title      string
+	State      string // Workflow state name; only set for the parent by FetchIssueByID
 }
 
 // IssueChildRef represents a lightweight reference to a child issue.
@@ -182,6 +189,8 @@ type Comment struct {
 	UpdatedAt time.Time
 	Author    User
 	IssueID   string
+	ParentID  string // Parent comment ID for threaded replies; empty for top-level comments
+	Reactions []Reaction
 }
 
 // Issue represents a Linear issue.
@@ -199,13 +208,45 @@ type Issue struct {
 	CreatedAt   time.Time
 	TeamID      string
 	ProjectID   string
+	ProjectName string
+	CycleID     string // Empty when the issue is not in a cycle
+	CycleName   string // Cycle name, or "Cycle N" for unnamed cycles
 	URL         string
 	BranchName  string
 	Archived    bool
 	Labels      []IssueLabel
 	Parent      *IssueRef       // Parent issue reference (nil if top-level)
 	Children    []IssueChildRef // Child/sub-issue references
-	Comments    []Comment       // Comments on this issue
+	Relations   []IssueRelation // Blocks, blocked by, duplicate and related issues
+	Reactions   []Reaction      // Emoji reactions; only populated by FetchIssueByID
+	Estimate    *int            // nil when the issue has no estimate
+	DueDate     string          // YYYY-MM-DD, empty when unset
+	CreatorID   string
+	Creator     string
+	StartedAt   time.Time // Zero until the issue is started
+	CompletedAt time.Time // Zero unless the issue is completed
+	CanceledAt  time.Time // Zero unless the issue is canceled
+	// SLABreachesAt is when the issue's SLA is breached; zero when no SLA applies
+	SLABreachesAt time.Time
+	Subscribers   []User    // Only populated by FetchIssueByID
+	Comments      []Comment // Comments on this issue
+}
+
+// PriorityLabel returns the display name for an issue priority value.
+// Linear priority: 0 = No priority, 1 = Urgent, 2 = High, 3 = Normal, 4 = Low.
+func PriorityLabel(priority int) string {
+	switch priority {
+	case 1:
+		return "Urgent"
+	case 2:
+		return "High"
+	case 3:
+		return "Normal"
+	case 4:
+		return "Low"
+	default:
+		return "No priority"
+	}
 }
 
 // IssueFetchProgress describes progress for a paginated issue fetch.
@@ -226,11 +267,24 @@ type FetchIssuesParams struct {
 	TeamID    string
 	ProjectID string
 	StateID   string
-	Search    string
+	// AssigneeID restricts results to issues assigned to this user (empty = any assignee).
+	AssigneeID string
+	Search     string
+	// Filter is an extra filter ANDed with the others, e.g. from ParseIssueQuery (nil = none).
+	Filter IssueFilter
+	// CustomViewID fetches the issues of a Linear custom view, with the other fields applied on top.
+	// Search text is then matched against title and description rather than full-text search.
+	CustomViewID string
 	// OrderBy specifies the sort order. Valid API values are "updatedAt" and "createdAt".
 	// "priority" is also supported and will be sorted client-side after fetching.
 	OrderBy string
 	First   int
+	// UpdatedAfter restricts results to issues updated strictly after this time (zero = no limit).
+	// It is used for incremental syncs against the local issue store.
+	UpdatedAfter time.Time
+	// IncludeArchived also returns archived issues, so deltas notice archivals.
+	// Only filtered fetches honour it; searches and custom views never include them.
+	IncludeArchived bool
 	// OnProgress is an optional callback invoked after each page is fetched.
 	OnProgress func(IssueFetchProgress)
 }
@@ -257,12 +311,20 @@ type UpdateIssueInput struct {
 	Priority    *int
 	LabelIDs    *[]string // nil = no change, empty slice = clear all, non-empty = set labels
 	ParentID    *string   // nil = no change, empty string = clear parent, non-empty = set parent
+	CycleID     *string   // nil = no change, empty string = remove from cycle, non-empty = move to cycle
+	ProjectID   *string   // nil = no change, empty string = remove from project, non-empty = move to project
+	DueDate     *string   // nil = no change, empty string = clear, otherwise YYYY-MM-DD
+	Estimate    *int      // nil = no change, NoEstimate = clear, otherwise the estimate value
 }
 
+// NoEstimate clears an issue's estimate when passed as UpdateIssueInput.Estimate.
+const NoEstimate = -1
+
 // CreateCommentInput contains input for creating a new comment.
 type CreateCommentInput struct {
-	IssueID string
-	Body    string
+	IssueID  string
+	Body     string
+	ParentID string // Optional parent comment ID to reply in a thread
 }
 
 // NewClient creates a new Linear API client with the provided configuration.
@@ -339,9 +401,12 @@ func (c *Client) ListTeams(ctx context.Context) ([]Team, error) {
 	var query struct {
 		Teams struct {
 			Nodes []struct {
-				ID   graphql.String
-				Key  graphql.String
-				Name graphql.String
+				ID                       graphql.String
+				Key                      graphql.String
+				Name                     graphql.String
+				IssueEstimationType      graphql.String
+				IssueEstimationAllowZero graphql.Boolean
+				IssueEstimationExtended  graphql.Boolean
 			}
 		} `graphql:"teams"`
 	}
@@ -355,9 +420,12 @@ func (c *Client) ListTeams(ctx context.Context) ([]Team, error) {
 	teams := make([]Team, 0, len(query.Teams.Nodes))
 	for _, node := range query.Teams.Nodes {
 		teams = append(teams, Team{
-			ID:   string(node.ID),
-			Key:  string(node.Key),
-			Name: string(node.Name),
+			ID:                  string(node.ID),
+			Key:                 string(node.Key),
+			Name:                string(node.Name),
+			EstimationType:      string(node.IssueEstimationType),
+			EstimationAllowZero: bool(node.IssueEstimationAllowZero),
+			EstimationExtended:  bool(node.IssueEstimationExtended),
 		})
 	}
 
@@ -516,6 +584,15 @@ func buildBaseIssueFilter(params FetchIssuesParams) IssueFilter {
 	if params.StateID != "" {
 		filter["state"] = map[string]interface{}{"id": map[string]interface{}{"eq": params.StateID}}
 	}
+	if params.AssigneeID != "" {
+		filter["assignee"] = map[string]interface{}{"id": map[string]interface{}{"eq": params.AssigneeID}}
+	}
+	if !params.UpdatedAfter.IsZero() {
+		filter["updatedAt"] = map[string]interface{}{"gt": params.UpdatedAfter.UTC().Format(time.RFC3339Nano)}
+	}
+	if len(params.Filter) > 0 {
+		filter["and"] = []map[string]interface{}{params.Filter}
+	}
 	return filter
 }
 
@@ -535,7 +612,7 @@ func buildIssueFilter(params FetchIssuesParams) IssueFilter {
 	}
 
 	// Require every term to match at least one field for free-text search.
-	andFilters := make([]map[string]interface{}, 0, len(terms))
+	andFilters, _ := filter["and"].([]map[string]interface{})
 	for _, term := range terms {
 		andFilters = append(andFilters, map[string]interface{}{
 			"or": buildSearchOrFilters(term),
@@ -558,6 +635,10 @@ func buildSearchOrFilters(term string) []map[string]interface{} {
 // FetchIssuesPage fetches a single page of issues with optional filtering and sorting.
 // It returns pagination metadata to allow callers to continue fetching.
 func (c *Client) FetchIssuesPage(ctx context.Context, params FetchIssuesParams, after *string) (IssuePage, error) {
+	if params.CustomViewID != "" {
+		return c.fetchCustomViewIssuesPage(ctx, params, after)
+	}
+
 	searchTerm := strings.TrimSpace(params.Search)
 	if searchTerm != "" {
 		params.Search = searchTerm
@@ -659,8 +740,38 @@ func (c *Client) searchIssuesPage(ctx context.Context, params FetchIssuesParams,
 					ID graphql.String
 				}
 				Project *struct {
-					ID graphql.String
+					ID   graphql.String
+					Name graphql.String
 				}
+				Cycle *struct {
+					ID     graphql.String
+					Number graphql.Float
+					Name   *graphql.String
+				}
+				Estimate      *graphql.Float
+				DueDate       *graphql.String
+				StartedAt     *graphql.String
+				CompletedAt   *graphql.String
+				CanceledAt    *graphql.String
+				SlaBreachesAt *graphql.String
+				Creator       *struct {
+					ID   graphql.String
+					Name graphql.String
+				}
+				// Only what the blocked marker needs; details load the full relation list
+				InverseRelations struct {
+					Nodes []struct {
+						ID    graphql.String
+						Type  graphql.String
+						Issue struct {
+							ID         graphql.String
+							Identifier graphql.String
+							State      struct {
+								Type graphql.String
+							}
+						}
+					}
+				} `graphql:"inverseRelations(first: 10)"`
 				Labels struct {
 					Nodes []struct {
 						ID    graphql.String
@@ -817,8 +928,38 @@ func (c *Client) fetchIssuesWithFilterPage(ctx context.Context, params FetchIssu
 					ID graphql.String
 				}
 				Project *struct {
-					ID graphql.String
+					ID   graphql.String
+					Name graphql.String
+				}
+				Cycle *struct {
+					ID     graphql.String
+					Number graphql.Float
+					Name   *graphql.String
+				}
+				Estimate      *graphql.Float
+				DueDate       *graphql.String
+				StartedAt     *graphql.String
+				CompletedAt   *graphql.String
+				CanceledAt    *graphql.String
+				SlaBreachesAt *graphql.String
+				Creator       *struct {
+					ID   graphql.String
+					Name graphql.String
 				}
+				// Only what the blocked marker needs; details load the full relation list
+				InverseRelations struct {
+					Nodes []struct {
+						ID    graphql.String
+						Type  graphql.String
+						Issue struct {
+							ID         graphql.String
+							Identifier graphql.String
+							State      struct {
+								Type graphql.String
+							}
+						}
+					}
+				} `graphql:"inverseRelations(first: 10)"`
 				Labels struct {
 					Nodes []struct {
 						ID    graphql.String
@@ -850,14 +991,15 @@ func (c *Client) fetchIssuesWithFilterPage(ctx context.Context, params FetchIssu
 				HasNextPage graphql.Boolean
 				EndCursor   graphql.String
 			}
-		} `graphql:"issues(first: $first, after: $after, filter: $filter, orderBy: $orderBy)"`
+		} `graphql:"issues(first: $first, after: $after, filter: $filter, orderBy: $orderBy, includeArchived: $includeArchived)"`
 	}
 
 	variables := map[string]interface{}{
-		"first":   graphql.Int(first),
-		"filter":  filter,
-		"orderBy": orderBy,
-		"after":   afterCursor,
+		"first":           graphql.Int(first),
+		"filter":          filter,
+		"orderBy":         orderBy,
+		"after":           afterCursor,
+		"includeArchived": graphql.Boolean(params.IncludeArchived),
 	}
 
 	err := c.client.Query(ctx, &query, variables)
@@ -922,9 +1064,24 @@ func (c *Client) parseIssueNode(node interface{}) Issue {
 	teamID := v.FieldByName("Team").FieldByName("ID").String()
 
 	projectID := ""
+	projectName := ""
 	projectField := v.FieldByName("Project")
 	if !projectField.IsNil() {
 		projectID = projectField.Elem().FieldByName("ID").String()
+		projectName = projectField.Elem().FieldByName("Name").String()
+	}
+
+	cycleID := ""
+	cycleName := ""
+	cycleField := v.FieldByName("Cycle")
+	if cycleField.IsValid() && !cycleField.IsNil() {
+		cycle := cycleField.Elem()
+		cycleID = cycle.FieldByName("ID").String()
+		var name string
+		if nameField := cycle.FieldByName("Name"); !nameField.IsNil() {
+			name = nameField.Elem().String()
+		}
+		cycleName = CycleDisplayName(int(cycle.FieldByName("Number").Float()), name)
 	}
 
 	url := v.FieldByName("URL").String()
@@ -970,7 +1127,7 @@ func (c *Client) parseIssueNode(node interface{}) Issue {
 		})
 	}
 
-	return Issue{
+	issue := Issue{
 		ID:          id,
 		Identifier:  identifier,
 		Title:       title,
@@ -984,13 +1141,20 @@ func (c *Client) parseIssueNode(node interface{}) Issue {
 		Description: description,
 		TeamID:      teamID,
 		ProjectID:   projectID,
+		ProjectName: projectName,
+		CycleID:     cycleID,
+		CycleName:   cycleName,
 		URL:         url,
 		BranchName:  branchName,
 		Archived:    archived,
 		Labels:      labels,
 		Parent:      parent,
 		Children:    children,
+		// List queries fetch blockers only, so other relations are left for the details
+		Relations: blockingRelations(parseIssueRelations(v)),
 	}
+	parseIssueFields(v, &issue)
+	return issue
 }
 
 // sortByPriority sorts issues by priority.
@@ -1033,8 +1197,72 @@ func (c *Client) FetchIssueByID(ctx context.Context, id string) (Issue, error) {
 				ID graphql.String
 			}
 			Project *struct {
-				ID graphql.String
+				ID   graphql.String
+				Name graphql.String
+			}
+			Cycle *struct {
+				ID     graphql.String
+				Number graphql.Float
+				Name   *graphql.String
+			}
+			Estimate      *graphql.Float
+			DueDate       *graphql.String
+			StartedAt     *graphql.String
+			CompletedAt   *graphql.String
+			CanceledAt    *graphql.String
+			SlaBreachesAt *graphql.String
+			Creator       *struct {
+				ID   graphql.String
+				Name graphql.String
+			}
+			Subscribers struct {
+				Nodes []struct {
+					ID          graphql.String
+					Name        graphql.String
+					DisplayName graphql.String
+					Email       graphql.String
+					IsMe        graphql.Boolean
+				}
+			} `graphql:"subscribers(first: 50)"`
+			Reactions []struct {
+				ID    graphql.String
+				Emoji graphql.String
+				User  *struct {
+					ID   graphql.String
+					Name graphql.String
+					IsMe graphql.Boolean
+				}
 			}
+			Relations struct {
+				Nodes []struct {
+					ID           graphql.String
+					Type         graphql.String
+					RelatedIssue struct {
+						ID         graphql.String
+						Identifier graphql.String
+						Title      graphql.String
+						State      struct {
+							Name graphql.String
+							Type graphql.String
+						}
+					}
+				}
+			} `graphql:"relations(first: 25)"`
+			InverseRelations struct {
+				Nodes []struct {
+					ID    graphql.String
+					Type  graphql.String
+					Issue struct {
+						ID         graphql.String
+						Identifier graphql.String
+						Title      graphql.String
+						State      struct {
+							Name graphql.String
+							Type graphql.String
+						}
+					}
+				}
+			} `graphql:"inverseRelations(first: 25)"`
 			Labels struct {
 				Nodes []struct {
 					ID    graphql.String
@@ -1049,6 +1277,9 @@ func (c *Client) FetchIssueByID(ctx context.Context, id string) (Issue, error) {
 				ID         graphql.String
 				Identifier graphql.String
 				Title      graphql.String
+				State      struct {
+					Name graphql.String
+				}
 			}
 			Children struct {
 				Nodes []struct {
@@ -1074,6 +1305,18 @@ func (c *Client) FetchIssueByID(ctx context.Context, id string) (Issue, error) {
 						Email       graphql.String
 						IsMe        graphql.Boolean
 					}
+					Parent *struct {
+						ID graphql.String
+					}
+					Reactions []struct {
+						ID    graphql.String
+						Emoji graphql.String
+						User  *struct {
+							ID   graphql.String
+							Name graphql.String
+							IsMe graphql.Boolean
+						}
+					}
 				}
 			} `graphql:"comments(first: 100, orderBy: createdAt)"`
 		} `graphql:"issue(id: $id)"`
@@ -1104,9 +1347,16 @@ func (c *Client) FetchIssueByID(ctx context.Context, id string) (Issue, error) {
 		description = string(*query.Issue.Description)
 	}
 
-	projectID := ""
+	projectID, projectName := "", ""
 	if query.Issue.Project != nil {
 		projectID = string(query.Issue.Project.ID)
+		projectName = string(query.Issue.Project.Name)
+	}
+
+	cycleID, cycleName := "", ""
+	if query.Issue.Cycle != nil {
+		cycleID = string(query.Issue.Cycle.ID)
+		cycleName = cycleDisplayNameFromNode(query.Issue.Cycle.Number, query.Issue.Cycle.Name)
 	}
 
 	archived := query.Issue.ArchivedAt != nil
@@ -1128,6 +1378,7 @@ func (c *Client) FetchIssueByID(ctx context.Context, id string) (Issue, error) {
 			ID:         string(query.Issue.Parent.ID),
 			Identifier: string(query.Issue.Parent.Identifier),
 			Title:      string(query.Issue.Parent.Title),
+			State:      string(query.Issue.Parent.State.Name),
 		}
 	}
 
@@ -1148,6 +1399,10 @@ func (c *Client) FetchIssueByID(ctx context.Context, id string) (Issue, error) {
 	for _, node := range query.Issue.Comments.Nodes {
 		commentCreatedAt := parseTime(string(node.CreatedAt))
 		commentUpdatedAt := parseTime(string(node.UpdatedAt))
+		parentID := ""
+		if node.Parent != nil {
+			parentID = string(node.Parent.ID)
+		}
 		comments = append(comments, Comment{
 			ID:        string(node.ID),
 			Body:      string(node.Body),
@@ -1160,11 +1415,13 @@ func (c *Client) FetchIssueByID(ctx context.Context, id string) (Issue, error) {
 				Email:       string(node.User.Email),
 				IsMe:        bool(node.User.IsMe),
 			},
-			IssueID: string(query.Issue.ID),
+			IssueID:   string(query.Issue.ID),
+			ParentID:  parentID,
+			Reactions: parseReactions(reflect.ValueOf(node)),
 		})
 	}
 
-	return Issue{
+	issue := Issue{
 		ID:          string(query.Issue.ID),
 		Identifier:  string(query.Issue.Identifier),
 		Title:       string(query.Issue.Title),
@@ -1178,14 +1435,21 @@ func (c *Client) FetchIssueByID(ctx context.Context, id string) (Issue, error) {
 		Description: description,
 		TeamID:      string(query.Issue.Team.ID),
 		ProjectID:   projectID,
+		ProjectName: projectName,
+		CycleID:     cycleID,
+		CycleName:   cycleName,
 		URL:         string(query.Issue.URL),
 		BranchName:  string(query.Issue.BranchName),
 		Archived:    archived,
 		Labels:      labels,
 		Parent:      parent,
 		Children:    children,
+		Relations:   parseIssueRelations(reflect.ValueOf(query.Issue)),
+		Reactions:   parseReactions(reflect.ValueOf(query.Issue)),
 		Comments:    comments,
-	}, nil
+	}
+	parseIssueFields(reflect.ValueOf(query.Issue), &issue)
+	return issue, nil
 }
 
 // CreateIssue creates a new issue.
@@ -1213,7 +1477,8 @@ func (c *Client) CreateIssue(ctx context.Context, input CreateIssueInput) (Issue
 					ID graphql.String
 				}
 				Project *struct {
-					ID graphql.String
+					ID   graphql.String
+					Name graphql.String
 				}
 				Labels struct {
 					Nodes []struct {
@@ -1281,9 +1546,10 @@ func (c *Client) CreateIssue(ctx context.Context, input CreateIssueInput) (Issue
 		description = string(*node.Description)
 	}
 
-	projectID := ""
+	projectID, projectName := "", ""
 	if node.Project != nil {
 		projectID = string(node.Project.ID)
+		projectName = string(node.Project.Name)
 	}
 
 	// Parse labels
@@ -1310,6 +1576,7 @@ func (c *Client) CreateIssue(ctx context.Context, input CreateIssueInput) (Issue
 		Description: description,
 		TeamID:      string(node.Team.ID),
 		ProjectID:   projectID,
+		ProjectName: projectName,
 		URL:         string(node.URL),
 		Labels:      labels,
 	}, nil
@@ -1340,7 +1607,13 @@ func (c *Client) UpdateIssue(ctx context.Context, input UpdateIssueInput) (Issue
 					ID graphql.String
 				}
 				Project *struct {
-					ID graphql.String
+					ID   graphql.String
+					Name graphql.String
+				}
+				Cycle *struct {
+					ID     graphql.String
+					Number graphql.Float
+					Name   *graphql.String
 				}
 				Labels struct {
 					Nodes []struct {
@@ -1392,6 +1665,38 @@ func (c *Client) UpdateIssue(ctx context.Context, input UpdateIssueInput) (Issue
 			issueInput["parentId"] = graphql.ID(*input.ParentID)
 		}
 	}
+	if input.CycleID != nil {
+		if *input.CycleID == "" {
+			// Remove from cycle by passing null
+			issueInput["cycleId"] = (*graphql.ID)(nil)
+		} else {
+			issueInput["cycleId"] = graphql.ID(*input.CycleID)
+		}
+	}
+	if input.ProjectID != nil {
+		if *input.ProjectID == "" {
+			// Remove from project by passing null
+			issueInput["projectId"] = (*graphql.ID)(nil)
+		} else {
+			issueInput["projectId"] = graphql.ID(*input.ProjectID)
+		}
+	}
+	if input.DueDate != nil {
+		if *input.DueDate == "" {
+			// Clear due date by passing null
+			issueInput["dueDate"] = (*graphql.String)(nil)
+		} else {
+			issueInput["dueDate"] = graphql.String(*input.DueDate)
+		}
+	}
+	if input.Estimate != nil {
+		if *input.Estimate == NoEstimate {
+			// Clear estimate by passing null
+			issueInput["estimate"] = (*graphql.Int)(nil)
+		} else {
+			issueInput["estimate"] = graphql.Int(*input.Estimate)
+		}
+	}
 
 	variables := map[string]interface{}{
 		"id":    graphql.String(input.ID),
@@ -1425,9 +1730,16 @@ func (c *Client) UpdateIssue(ctx context.Context, input UpdateIssueInput) (Issue
 		description = string(*node.Description)
 	}
 
-	projectID := ""
+	projectID, projectName := "", ""
 	if node.Project != nil {
 		projectID = string(node.Project.ID)
+		projectName = string(node.Project.Name)
+	}
+
+	cycleID, cycleName := "", ""
+	if node.Cycle != nil {
+		cycleID = string(node.Cycle.ID)
+		cycleName = cycleDisplayNameFromNode(node.Cycle.Number, node.Cycle.Name)
 	}
 
 	// Parse labels
@@ -1454,6 +1766,9 @@ func (c *Client) UpdateIssue(ctx context.Context, input UpdateIssueInput) (Issue
 		Description: description,
 		TeamID:      string(node.Team.ID),
 		ProjectID:   projectID,
+		ProjectName: projectName,
+		CycleID:     cycleID,
+		CycleName:   cycleName,
 		URL:         string(node.URL),
 		Labels:      labels,
 	}, nil
@@ -1484,6 +1799,9 @@ func (c *Client) CreateComment(ctx context.Context, input CreateCommentInput) (C
 	commentInput := make(CommentCreateInput)
 	commentInput["issueId"] = graphql.ID(input.IssueID)
 	commentInput["body"] = graphql.String(input.Body)
+	if input.ParentID != "" {
+		commentInput["parentId"] = graphql.ID(input.ParentID)
+	}
 
 	variables := map[string]interface{}{
 		"input": commentInput,
@@ -1516,7 +1834,8 @@ func (c *Client) CreateComment(ctx context.Context, input CreateCommentInput) (C
 			Email:       string(node.User.Email),
 			IsMe:        bool(node.User.IsMe),
 		},
-		IssueID: input.IssueID,
+		IssueID:  input.IssueID,
+		ParentID: input.ParentID,
 	}, nil
 }
 
@@ -1673,3 +1992,16 @@ func (c *Client) ListIssueLabels(ctx context.Context, teamID string) ([]IssueLab
 
 	return labels, nil
 }
+
+// IsUnreachable reports whether err means the API could not be reached at all
+// (DNS, connection or timeout failures), as opposed to the API rejecting the request.
+func IsUnreachable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
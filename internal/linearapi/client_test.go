@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 // issueNodeJSON returns a JSON object string for an issue node used in tests.
@@ -358,6 +359,51 @@ func TestFetchIssuesPage_NoNextPage(t *testing.T) {
 	}
 }
 
+// TestFetchIssuesPage_IncludeArchived verifies archived issues are requested and flagged.
+func TestFetchIssuesPage_IncludeArchived(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, issuesPageResponse([]string{
+		strings.Replace(issueNodeJSON("issue-1", "ABC-1", "Archived"), `"archivedAt": null`, `"archivedAt": "2025-01-02T00:00:00Z"`, 1),
+	}, false, ""), &request)
+
+	page, err := client.FetchIssuesPage(context.Background(), FetchIssuesParams{IncludeArchived: true}, nil)
+	if err != nil {
+		t.Fatalf("FetchIssuesPage() error = %v", err)
+	}
+	if query, _ := request["query"].(string); !strings.Contains(query, "includeArchived: $includeArchived") {
+		t.Fatalf("query = %s, want includeArchived argument", query)
+	}
+	if variables, _ := request["variables"].(map[string]interface{}); variables["includeArchived"] != true {
+		t.Fatalf("variables = %+v, want includeArchived", variables)
+	}
+	if len(page.Issues) != 1 || !page.Issues[0].Archived {
+		t.Fatalf("issues = %+v, want the archived issue", page.Issues)
+	}
+}
+
+// TestFetchIssuesPage_FetchesBlockersOnly verifies list pages request only inverse relations
+// and keep just the blockers, leaving the full relation list to the details fetch.
+func TestFetchIssuesPage_FetchesBlockersOnly(t *testing.T) {
+	var request map[string]interface{}
+	node := strings.Replace(issueNodeJSON("issue-1", "ABC-1", "Blocked"), `"archivedAt": null`, `"archivedAt": null,
+		"inverseRelations": {"nodes": [
+			{"id": "rel-1", "type": "blocks", "issue": {"id": "issue-2", "identifier": "ABC-2", "state": {"type": "started"}}},
+			{"id": "rel-2", "type": "related", "issue": {"id": "issue-3", "identifier": "ABC-3", "state": {"type": "started"}}}
+		]}`, 1)
+	client := graphQLTestServer(t, issuesPageResponse([]string{node}, false, ""), &request)
+
+	page, err := client.FetchIssuesPage(context.Background(), FetchIssuesParams{}, nil)
+	if err != nil {
+		t.Fatalf("FetchIssuesPage() error = %v", err)
+	}
+	if query, _ := request["query"].(string); strings.Contains(query, "relations(first: 25)") || !strings.Contains(query, "inverseRelations(first: 10)") {
+		t.Fatalf("query = %s, want only a small inverseRelations connection", query)
+	}
+	if len(page.Issues) != 1 || !page.Issues[0].IsBlocked() || len(page.Issues[0].Relations) != 1 {
+		t.Fatalf("issues = %+v, want ABC-1 blocked by ABC-2 only", page.Issues)
+	}
+}
+
 // TestFetchIssues_ProgressCallback verifies progress updates per page.
 func TestFetchIssues_ProgressCallback(t *testing.T) {
 	pageOne := issuesPageResponse([]string{
@@ -477,6 +523,35 @@ func TestBuildBaseIssueFilter(t *testing.T) {
 				"state":   map[string]interface{}{"id": map[string]interface{}{"eq": "state-2"}},
 			},
 		},
+		{
+			name:   "assignee filter",
+			params: FetchIssuesParams{AssigneeID: "user-1"},
+			want: IssueFilter{
+				"assignee": map[string]interface{}{"id": map[string]interface{}{"eq": "user-1"}},
+			},
+		},
+		{
+			name: "updated after filter",
+			params: FetchIssuesParams{
+				TeamID:       "team-1",
+				UpdatedAfter: time.Date(2025, 1, 2, 3, 4, 5, 0, time.FixedZone("UTC+2", 2*60*60)),
+			},
+			want: IssueFilter{
+				"team":      map[string]interface{}{"id": map[string]interface{}{"eq": "team-1"}},
+				"updatedAt": map[string]interface{}{"gt": "2025-01-02T01:04:05Z"},
+			},
+		},
+		{
+			name: "query filter is ANDed",
+			params: FetchIssuesParams{
+				TeamID: "team-1",
+				Filter: IssueFilter{"priority": map[string]interface{}{"lte": 2}},
+			},
+			want: IssueFilter{
+				"team": map[string]interface{}{"id": map[string]interface{}{"eq": "team-1"}},
+				"and":  []map[string]interface{}{{"priority": map[string]interface{}{"lte": 2}}},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -856,3 +931,29 @@ func TestUpdateIssueInput_ParentID(t *testing.T) {
 		}
 	})
 }
+
+// TestIsUnreachable verifies transport failures are told apart from API errors.
+func TestIsUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	endpoint := server.URL
+	server.Close()
+
+	client := NewClient(ClientConfig{Endpoint: endpoint, Token: "test", Timeout: time.Second})
+	_, err := client.UpdateIssue(context.Background(), UpdateIssueInput{ID: "issue-1"})
+	if err == nil {
+		t.Fatal("UpdateIssue() error = nil, want connection error")
+	}
+	if !IsUnreachable(err) {
+		t.Errorf("IsUnreachable(%v) = false, want true", err)
+	}
+
+	if IsUnreachable(fmt.Errorf("update issue: %w", fmt.Errorf("Entity not found"))) {
+		t.Error("IsUnreachable(api error) = true, want false")
+	}
+	if !IsUnreachable(fmt.Errorf("fetch: %w", context.DeadlineExceeded)) {
+		t.Error("IsUnreachable(deadline) = false, want true")
+	}
+	if IsUnreachable(nil) {
+		t.Error("IsUnreachable(nil) = true, want false")
+	}
+}
@@ -0,0 +1,86 @@
+package linearapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/shurcooL/graphql"
+)
+
+// CommentUpdateInput is a custom scalar type for Linear's CommentUpdateInput.
+// The Go type name must match the GraphQL type name exactly.
+type CommentUpdateInput map[string]interface{}
+
+// GetGraphQLType returns the GraphQL type name for the input.
+func (CommentUpdateInput) GetGraphQLType() string {
+	return "CommentUpdateInput"
+}
+
+// MarshalJSON implements json.Marshaler for CommentUpdateInput.
+func (c CommentUpdateInput) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}(c))
+}
+
+// UpdateComment replaces the body of a comment. Linear only allows authors to edit their comments.
+func (c *Client) UpdateComment(ctx context.Context, commentID, body string) (Comment, error) {
+	var mutation struct {
+		CommentUpdate struct {
+			Success graphql.Boolean
+			Comment struct {
+				ID        graphql.String
+				Body      graphql.String
+				CreatedAt graphql.String
+				UpdatedAt graphql.String
+			}
+		} `graphql:"commentUpdate(id: $id, input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"id":    graphql.String(commentID),
+		"input": CommentUpdateInput{"body": graphql.String(body)},
+	}
+
+	err := c.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.comments: UpdateComment failed comment_id=%s", commentID)
+		return Comment{}, fmt.Errorf("update comment %s: %w", commentID, err)
+	}
+	if !bool(mutation.CommentUpdate.Success) {
+		logger.Error("linearapi.comments: UpdateComment operation failed success=false comment_id=%s", commentID)
+		return Comment{}, fmt.Errorf("update comment %s: operation failed", commentID)
+	}
+
+	node := mutation.CommentUpdate.Comment
+	return Comment{
+		ID:        string(node.ID),
+		Body:      string(node.Body),
+		CreatedAt: parseTime(string(node.CreatedAt)),
+		UpdatedAt: parseTime(string(node.UpdatedAt)),
+	}, nil
+}
+
+// DeleteComment deletes a comment by its ID.
+func (c *Client) DeleteComment(ctx context.Context, commentID string) error {
+	var mutation struct {
+		CommentDelete struct {
+			Success graphql.Boolean
+		} `graphql:"commentDelete(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id": graphql.String(commentID),
+	}
+
+	err := c.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.comments: DeleteComment failed comment_id=%s", commentID)
+		return fmt.Errorf("delete comment %s: %w", commentID, err)
+	}
+	if !bool(mutation.CommentDelete.Success) {
+		logger.Error("linearapi.comments: DeleteComment operation failed success=false comment_id=%s", commentID)
+		return fmt.Errorf("delete comment %s: operation failed", commentID)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package linearapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestCreateComment_SendsParentID verifies replies carry the parent comment ID.
+func TestCreateComment_SendsParentID(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"commentCreate": {"success": true, "comment": {
+		"id": "c-2", "body": "Agreed", "createdAt": "2025-01-01T00:00:00Z", "updatedAt": "2025-01-01T00:00:00Z",
+		"user": {"id": "u-1", "name": "Ada", "displayName": "ada", "email": "ada@example.com", "isMe": true}}}}}`, &request)
+
+	comment, err := client.CreateComment(context.Background(), CreateCommentInput{IssueID: "issue-1", Body: "Agreed", ParentID: "c-1"})
+	if err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+	if comment.ParentID != "c-1" || !comment.Author.IsMe {
+		t.Fatalf("CreateComment() = %+v", comment)
+	}
+	input := request["variables"].(map[string]interface{})["input"].(map[string]interface{})
+	if input["parentId"] != "c-1" || input["issueId"] != "issue-1" {
+		t.Fatalf("input = %+v, want parentId c-1", input)
+	}
+
+	if _, err := client.CreateComment(context.Background(), CreateCommentInput{IssueID: "issue-1", Body: "Top"}); err != nil {
+		t.Fatalf("CreateComment() error = %v", err)
+	}
+	input = request["variables"].(map[string]interface{})["input"].(map[string]interface{})
+	if _, ok := input["parentId"]; ok {
+		t.Fatalf("input = %+v, want no parentId for top-level comments", input)
+	}
+}
+
+// TestUpdateComment_SendsBody verifies the comment ID and new body are sent.
+func TestUpdateComment_SendsBody(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"commentUpdate": {"success": true, "comment": {
+		"id": "c-1", "body": "Fixed typo", "createdAt": "2025-01-01T00:00:00Z", "updatedAt": "2025-01-02T00:00:00Z"}}}}`, &request)
+
+	comment, err := client.UpdateComment(context.Background(), "c-1", "Fixed typo")
+	if err != nil {
+		t.Fatalf("UpdateComment() error = %v", err)
+	}
+	if comment.Body != "Fixed typo" || comment.UpdatedAt.Equal(comment.CreatedAt) {
+		t.Fatalf("UpdateComment() = %+v", comment)
+	}
+	variables := request["variables"].(map[string]interface{})
+	if variables["id"] != "c-1" || variables["input"].(map[string]interface{})["body"] != "Fixed typo" {
+		t.Fatalf("variables = %+v", variables)
+	}
+	if query, _ := request["query"].(string); !strings.Contains(query, "commentUpdate(id: $id, input: $input)") {
+		t.Fatalf("query = %q", query)
+	}
+}
+
+// TestDeleteComment_ReportsFailure verifies success=false is an error.
+func TestDeleteComment_ReportsFailure(t *testing.T) {
+	client := graphQLTestServer(t, `{"data": {"commentDelete": {"success": false}}}`, nil)
+	if err := client.DeleteComment(context.Background(), "c-1"); err == nil {
+		t.Fatal("DeleteComment() expected error on success=false")
+	}
+}
@@ -0,0 +1,163 @@
+package linearapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/shurcooL/graphql"
+)
+
+// Cycle represents a team's time-boxed iteration.
+type Cycle struct {
+	ID          string
+	Number      int
+	Name        string // Empty for unnamed cycles; use DisplayName for labels
+	TeamID      string
+	StartsAt    time.Time
+	EndsAt      time.Time
+	CompletedAt time.Time // Zero until the cycle is completed
+	Progress    float64   // Completed fraction of the cycle's scope, 0..1
+	IsActive    bool
+	IsFuture    bool
+	IsPast      bool
+	// ScopeHistory and CompletedScopeHistory hold one data point per cycle day,
+	// as reported by Linear; they drive the burn-up summary.
+	ScopeHistory          []float64
+	CompletedScopeHistory []float64
+}
+
+// DisplayName returns the cycle name, or "Cycle N" when the cycle is unnamed.
+func (c Cycle) DisplayName() string {
+	return CycleDisplayName(c.Number, c.Name)
+}
+
+// Status returns "active", "upcoming" or "past".
+func (c Cycle) Status() string {
+	switch {
+	case c.IsActive:
+		return "active"
+	case c.IsFuture:
+		return "upcoming"
+	default:
+		return "past"
+	}
+}
+
+// IssueFilter returns the issue filter matching issues in this cycle.
+func (c Cycle) IssueFilter() IssueFilter {
+	return IssueFilter{"cycle": map[string]interface{}{"id": map[string]interface{}{"eq": c.ID}}}
+}
+
+// CycleDisplayName returns name, or "Cycle N" when name is empty.
+func CycleDisplayName(number int, name string) string {
+	if name != "" {
+		return name
+	}
+	return fmt.Sprintf("Cycle %d", number)
+}
+
+// cycleDisplayNameFromNode returns the display name for a GraphQL cycle node.
+func cycleDisplayNameFromNode(number graphql.Float, name *graphql.String) string {
+	if name == nil {
+		return CycleDisplayName(int(number), "")
+	}
+	return CycleDisplayName(int(number), string(*name))
+}
+
+// ListCycles fetches a team's cycles ordered active first, then upcoming
+// (soonest first), then past (most recent first).
+func (c *Client) ListCycles(ctx context.Context, teamID string) ([]Cycle, error) {
+	var query struct {
+		Team struct {
+			Cycles struct {
+				Nodes []struct {
+					ID                    graphql.String
+					Number                graphql.Float
+					Name                  *graphql.String
+					StartsAt              graphql.String
+					EndsAt                graphql.String
+					CompletedAt           *graphql.String
+					Progress              graphql.Float
+					IsActive              graphql.Boolean
+					IsFuture              graphql.Boolean
+					IsPast                graphql.Boolean
+					ScopeHistory          []graphql.Float
+					CompletedScopeHistory []graphql.Float
+				}
+			} `graphql:"cycles(first: 100)"`
+		} `graphql:"team(id: $teamId)"`
+	}
+
+	variables := map[string]interface{}{
+		"teamId": graphql.String(teamID),
+	}
+
+	err := c.client.Query(ctx, &query, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.cycles: ListCycles failed team_id=%s", teamID)
+		return nil, fmt.Errorf("list cycles: %w", err)
+	}
+
+	cycles := make([]Cycle, 0, len(query.Team.Cycles.Nodes))
+	for _, node := range query.Team.Cycles.Nodes {
+		cycle := Cycle{
+			ID:                    string(node.ID),
+			Number:                int(node.Number),
+			TeamID:                teamID,
+			StartsAt:              parseTime(string(node.StartsAt)),
+			EndsAt:                parseTime(string(node.EndsAt)),
+			Progress:              float64(node.Progress),
+			IsActive:              bool(node.IsActive),
+			IsFuture:              bool(node.IsFuture),
+			IsPast:                bool(node.IsPast),
+			ScopeHistory:          floatsFromGraphQL(node.ScopeHistory),
+			CompletedScopeHistory: floatsFromGraphQL(node.CompletedScopeHistory),
+		}
+		if node.Name != nil {
+			cycle.Name = string(*node.Name)
+		}
+		if node.CompletedAt != nil {
+			cycle.CompletedAt = parseTime(string(*node.CompletedAt))
+		}
+		cycles = append(cycles, cycle)
+	}
+	SortCycles(cycles)
+
+	return cycles, nil
+}
+
+// SortCycles orders cycles active first, then upcoming (soonest first), then past (most recent first).
+func SortCycles(cycles []Cycle) {
+	rank := func(c Cycle) int {
+		switch c.Status() {
+		case "active":
+			return 0
+		case "upcoming":
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(cycles, func(i, j int) bool {
+		ri, rj := rank(cycles[i]), rank(cycles[j])
+		if ri != rj {
+			return ri < rj
+		}
+		if ri == 2 {
+			return cycles[i].StartsAt.After(cycles[j].StartsAt)
+		}
+		return cycles[i].StartsAt.Before(cycles[j].StartsAt)
+	})
+}
+
+// floatsFromGraphQL converts a GraphQL float list to plain floats.
+func floatsFromGraphQL(values []graphql.Float) []float64 {
+	out := make([]float64, len(values))
+	for i, v := range values {
+		out[i] = float64(v)
+	}
+	return out
+}
@@ -0,0 +1,112 @@
+package linearapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestListCycles_ParsesAndSorts verifies cycles are parsed and ordered active, upcoming, then past.
+func TestListCycles_ParsesAndSorts(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"team": {"cycles": {"nodes": [
+		{"id": "c-10", "number": 10, "name": null, "startsAt": "2025-01-01T00:00:00Z", "endsAt": "2025-01-15T00:00:00Z",
+		 "completedAt": "2025-01-15T00:00:00Z", "progress": 1, "isActive": false, "isFuture": false, "isPast": true,
+		 "scopeHistory": [], "completedScopeHistory": []},
+		{"id": "c-13", "number": 13, "name": "Polish", "startsAt": "2025-02-12T00:00:00Z", "endsAt": "2025-02-26T00:00:00Z",
+		 "completedAt": null, "progress": 0, "isActive": false, "isFuture": true, "isPast": false,
+		 "scopeHistory": [], "completedScopeHistory": []},
+		{"id": "c-11", "number": 11, "name": null, "startsAt": "2025-01-15T00:00:00Z", "endsAt": "2025-01-29T00:00:00Z",
+		 "completedAt": "2025-01-29T00:00:00Z", "progress": 0.8, "isActive": false, "isFuture": false, "isPast": true,
+		 "scopeHistory": [], "completedScopeHistory": []},
+		{"id": "c-12", "number": 12, "name": "", "startsAt": "2025-01-29T00:00:00Z", "endsAt": "2025-02-12T00:00:00Z",
+		 "completedAt": null, "progress": 0.45, "isActive": true, "isFuture": false, "isPast": false,
+		 "scopeHistory": [10, 12, 12], "completedScopeHistory": [0, 3, 5]}
+	]}}}}`, &request)
+
+	cycles, err := client.ListCycles(context.Background(), "team-1")
+	if err != nil {
+		t.Fatalf("ListCycles() error = %v", err)
+	}
+
+	var order []string
+	for _, cycle := range cycles {
+		order = append(order, cycle.ID)
+	}
+	if got := strings.Join(order, ","); got != "c-12,c-13,c-11,c-10" {
+		t.Fatalf("ListCycles() order = %s, want c-12,c-13,c-11,c-10", got)
+	}
+
+	active := cycles[0]
+	if active.DisplayName() != "Cycle 12" || active.Status() != "active" || active.TeamID != "team-1" {
+		t.Fatalf("active cycle = %+v", active)
+	}
+	if len(active.ScopeHistory) != 3 || active.CompletedScopeHistory[2] != 5 || active.Progress != 0.45 {
+		t.Fatalf("active cycle history = %+v", active)
+	}
+	if cycles[1].DisplayName() != "Polish" || cycles[1].Status() != "upcoming" {
+		t.Fatalf("upcoming cycle = %+v", cycles[1])
+	}
+	if cycles[3].CompletedAt.IsZero() {
+		t.Fatal("past cycle CompletedAt not parsed")
+	}
+
+	variables, _ := request["variables"].(map[string]interface{})
+	if variables["teamId"] != "team-1" {
+		t.Fatalf("variables = %+v", variables)
+	}
+}
+
+// TestFetchIssuesPage_ParsesCycle verifies issue nodes carry their cycle.
+func TestFetchIssuesPage_ParsesCycle(t *testing.T) {
+	node := strings.Replace(issueNodeJSON("issue-1", "ABC-1", "First"),
+		`"project": null,`, `"project": null, "cycle": {"id": "c-12", "number": 12, "name": null},`, 1)
+	client := graphQLTestServer(t, fmt.Sprintf(`{"data": {"issues": {
+		"nodes": [%s, %s], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}`,
+		node, issueNodeJSON("issue-2", "ABC-2", "Second")), nil)
+
+	page, err := client.FetchIssuesPage(context.Background(), FetchIssuesParams{TeamID: "team-1"}, nil)
+	if err != nil {
+		t.Fatalf("FetchIssuesPage() error = %v", err)
+	}
+	if len(page.Issues) != 2 {
+		t.Fatalf("FetchIssuesPage() issues = %+v", page.Issues)
+	}
+	if page.Issues[0].CycleID != "c-12" || page.Issues[0].CycleName != "Cycle 12" {
+		t.Fatalf("issue cycle = %q %q, want c-12 Cycle 12", page.Issues[0].CycleID, page.Issues[0].CycleName)
+	}
+	if page.Issues[1].CycleID != "" || page.Issues[1].CycleName != "" {
+		t.Fatalf("issue without cycle = %q %q", page.Issues[1].CycleID, page.Issues[1].CycleName)
+	}
+}
+
+// TestUpdateIssue_CycleID verifies moving an issue sends cycleId and removing it sends null.
+func TestUpdateIssue_CycleID(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"issueUpdate": {"success": true, "issue": {
+		"id": "issue-1", "identifier": "ABC-1", "title": "First", "state": {"id": "s", "name": "Todo"},
+		"team": {"id": "team-1"}, "labels": {"nodes": []}, "cycle": {"id": "c-12", "number": 12, "name": "Polish"}}}}}`, &request)
+
+	cycleID := "c-12"
+	issue, err := client.UpdateIssue(context.Background(), UpdateIssueInput{ID: "issue-1", CycleID: &cycleID})
+	if err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+	if issue.CycleID != "c-12" || issue.CycleName != "Polish" {
+		t.Fatalf("UpdateIssue() cycle = %q %q", issue.CycleID, issue.CycleName)
+	}
+	input := request["variables"].(map[string]interface{})["input"].(map[string]interface{})
+	if input["cycleId"] != "c-12" {
+		t.Fatalf("input = %+v, want cycleId c-12", input)
+	}
+
+	noCycle := ""
+	if _, err := client.UpdateIssue(context.Background(), UpdateIssueInput{ID: "issue-1", CycleID: &noCycle}); err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+	input = request["variables"].(map[string]interface{})["input"].(map[string]interface{})
+	if value, ok := input["cycleId"]; !ok || value != nil {
+		t.Fatalf("input = %+v, want cycleId null", input)
+	}
+}
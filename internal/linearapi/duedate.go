@@ -0,0 +1,148 @@
+package linearapi
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DueDateLayout is the YYYY-MM-DD format Linear uses for due dates.
+const DueDateLayout = "2006-01-02"
+
+// dueDateOffset matches "in 3 days", "+2w", "3d" and similar offsets from today.
+var dueDateOffset = regexp.MustCompile(`^(?:in\s+|\+)?(\d+)\s*(d|day|days|w|wk|week|weeks|m|mo|month|months)$`)
+
+// dueDateLayouts are the absolute date formats accepted by ParseDueDate.
+// Layouts without a year resolve to the next occurrence of that day.
+var dueDateLayouts = []struct {
+	layout  string
+	hasYear bool
+}{
+	{DueDateLayout, true},
+	{"Jan 2 2006", true},
+	{"Jan 2, 2006", true},
+	{"January 2 2006", true},
+	{"January 2, 2006", true},
+	{"2 Jan 2006", true},
+	{"2 January 2006", true},
+	{"Jan 2", false},
+	{"January 2", false},
+	{"2 Jan", false},
+	{"2 January", false},
+}
+
+// ParseDueDate parses a natural-language due date relative to now and returns it
+// as YYYY-MM-DD. It accepts dates (2025-03-07, Mar 7, 7 March 2025), relative days
+// (today, tomorrow, friday, next friday), offsets (in 3 days, 2w, +1m) and periods
+// (next week, end of week, next month, end of month). "none" or an empty string
+// returns an empty string, which clears the due date.
+func ParseDueDate(input string, now time.Time) (string, error) {
+	text := strings.Join(strings.Fields(strings.ToLower(input)), " ")
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	date, ok := parseRelativeDueDate(text, today)
+	if !ok {
+		date, ok = parseAbsoluteDueDate(strings.TrimSpace(input), today)
+	}
+	if !ok {
+		return "", fmt.Errorf("unrecognized due date %q (try tomorrow, friday, in 3 days, Mar 7 or 2025-03-07)", input)
+	}
+	if date.IsZero() {
+		return "", nil
+	}
+	return date.Format(DueDateLayout), nil
+}
+
+// parseRelativeDueDate handles keywords, weekdays and offsets. A zero time with
+// ok=true means "no due date".
+func parseRelativeDueDate(text string, today time.Time) (time.Time, bool) {
+	switch text {
+	case "", "none", "no due date", "clear", "remove":
+		return time.Time{}, true
+	case "today", "tod":
+		return today, true
+	case "tomorrow", "tom", "tmr":
+		return today.AddDate(0, 0, 1), true
+	case "yesterday":
+		return today.AddDate(0, 0, -1), true
+	case "next week":
+		return startOfWeek(today).AddDate(0, 0, 7), true
+	case "end of week", "eow", "this week":
+		return nextWeekday(today, time.Friday, true), true
+	case "next month":
+		return time.Date(today.Year(), today.Month()+1, 1, 0, 0, 0, 0, today.Location()), true
+	case "end of month", "eom", "this month":
+		return time.Date(today.Year(), today.Month()+1, 0, 0, 0, 0, 0, today.Location()), true
+	}
+
+	if weekday, ok := parseWeekday(strings.TrimPrefix(text, "this ")); ok {
+		return nextWeekday(today, weekday, false), true
+	}
+	if rest, found := strings.CutPrefix(text, "next "); found {
+		if weekday, ok := parseWeekday(rest); ok {
+			// The weekday in next week's Monday-to-Sunday span
+			offset := (int(weekday) + 6) % 7
+			return startOfWeek(today).AddDate(0, 0, 7+offset), true
+		}
+	}
+
+	if match := dueDateOffset.FindStringSubmatch(text); match != nil {
+		n, _ := strconv.Atoi(match[1])
+		switch match[2][0] {
+		case 'd':
+			return today.AddDate(0, 0, n), true
+		case 'w':
+			return today.AddDate(0, 0, 7*n), true
+		default:
+			return today.AddDate(0, n, 0), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseAbsoluteDueDate handles explicit dates; dates without a year that already
+// passed this year roll over to next year.
+func parseAbsoluteDueDate(text string, today time.Time) (time.Time, bool) {
+	for _, candidate := range dueDateLayouts {
+		parsed, err := time.ParseInLocation(candidate.layout, text, today.Location())
+		if err != nil {
+			continue
+		}
+		if candidate.hasYear {
+			return parsed, true
+		}
+		date := time.Date(today.Year(), parsed.Month(), parsed.Day(), 0, 0, 0, 0, today.Location())
+		if date.Before(today) {
+			date = date.AddDate(1, 0, 0)
+		}
+		return date, true
+	}
+	return time.Time{}, false
+}
+
+// parseWeekday parses a full or three-letter weekday name.
+func parseWeekday(text string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if text == name || text == name[:3] {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+// nextWeekday returns the next date falling on weekday, after today or from today when includeToday is set.
+func nextWeekday(today time.Time, weekday time.Weekday, includeToday bool) time.Time {
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	if days == 0 && !includeToday {
+		days = 7
+	}
+	return today.AddDate(0, 0, days)
+}
+
+// startOfWeek returns the Monday of today's week.
+func startOfWeek(today time.Time) time.Time {
+	return today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+}
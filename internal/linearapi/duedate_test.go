@@ -0,0 +1,49 @@
+package linearapi
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseDueDate verifies natural-language due dates relative to Wednesday 2025-03-05.
+func TestParseDueDate(t *testing.T) {
+	now := time.Date(2025, 3, 5, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", ""},
+		{"none", ""},
+		{"today", "2025-03-05"},
+		{"Tomorrow", "2025-03-06"},
+		{"friday", "2025-03-07"},
+		{"wed", "2025-03-12"},
+		{"next friday", "2025-03-14"},
+		{"next week", "2025-03-10"},
+		{"end of week", "2025-03-07"},
+		{"eom", "2025-03-31"},
+		{"next month", "2025-04-01"},
+		{"in 3 days", "2025-03-08"},
+		{"2w", "2025-03-19"},
+		{"+1m", "2025-04-05"},
+		{"2025-12-24", "2025-12-24"},
+		{"Mar 20", "2025-03-20"},
+		{"1 March", "2026-03-01"},
+		{"January 2, 2026", "2026-01-02"},
+	}
+	for _, tt := range tests {
+		got, err := ParseDueDate(tt.input, now)
+		if err != nil {
+			t.Fatalf("ParseDueDate(%q) error = %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Fatalf("ParseDueDate(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	for _, input := range []string{"someday", "2025-13-40", "in days"} {
+		if _, err := ParseDueDate(input, now); err == nil {
+			t.Fatalf("ParseDueDate(%q) expected error", input)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package linearapi
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Team estimation types, as reported by Linear.
+const (
+	EstimationNotUsed     = "notUsed"
+	EstimationExponential = "exponential"
+	EstimationFibonacci   = "fibonacci"
+	EstimationLinear      = "linear"
+	EstimationTShirt      = "tShirt"
+)
+
+// EstimateOption is one value on a team's estimation scale.
+type EstimateOption struct {
+	Value int
+	Label string // e.g. "3 points" or "M"
+}
+
+// tShirtSizes maps t-shirt estimate values to their sizes.
+var tShirtSizes = map[int]string{0: "None", 1: "XS", 2: "S", 3: "M", 5: "L", 8: "XL", 13: "XXL", 21: "XXXL"}
+
+// EstimateScale returns the estimate values a team allows, smallest first.
+// It returns nil when the team does not use estimates.
+func EstimateScale(team Team) []EstimateOption {
+	var values []int
+	switch team.EstimationType {
+	case EstimationExponential:
+		values = []int{1, 2, 4, 8, 16}
+		if team.EstimationExtended {
+			values = append(values, 32, 64)
+		}
+	case EstimationFibonacci, EstimationTShirt:
+		values = []int{1, 2, 3, 5, 8}
+		if team.EstimationExtended {
+			values = append(values, 13, 21)
+		}
+	case EstimationLinear:
+		values = []int{1, 2, 3, 4, 5}
+		if team.EstimationExtended {
+			values = append(values, 6, 7)
+		}
+	default:
+		return nil
+	}
+	if team.EstimationAllowZero {
+		values = append([]int{0}, values...)
+	}
+
+	options := make([]EstimateOption, 0, len(values))
+	for _, value := range values {
+		options = append(options, EstimateOption{Value: value, Label: FormatEstimate(value, team.EstimationType)})
+	}
+	return options
+}
+
+// FormatEstimate formats an estimate value for a team's estimation type.
+func FormatEstimate(value int, estimationType string) string {
+	if estimationType == EstimationTShirt {
+		if size, ok := tShirtSizes[value]; ok {
+			return size
+		}
+	}
+	if value == 1 {
+		return "1 point"
+	}
+	return fmt.Sprintf("%d points", value)
+}
+
+// parseIssueFields reads the optional scheduling, people and SLA fields of a
+// GraphQL issue node. Fields the node does not query are left unset.
+func parseIssueFields(v reflect.Value, issue *Issue) {
+	optional := func(name string) (reflect.Value, bool) {
+		field := v.FieldByName(name)
+		if !field.IsValid() || field.IsNil() {
+			return reflect.Value{}, false
+		}
+		return field.Elem(), true
+	}
+	optionalTime := func(name string) time.Time {
+		if field, ok := optional(name); ok {
+			return parseTime(field.String())
+		}
+		return time.Time{}
+	}
+
+	if estimate, ok := optional("Estimate"); ok {
+		value := int(estimate.Float())
+		issue.Estimate = &value
+	}
+	if dueDate, ok := optional("DueDate"); ok {
+		issue.DueDate = dueDate.String()
+	}
+	if creator, ok := optional("Creator"); ok {
+		issue.CreatorID = creator.FieldByName("ID").String()
+		issue.Creator = creator.FieldByName("Name").String()
+	}
+	issue.StartedAt = optionalTime("StartedAt")
+	issue.CompletedAt = optionalTime("CompletedAt")
+	issue.CanceledAt = optionalTime("CanceledAt")
+	issue.SLABreachesAt = optionalTime("SlaBreachesAt")
+
+	if subscribers := v.FieldByName("Subscribers"); subscribers.IsValid() {
+		nodes := subscribers.FieldByName("Nodes")
+		issue.Subscribers = make([]User, 0, nodes.Len())
+		for i := 0; i < nodes.Len(); i++ {
+			node := nodes.Index(i)
+			issue.Subscribers = append(issue.Subscribers, User{
+				ID:          node.FieldByName("ID").String(),
+				Name:        node.FieldByName("Name").String(),
+				DisplayName: node.FieldByName("DisplayName").String(),
+				Email:       node.FieldByName("Email").String(),
+				IsMe:        node.FieldByName("IsMe").Bool(),
+			})
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package linearapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestEstimateScale verifies the scale for each estimation type and its options.
+func TestEstimateScale(t *testing.T) {
+	tests := []struct {
+		name string
+		team Team
+		want []string
+	}{
+		{"not used", Team{EstimationType: EstimationNotUsed}, nil},
+		{"exponential", Team{EstimationType: EstimationExponential}, []string{"1 point", "2 points", "4 points", "8 points", "16 points"}},
+		{"fibonacci extended", Team{EstimationType: EstimationFibonacci, EstimationExtended: true},
+			[]string{"1 point", "2 points", "3 points", "5 points", "8 points", "13 points", "21 points"}},
+		{"linear with zero", Team{EstimationType: EstimationLinear, EstimationAllowZero: true},
+			[]string{"0 points", "1 point", "2 points", "3 points", "4 points", "5 points"}},
+		{"t-shirt", Team{EstimationType: EstimationTShirt}, []string{"XS", "S", "M", "L", "XL"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, option := range EstimateScale(tt.team) {
+				got = append(got, option.Label)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("EstimateScale() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFetchIssuesPage_ParsesIssueFields verifies estimate, due date, creator, project and timestamps.
+func TestFetchIssuesPage_ParsesIssueFields(t *testing.T) {
+	node := strings.Replace(issueNodeJSON("issue-1", "ABC-1", "First"), `"project": null,`, `"project": {"id": "p-1", "name": "Launch"},
+		"estimate": 3, "dueDate": "2025-03-07", "creator": {"id": "u-1", "name": "Ada"},
+		"startedAt": "2025-01-02T10:00:00Z", "completedAt": null, "canceledAt": null, "slaBreachesAt": "2025-01-05T00:00:00Z",`, 1)
+	client := graphQLTestServer(t, fmt.Sprintf(`{"data": {"issues": {
+		"nodes": [%s, %s], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}`,
+		node, issueNodeJSON("issue-2", "ABC-2", "Second")), nil)
+
+	page, err := client.FetchIssuesPage(context.Background(), FetchIssuesParams{TeamID: "team-1"}, nil)
+	if err != nil {
+		t.Fatalf("FetchIssuesPage() error = %v", err)
+	}
+	issue := page.Issues[0]
+	if issue.Estimate == nil || *issue.Estimate != 3 || issue.DueDate != "2025-03-07" {
+		t.Fatalf("estimate/due date = %v %q", issue.Estimate, issue.DueDate)
+	}
+	if issue.ProjectID != "p-1" || issue.ProjectName != "Launch" || issue.CreatorID != "u-1" || issue.Creator != "Ada" {
+		t.Fatalf("project/creator = %+v", issue)
+	}
+	if !issue.StartedAt.Equal(time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)) || !issue.CompletedAt.IsZero() || issue.SLABreachesAt.IsZero() {
+		t.Fatalf("timestamps = %v %v %v", issue.StartedAt, issue.CompletedAt, issue.SLABreachesAt)
+	}
+
+	other := page.Issues[1]
+	if other.Estimate != nil || other.DueDate != "" || other.Creator != "" || !other.StartedAt.IsZero() {
+		t.Fatalf("issue without fields = %+v", other)
+	}
+}
+
+// TestUpdateIssue_ClearsFields verifies empty project, due date and NoEstimate are sent as null.
+func TestUpdateIssue_ClearsFields(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"issueUpdate": {"success": true, "issue": {
+		"id": "issue-1", "identifier": "ABC-1", "title": "First", "state": {"id": "s", "name": "Todo"},
+		"team": {"id": "team-1"}, "labels": {"nodes": []}}}}}`, &request)
+
+	empty := ""
+	noEstimate := NoEstimate
+	input := UpdateIssueInput{ID: "issue-1", ProjectID: &empty, DueDate: &empty, Estimate: &noEstimate}
+	if _, err := client.UpdateIssue(context.Background(), input); err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+	sent := request["variables"].(map[string]interface{})["input"].(map[string]interface{})
+	for _, key := range []string{"projectId", "dueDate", "estimate"} {
+		if value, ok := sent[key]; !ok || value != nil {
+			t.Fatalf("input = %+v, want %s null", sent, key)
+		}
+	}
+
+	dueDate := "2025-03-07"
+	estimate := 5
+	input = UpdateIssueInput{ID: "issue-1", DueDate: &dueDate, Estimate: &estimate}
+	if _, err := client.UpdateIssue(context.Background(), input); err != nil {
+		t.Fatalf("UpdateIssue() error = %v", err)
+	}
+	sent = request["variables"].(map[string]interface{})["input"].(map[string]interface{})
+	if sent["dueDate"] != "2025-03-07" || sent["estimate"] != float64(5) {
+		t.Fatalf("input = %+v, want dueDate and estimate", sent)
+	}
+	if _, ok := sent["projectId"]; ok {
+		t.Fatalf("input = %+v, want no projectId", sent)
+	}
+}
@@ -0,0 +1,159 @@
+package linearapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/shurcooL/graphql"
+)
+
+// IssueHistoryEntry is one change in an issue's history. A single entry can record
+// several changes made together, such as a state change that also reassigned the issue.
+type IssueHistoryEntry struct {
+	ID            string
+	CreatedAt     time.Time
+	Actor         string // Empty for changes made by automations and integrations
+	FromState     string
+	ToState       string
+	FromAssignee  string
+	ToAssignee    string
+	AssigneeSet   bool // The assignee changed; From/ToAssignee are empty when unassigned
+	AddedLabels   []string
+	RemovedLabels []string
+	FromPriority  *int
+	ToPriority    *int
+	FromTitle     string
+	ToTitle       string
+}
+
+// Changes describes the entry's changes, e.g. `changed status from Todo to In Progress`.
+func (e IssueHistoryEntry) Changes() []string {
+	var changes []string
+	if e.FromState != "" || e.ToState != "" {
+		if e.FromState == "" {
+			changes = append(changes, fmt.Sprintf("set status to %s", e.ToState))
+		} else {
+			changes = append(changes, fmt.Sprintf("changed status from %s to %s", e.FromState, e.ToState))
+		}
+	}
+	if e.AssigneeSet {
+		switch {
+		case e.ToAssignee == "":
+			changes = append(changes, fmt.Sprintf("unassigned %s", e.FromAssignee))
+		case e.FromAssignee == "":
+			changes = append(changes, fmt.Sprintf("assigned to %s", e.ToAssignee))
+		default:
+			changes = append(changes, fmt.Sprintf("reassigned from %s to %s", e.FromAssignee, e.ToAssignee))
+		}
+	}
+	for _, label := range e.AddedLabels {
+		changes = append(changes, fmt.Sprintf("added label %s", label))
+	}
+	for _, label := range e.RemovedLabels {
+		changes = append(changes, fmt.Sprintf("removed label %s", label))
+	}
+	if e.FromPriority != nil && e.ToPriority != nil && *e.FromPriority != *e.ToPriority {
+		changes = append(changes, fmt.Sprintf("changed priority from %s to %s", PriorityLabel(*e.FromPriority), PriorityLabel(*e.ToPriority)))
+	}
+	if e.FromTitle != "" && e.ToTitle != "" && e.FromTitle != e.ToTitle {
+		changes = append(changes, fmt.Sprintf("renamed from %q to %q", e.FromTitle, e.ToTitle))
+	}
+	return changes
+}
+
+// ListIssueHistory fetches the recent history of an issue, oldest first.
+// Entries without a change Changes can describe are skipped.
+func (c *Client) ListIssueHistory(ctx context.Context, issueID string) ([]IssueHistoryEntry, error) {
+	type named struct {
+		Name graphql.String
+	}
+	var query struct {
+		Issue struct {
+			History struct {
+				Nodes []struct {
+					ID            graphql.String
+					CreatedAt     graphql.String
+					Actor         *named
+					FromState     *named
+					ToState       *named
+					FromAssignee  *named
+					ToAssignee    *named
+					AddedLabels   []named
+					RemovedLabels []named
+					FromPriority  *graphql.Float
+					ToPriority    *graphql.Float
+					FromTitle     *graphql.String
+					ToTitle       *graphql.String
+				}
+			} `graphql:"history(first: 100)"`
+		} `graphql:"issue(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id": graphql.String(issueID),
+	}
+
+	err := c.client.Query(ctx, &query, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.history: ListIssueHistory failed issue_id=%s", issueID)
+		return nil, fmt.Errorf("list history for issue %s: %w", issueID, err)
+	}
+
+	name := func(n *named) string {
+		if n == nil {
+			return ""
+		}
+		return string(n.Name)
+	}
+	names := func(list []named) []string {
+		out := make([]string, 0, len(list))
+		for _, n := range list {
+			out = append(out, string(n.Name))
+		}
+		return out
+	}
+	priority := func(f *graphql.Float) *int {
+		if f == nil {
+			return nil
+		}
+		value := int(*f)
+		return &value
+	}
+	text := func(s *graphql.String) string {
+		if s == nil {
+			return ""
+		}
+		return string(*s)
+	}
+
+	entries := make([]IssueHistoryEntry, 0, len(query.Issue.History.Nodes))
+	for _, node := range query.Issue.History.Nodes {
+		entry := IssueHistoryEntry{
+			ID:            string(node.ID),
+			CreatedAt:     parseTime(string(node.CreatedAt)),
+			Actor:         name(node.Actor),
+			FromState:     name(node.FromState),
+			ToState:       name(node.ToState),
+			FromAssignee:  name(node.FromAssignee),
+			ToAssignee:    name(node.ToAssignee),
+			AssigneeSet:   node.FromAssignee != nil || node.ToAssignee != nil,
+			AddedLabels:   names(node.AddedLabels),
+			RemovedLabels: names(node.RemovedLabels),
+			FromPriority:  priority(node.FromPriority),
+			ToPriority:    priority(node.ToPriority),
+			FromTitle:     text(node.FromTitle),
+			ToTitle:       text(node.ToTitle),
+		}
+		if len(entry.Changes()) == 0 {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+	return entries, nil
+}
@@ -0,0 +1,64 @@
+package linearapi
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestListIssueHistory_ParsesAndOrdersEntries verifies entries are parsed, sorted oldest
+// first and that entries without a describable change are skipped.
+func TestListIssueHistory_ParsesAndOrdersEntries(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"issue": {"history": {"nodes": [
+		{"id": "h-2", "createdAt": "2025-01-02T00:00:00Z", "actor": {"name": "Ada"},
+		 "fromState": {"name": "Todo"}, "toState": {"name": "In Progress"},
+		 "fromAssignee": null, "toAssignee": {"name": "Grace"},
+		 "addedLabels": [{"name": "bug"}], "removedLabels": [],
+		 "fromPriority": 3, "toPriority": 1, "fromTitle": null, "toTitle": null},
+		{"id": "h-1", "createdAt": "2025-01-01T00:00:00Z", "actor": null,
+		 "fromState": null, "toState": null, "fromAssignee": null, "toAssignee": null,
+		 "addedLabels": [], "removedLabels": [], "fromPriority": null, "toPriority": null,
+		 "fromTitle": "Old", "toTitle": "New"},
+		{"id": "h-3", "createdAt": "2025-01-03T00:00:00Z", "actor": {"name": "Ada"},
+		 "fromState": null, "toState": null, "fromAssignee": null, "toAssignee": null,
+		 "addedLabels": [], "removedLabels": [], "fromPriority": null, "toPriority": null,
+		 "fromTitle": null, "toTitle": null}
+	]}}}}`, &request)
+
+	entries, err := client.ListIssueHistory(context.Background(), "issue-1")
+	if err != nil {
+		t.Fatalf("ListIssueHistory() error = %v", err)
+	}
+	if len(entries) != 2 || entries[0].ID != "h-1" || entries[1].ID != "h-2" {
+		t.Fatalf("ListIssueHistory() = %+v, want h-1 then h-2", entries)
+	}
+	if entries[0].Actor != "" || !reflect.DeepEqual(entries[0].Changes(), []string{`renamed from "Old" to "New"`}) {
+		t.Fatalf("entries[0] = %+v, changes %q", entries[0], entries[0].Changes())
+	}
+	want := []string{
+		"changed status from Todo to In Progress",
+		"assigned to Grace",
+		"added label bug",
+		"changed priority from Normal to Urgent",
+	}
+	if got := entries[1].Changes(); entries[1].Actor != "Ada" || !reflect.DeepEqual(got, want) {
+		t.Fatalf("entries[1] changes = %q, want %q", got, want)
+	}
+	if query, _ := request["query"].(string); !strings.Contains(query, "history(first: 100)") {
+		t.Fatalf("query = %q", query)
+	}
+}
+
+// TestIssueHistoryEntry_ChangesAssignee verifies unassign and reassign descriptions.
+func TestIssueHistoryEntry_ChangesAssignee(t *testing.T) {
+	unassigned := IssueHistoryEntry{AssigneeSet: true, FromAssignee: "Ada"}
+	if got := unassigned.Changes(); !reflect.DeepEqual(got, []string{"unassigned Ada"}) {
+		t.Fatalf("Changes() = %q", got)
+	}
+	reassigned := IssueHistoryEntry{AssigneeSet: true, FromAssignee: "Ada", ToAssignee: "Grace"}
+	if got := reassigned.Changes(); !reflect.DeepEqual(got, []string{"reassigned from Ada to Grace"}) {
+		t.Fatalf("Changes() = %q", got)
+	}
+}
@@ -0,0 +1,214 @@
+package linearapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/shurcooL/graphql"
+)
+
+// NotificationUpdateInput is a custom scalar type for Linear's NotificationUpdateInput.
+// The Go type name must match the GraphQL type name exactly.
+type NotificationUpdateInput map[string]interface{}
+
+// GetGraphQLType returns the GraphQL type name for the input.
+func (NotificationUpdateInput) GetGraphQLType() string {
+	return "NotificationUpdateInput"
+}
+
+// MarshalJSON implements json.Marshaler for NotificationUpdateInput.
+func (n NotificationUpdateInput) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}(n))
+}
+
+// Notification is an item of the current user's Linear inbox.
+type Notification struct {
+	ID              string
+	Type            string // e.g. "issueAssignedToYou", "issueMention", "issueNewComment"
+	CreatedAt       time.Time
+	ReadAt          time.Time // Zero while unread
+	SnoozedUntil    time.Time // Zero unless snoozed
+	Actor           string    // Empty for notifications from automations
+	IssueID         string    // Empty for notifications not about an issue
+	IssueIdentifier string
+	IssueTitle      string
+	CommentBody     string // Set for comment notifications
+}
+
+// IsRead reports whether the notification has been read.
+func (n Notification) IsRead() bool {
+	return !n.ReadAt.IsZero()
+}
+
+// IsSnoozed reports whether the notification is snoozed at now.
+func (n Notification) IsSnoozed(now time.Time) bool {
+	return n.SnoozedUntil.After(now)
+}
+
+// notificationVerbs describes notification types, e.g. "Ada mentioned you in ABC-1".
+var notificationVerbs = map[string]string{
+	"issueAssignedToYou":     "assigned you to",
+	"issueUnassignedFromYou": "unassigned you from",
+	"issueMention":           "mentioned you in",
+	"issueCommentMention":    "mentioned you in a comment on",
+	"issueNewComment":        "commented on",
+	"issueCommentReaction":   "reacted to your comment on",
+	"issueEmojiReaction":     "reacted to",
+	"issueStatusChanged":     "changed the status of",
+	"issueCreated":           "created",
+	"issueSubscribed":        "subscribed you to",
+	"issuePriorityUrgent":    "marked as urgent",
+	"issueDue":               "has a due date coming up:",
+	"issueBlocking":          "unblocked",
+}
+
+// Describe summarises the notification, e.g. `Ada mentioned you in ABC-1: Fix login`.
+func (n Notification) Describe() string {
+	actor := n.Actor
+	if actor == "" {
+		actor = "Linear"
+	}
+	verb, ok := notificationVerbs[n.Type]
+	if !ok {
+		verb = "updated"
+	}
+	if n.IssueIdentifier == "" {
+		return fmt.Sprintf("%s %s a notification (%s)", actor, verb, n.Type)
+	}
+	return fmt.Sprintf("%s %s %s: %s", actor, verb, n.IssueIdentifier, n.IssueTitle)
+}
+
+// ListNotifications fetches the current user's inbox, newest first. Archived
+// notifications are not included.
+func (c *Client) ListNotifications(ctx context.Context) ([]Notification, error) {
+	type named struct {
+		Name graphql.String
+	}
+	var query struct {
+		Notifications struct {
+			Nodes []struct {
+				ID                graphql.String
+				Type              graphql.String
+				CreatedAt         graphql.String
+				ReadAt            *graphql.String
+				SnoozedUntilAt    *graphql.String
+				Actor             *named
+				IssueNotification struct {
+					Issue *struct {
+						ID         graphql.String
+						Identifier graphql.String
+						Title      graphql.String
+					}
+					Comment *struct {
+						Body graphql.String
+					}
+				} `graphql:"... on IssueNotification"`
+			}
+		} `graphql:"notifications(first: 100)"`
+	}
+
+	err := c.client.Query(ctx, &query, nil)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.notifications: ListNotifications failed")
+		return nil, fmt.Errorf("list notifications: %w", err)
+	}
+
+	optionalTime := func(s *graphql.String) time.Time {
+		if s == nil {
+			return time.Time{}
+		}
+		return parseTime(string(*s))
+	}
+
+	notifications := make([]Notification, 0, len(query.Notifications.Nodes))
+	for _, node := range query.Notifications.Nodes {
+		notification := Notification{
+			ID:           string(node.ID),
+			Type:         string(node.Type),
+			CreatedAt:    parseTime(string(node.CreatedAt)),
+			ReadAt:       optionalTime(node.ReadAt),
+			SnoozedUntil: optionalTime(node.SnoozedUntilAt),
+		}
+		if node.Actor != nil {
+			notification.Actor = string(node.Actor.Name)
+		}
+		if issue := node.IssueNotification.Issue; issue != nil {
+			notification.IssueID = string(issue.ID)
+			notification.IssueIdentifier = string(issue.Identifier)
+			notification.IssueTitle = string(issue.Title)
+		}
+		if comment := node.IssueNotification.Comment; comment != nil {
+			notification.CommentBody = string(comment.Body)
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications, nil
+}
+
+// MarkNotificationRead marks a notification as read, or as unread when read is false.
+func (c *Client) MarkNotificationRead(ctx context.Context, notificationID string, read bool) error {
+	input := NotificationUpdateInput{"readAt": nil}
+	if read {
+		input["readAt"] = time.Now().UTC().Format(time.RFC3339)
+	}
+	return c.updateNotification(ctx, notificationID, input)
+}
+
+// SnoozeNotification hides a notification from the inbox until the given time.
+func (c *Client) SnoozeNotification(ctx context.Context, notificationID string, until time.Time) error {
+	return c.updateNotification(ctx, notificationID, NotificationUpdateInput{
+		"snoozedUntilAt": until.UTC().Format(time.RFC3339),
+	})
+}
+
+// updateNotification runs the notificationUpdate mutation.
+func (c *Client) updateNotification(ctx context.Context, notificationID string, input NotificationUpdateInput) error {
+	var mutation struct {
+		NotificationUpdate struct {
+			Success graphql.Boolean
+		} `graphql:"notificationUpdate(id: $id, input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"id":    graphql.String(notificationID),
+		"input": input,
+	}
+
+	err := c.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.notifications: updateNotification failed notification_id=%s", notificationID)
+		return fmt.Errorf("update notification %s: %w", notificationID, err)
+	}
+	if !bool(mutation.NotificationUpdate.Success) {
+		logger.Error("linearapi.notifications: updateNotification operation failed success=false notification_id=%s", notificationID)
+		return fmt.Errorf("update notification %s: operation failed", notificationID)
+	}
+	return nil
+}
+
+// ArchiveNotification removes a notification from the inbox.
+func (c *Client) ArchiveNotification(ctx context.Context, notificationID string) error {
+	var mutation struct {
+		NotificationArchive struct {
+			Success graphql.Boolean
+		} `graphql:"notificationArchive(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id": graphql.String(notificationID),
+	}
+
+	err := c.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.notifications: ArchiveNotification failed notification_id=%s", notificationID)
+		return fmt.Errorf("archive notification %s: %w", notificationID, err)
+	}
+	if !bool(mutation.NotificationArchive.Success) {
+		logger.Error("linearapi.notifications: ArchiveNotification operation failed success=false notification_id=%s", notificationID)
+		return fmt.Errorf("archive notification %s: operation failed", notificationID)
+	}
+	return nil
+}
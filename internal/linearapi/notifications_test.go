@@ -0,0 +1,90 @@
+package linearapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestListNotifications_ParsesIssueNotifications verifies read, snooze, actor and issue fields are parsed.
+func TestListNotifications_ParsesIssueNotifications(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"notifications": {"nodes": [
+		{"id": "n-1", "type": "issueMention", "createdAt": "2026-10-01T10:00:00Z", "readAt": null, "snoozedUntilAt": null,
+		 "actor": {"name": "Ada"}, "issue": {"id": "issue-1", "identifier": "ABC-1", "title": "Fix login"},
+		 "comment": {"body": "@you can you look?"}},
+		{"id": "n-2", "type": "projectUpdateCreated", "createdAt": "2026-10-01T09:00:00Z",
+		 "readAt": "2026-10-01T09:30:00Z", "snoozedUntilAt": "2026-10-02T09:00:00Z", "actor": null}
+	]}}}`, &request)
+
+	notifications, err := client.ListNotifications(context.Background())
+	if err != nil {
+		t.Fatalf("ListNotifications() error = %v", err)
+	}
+	if len(notifications) != 2 {
+		t.Fatalf("ListNotifications() returned %d notifications, want 2", len(notifications))
+	}
+	mention := notifications[0]
+	if mention.IsRead() || mention.IssueIdentifier != "ABC-1" || mention.CommentBody != "@you can you look?" {
+		t.Fatalf("mention = %+v", mention)
+	}
+	if got := mention.Describe(); got != "Ada mentioned you in ABC-1: Fix login" {
+		t.Fatalf("Describe() = %q", got)
+	}
+	other := notifications[1]
+	if !other.IsRead() || !other.IsSnoozed(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)) || other.IssueID != "" {
+		t.Fatalf("other = %+v", other)
+	}
+	if got := other.Describe(); got != "Linear updated a notification (projectUpdateCreated)" {
+		t.Fatalf("Describe() = %q", got)
+	}
+	if query, _ := request["query"].(string); !strings.Contains(query, "... on IssueNotification") {
+		t.Fatalf("query = %q", query)
+	}
+}
+
+// TestMarkNotificationRead_SendsReadAt verifies marking unread clears readAt.
+func TestMarkNotificationRead_SendsReadAt(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"notificationUpdate": {"success": true}}}`, &request)
+
+	if err := client.MarkNotificationRead(context.Background(), "n-1", true); err != nil {
+		t.Fatalf("MarkNotificationRead() error = %v", err)
+	}
+	variables := request["variables"].(map[string]interface{})
+	if readAt, _ := variables["input"].(map[string]interface{})["readAt"].(string); variables["id"] != "n-1" || readAt == "" {
+		t.Fatalf("variables = %+v, want readAt set", variables)
+	}
+
+	if err := client.MarkNotificationRead(context.Background(), "n-1", false); err != nil {
+		t.Fatalf("MarkNotificationRead() error = %v", err)
+	}
+	input := request["variables"].(map[string]interface{})["input"].(map[string]interface{})
+	if readAt, ok := input["readAt"]; !ok || readAt != nil {
+		t.Fatalf("input = %+v, want readAt null", input)
+	}
+}
+
+// TestSnoozeNotification_SendsSnoozedUntil verifies the snooze end is sent in UTC.
+func TestSnoozeNotification_SendsSnoozedUntil(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"notificationUpdate": {"success": true}}}`, &request)
+
+	until := time.Date(2026, 10, 2, 9, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	if err := client.SnoozeNotification(context.Background(), "n-1", until); err != nil {
+		t.Fatalf("SnoozeNotification() error = %v", err)
+	}
+	input := request["variables"].(map[string]interface{})["input"].(map[string]interface{})
+	if input["snoozedUntilAt"] != "2026-10-02T07:00:00Z" {
+		t.Fatalf("input = %+v", input)
+	}
+}
+
+// TestArchiveNotification_ReportsFailure verifies success=false is an error.
+func TestArchiveNotification_ReportsFailure(t *testing.T) {
+	client := graphQLTestServer(t, `{"data": {"notificationArchive": {"success": false}}}`, nil)
+	if err := client.ArchiveNotification(context.Background(), "n-1"); err == nil {
+		t.Fatal("ArchiveNotification() expected error on success=false")
+	}
+}
@@ -0,0 +1,547 @@
+package linearapi
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Issue filter queries combine field terms, free text and boolean operators, e.g.
+//
+//	assignee:me state:started label:bug,crash priority:>=2 project:"Q3 Launch" updated:<7d -label:wontfix
+//	(label:bug OR label:crash) NOT state:done login
+//
+// Terms are ANDed unless joined by OR; NOT or a leading "-" negates a term or group.
+// Free text matches title or description.
+
+// queryFields lists the supported query field keys.
+var queryFields = map[string]bool{
+	"assignee": true,
+	"state":    true,
+	"label":    true,
+	"priority": true,
+	"project":  true,
+	"team":     true,
+	"updated":  true,
+	"created":  true,
+}
+
+// queryFieldKey matches the "key:" prefix of a field term.
+var queryFieldKey = regexp.MustCompile(`^([a-zA-Z]+):`)
+
+// queryRelativeDate matches relative durations such as 7d, 12h or 2w.
+var queryRelativeDate = regexp.MustCompile(`^(\d+)([hdw])$`)
+
+// queryTokenKind identifies a query token.
+type queryTokenKind int
+
+const (
+	queryTokenTerm queryTokenKind = iota
+	queryTokenAnd
+	queryTokenOr
+	queryTokenNot
+	queryTokenOpen
+	queryTokenClose
+)
+
+// queryToken is a lexed query token. Quoted terms are always free text.
+type queryToken struct {
+	kind   queryTokenKind
+	text   string
+	quoted bool
+}
+
+// queryNode is a parsed query expression.
+type queryNode struct {
+	op       string // "and", "or", "not" or "" for a term
+	children []*queryNode
+	key      string // Field key, or "" for free text
+	value    string
+}
+
+// ParseIssueQuery parses a search query into an issue filter. It returns a nil
+// filter when the query is plain free text, which callers should pass on as a
+// full-text search instead. Relative dates are resolved against now.
+func ParseIssueQuery(query string, now time.Time) (IssueFilter, error) {
+	tokens, err := lexIssueQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 || isPlainTextQuery(tokens) {
+		return nil, nil
+	}
+
+	p := &queryParser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q", p.tokens[p.pos].display())
+	}
+
+	filter, err := compileQueryNode(node, false, now)
+	if err != nil {
+		return nil, err
+	}
+	return IssueFilter(filter), nil
+}
+
+// isPlainTextQuery reports whether tokens are only unquoted words without field keys.
+func isPlainTextQuery(tokens []queryToken) bool {
+	for _, token := range tokens {
+		if token.kind != queryTokenTerm || token.quoted || queryFieldKey.MatchString(token.text) {
+			return false
+		}
+	}
+	return true
+}
+
+// display returns the token as it appeared in the query, for error messages.
+func (t queryToken) display() string {
+	switch t.kind {
+	case queryTokenAnd:
+		return "AND"
+	case queryTokenOr:
+		return "OR"
+	case queryTokenNot:
+		return "NOT"
+	case queryTokenOpen:
+		return "("
+	case queryTokenClose:
+		return ")"
+	}
+	return t.text
+}
+
+// lexIssueQuery splits a query into tokens. Quotes group words, both as free
+// text ("login page") and as field values (project:"Q3 Launch").
+func lexIssueQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	i := 0
+	for i < len(query) {
+		switch c := query[i]; {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(':
+			tokens = append(tokens, queryToken{kind: queryTokenOpen})
+			i++
+		case c == ')':
+			tokens = append(tokens, queryToken{kind: queryTokenClose})
+			i++
+		case c == '-' && i+1 < len(query) && query[i+1] != ' ':
+			tokens = append(tokens, queryToken{kind: queryTokenNot})
+			i++
+		default:
+			var b strings.Builder
+			quoted := c == '"'
+			for i < len(query) && !strings.ContainsRune(" \t\n()", rune(query[i])) {
+				if query[i] == '"' {
+					end := strings.IndexByte(query[i+1:], '"')
+					if end < 0 {
+						return nil, fmt.Errorf("unterminated quote")
+					}
+					b.WriteString(query[i+1 : i+1+end])
+					i += end + 2
+					continue
+				}
+				b.WriteByte(query[i])
+				i++
+			}
+			text := b.String()
+			switch {
+			case !quoted && text == "AND":
+				tokens = append(tokens, queryToken{kind: queryTokenAnd})
+			case !quoted && text == "OR":
+				tokens = append(tokens, queryToken{kind: queryTokenOr})
+			case !quoted && text == "NOT":
+				tokens = append(tokens, queryToken{kind: queryTokenNot})
+			default:
+				tokens = append(tokens, queryToken{kind: queryTokenTerm, text: text, quoted: quoted})
+			}
+		}
+	}
+	return tokens, nil
+}
+
+// queryParser is a recursive-descent parser over query tokens.
+// Precedence from loosest: OR, AND (explicit or implicit), NOT.
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+// peek returns the next token, if any.
+func (p *queryParser) peek() (queryToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return queryToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// parseOr parses terms joined by OR.
+func (p *queryParser) parseOr() (*queryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	children := []*queryNode{left}
+	for {
+		token, ok := p.peek()
+		if !ok || token.kind != queryTokenOr {
+			break
+		}
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, right)
+	}
+	if len(children) == 1 {
+		return left, nil
+	}
+	return &queryNode{op: "or", children: children}, nil
+}
+
+// parseAnd parses terms joined by AND or juxtaposition.
+func (p *queryParser) parseAnd() (*queryNode, error) {
+	var children []*queryNode
+	for {
+		token, ok := p.peek()
+		if !ok || token.kind == queryTokenOr || token.kind == queryTokenClose {
+			break
+		}
+		if token.kind == queryTokenAnd {
+			if len(children) == 0 {
+				return nil, fmt.Errorf("expected a term before AND")
+			}
+			p.pos++
+			continue
+		}
+		child, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	switch len(children) {
+	case 0:
+		if token, ok := p.peek(); ok {
+			return nil, fmt.Errorf("expected a term before %s", token.display())
+		}
+		return nil, fmt.Errorf("expected a term at end of query")
+	case 1:
+		return children[0], nil
+	}
+	return &queryNode{op: "and", children: children}, nil
+}
+
+// parseNot parses an optionally negated term or group.
+func (p *queryParser) parseNot() (*queryNode, error) {
+	token, _ := p.peek()
+	switch token.kind {
+	case queryTokenNot:
+		p.pos++
+		if _, ok := p.peek(); !ok {
+			return nil, fmt.Errorf("expected a term after NOT")
+		}
+		child, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &queryNode{op: "not", children: []*queryNode{child}}, nil
+	case queryTokenOpen:
+		p.pos++
+		child, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing, ok := p.peek(); !ok || closing.kind != queryTokenClose {
+			return nil, fmt.Errorf("missing \")\"")
+		}
+		p.pos++
+		return child, nil
+	case queryTokenClose:
+		return nil, fmt.Errorf("unexpected \")\"")
+	case queryTokenAnd, queryTokenOr:
+		return nil, fmt.Errorf("expected a term before %s", token.display())
+	}
+	p.pos++
+	return parseQueryTerm(token)
+}
+
+// parseQueryTerm turns a term token into a field or free-text node.
+func parseQueryTerm(token queryToken) (*queryNode, error) {
+	if token.quoted {
+		return &queryNode{value: token.text}, nil
+	}
+	match := queryFieldKey.FindStringSubmatch(token.text)
+	if match == nil {
+		return &queryNode{value: token.text}, nil
+	}
+	key := strings.ToLower(match[1])
+	if !queryFields[key] {
+		return nil, fmt.Errorf("unknown filter %q (quote it to search for the text)", match[1]+":")
+	}
+	value := strings.TrimSpace(token.text[len(match[0]):])
+	if value == "" {
+		return nil, fmt.Errorf("missing value for %q", key+":")
+	}
+	return &queryNode{key: key, value: value}, nil
+}
+
+// compileQueryNode compiles a node into a GraphQL filter. Linear filters have no
+// NOT, so negation is pushed down to the terms, which use inverse comparators.
+func compileQueryNode(node *queryNode, negated bool, now time.Time) (map[string]interface{}, error) {
+	switch node.op {
+	case "not":
+		return compileQueryNode(node.children[0], !negated, now)
+	case "and", "or":
+		op := node.op
+		if negated {
+			// De Morgan: NOT (a AND b) = NOT a OR NOT b
+			op = map[string]string{"and": "or", "or": "and"}[op]
+		}
+		children := make([]map[string]interface{}, 0, len(node.children))
+		for _, child := range node.children {
+			compiled, err := compileQueryNode(child, negated, now)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, compiled)
+		}
+		return map[string]interface{}{op: children}, nil
+	}
+
+	if node.key == "" {
+		return compileTextTerm(node.value, negated), nil
+	}
+
+	// Comma-separated values match any of them
+	values := strings.Split(node.value, ",")
+	if len(values) > 1 && node.key != "priority" && node.key != "updated" && node.key != "created" {
+		children := make([]*queryNode, 0, len(values))
+		for _, value := range values {
+			if value = strings.TrimSpace(value); value != "" {
+				children = append(children, &queryNode{key: node.key, value: value})
+			}
+		}
+		return compileQueryNode(&queryNode{op: "or", children: children}, negated, now)
+	}
+
+	switch node.key {
+	case "assignee":
+		return compileAssigneeTerm(node.value, negated), nil
+	case "state":
+		return compileStateTerm(node.value, negated), nil
+	case "label":
+		return compileLabelTerm(node.value, negated), nil
+	case "priority":
+		return compilePriorityTerm(node.value, negated)
+	case "project":
+		return compileProjectTerm(node.value, negated), nil
+	case "team":
+		return map[string]interface{}{"team": map[string]interface{}{"key": stringComparator("eqIgnoreCase", node.value, negated)}}, nil
+	case "updated":
+		return compileDateTerm("updatedAt", node.value, negated, now)
+	case "created":
+		return compileDateTerm("createdAt", node.value, negated, now)
+	}
+	return nil, fmt.Errorf("unknown filter %q", node.key+":")
+}
+
+// stringComparator returns a comparator, inverted when negated.
+func stringComparator(op, value string, negated bool) map[string]interface{} {
+	if negated {
+		op = map[string]string{
+			"eqIgnoreCase":       "neqIgnoreCase",
+			"containsIgnoreCase": "notContainsIgnoreCase",
+			"eq":                 "neq",
+		}[op]
+	}
+	return map[string]interface{}{op: value}
+}
+
+// isNoneValue reports whether a value asks for an unset field.
+func isNoneValue(value string) bool {
+	switch strings.ToLower(value) {
+	case "none", "unassigned", "null":
+		return true
+	}
+	return false
+}
+
+// nullableRelation filters an optional relation. A negated match also includes issues without it.
+func nullableRelation(field, value string, negated bool, match func(negated bool) map[string]interface{}) map[string]interface{} {
+	if isNoneValue(value) {
+		return map[string]interface{}{field: map[string]interface{}{"null": !negated}}
+	}
+	if !negated {
+		return map[string]interface{}{field: match(false)}
+	}
+	return map[string]interface{}{"or": []map[string]interface{}{
+		{field: map[string]interface{}{"null": true}},
+		{field: match(true)},
+	}}
+}
+
+// compileTextTerm matches free text against title or description.
+func compileTextTerm(text string, negated bool) map[string]interface{} {
+	title := map[string]interface{}{"title": stringComparator("containsIgnoreCase", text, negated)}
+	description := map[string]interface{}{"description": stringComparator("containsIgnoreCase", text, negated)}
+	if !negated {
+		return map[string]interface{}{"or": []map[string]interface{}{title, description}}
+	}
+	return map[string]interface{}{"and": []map[string]interface{}{
+		title,
+		{"or": []map[string]interface{}{{"description": map[string]interface{}{"null": true}}, description}},
+	}}
+}
+
+// compileAssigneeTerm matches "me", "none", or a user's name, display name or email.
+func compileAssigneeTerm(value string, negated bool) map[string]interface{} {
+	return nullableRelation("assignee", value, negated, func(negated bool) map[string]interface{} {
+		if strings.EqualFold(value, "me") {
+			return map[string]interface{}{"isMe": map[string]interface{}{"eq": !negated}}
+		}
+		op := "or"
+		if negated {
+			op = "and"
+		}
+		return map[string]interface{}{op: []map[string]interface{}{
+			{"name": stringComparator("eqIgnoreCase", value, negated)},
+			{"displayName": stringComparator("eqIgnoreCase", value, negated)},
+			{"email": stringComparator("eqIgnoreCase", value, negated)},
+		}}
+	})
+}
+
+// compileStateTerm matches a workflow state by name or type (e.g. "started").
+func compileStateTerm(value string, negated bool) map[string]interface{} {
+	op := "or"
+	if negated {
+		op = "and"
+	}
+	return map[string]interface{}{"state": map[string]interface{}{op: []map[string]interface{}{
+		{"name": stringComparator("eqIgnoreCase", value, negated)},
+		{"type": stringComparator("eq", strings.ToLower(value), negated)},
+	}}}
+}
+
+// compileLabelTerm matches issues with (or, negated, without) a label.
+func compileLabelTerm(value string, negated bool) map[string]interface{} {
+	if negated {
+		return map[string]interface{}{"labels": map[string]interface{}{"every": map[string]interface{}{"name": stringComparator("eqIgnoreCase", value, true)}}}
+	}
+	return map[string]interface{}{"labels": map[string]interface{}{"some": map[string]interface{}{"name": stringComparator("eqIgnoreCase", value, false)}}}
+}
+
+// compileProjectTerm matches a project by name, or "none" for issues without one.
+func compileProjectTerm(value string, negated bool) map[string]interface{} {
+	return nullableRelation("project", value, negated, func(negated bool) map[string]interface{} {
+		return map[string]interface{}{"name": stringComparator("eqIgnoreCase", value, negated)}
+	})
+}
+
+// splitComparison splits a leading comparison operator from a value.
+func splitComparison(value string) (string, string) {
+	for _, op := range []string{">=", "<=", "!=", ">", "<", "="} {
+		if strings.HasPrefix(value, op) {
+			return op, strings.TrimSpace(value[len(op):])
+		}
+	}
+	return "=", value
+}
+
+// comparatorOps maps comparison operators to GraphQL comparators and their negations.
+var comparatorOps = map[string][2]string{
+	"=":  {"eq", "neq"},
+	"!=": {"neq", "eq"},
+	">":  {"gt", "lte"},
+	">=": {"gte", "lt"},
+	"<":  {"lt", "gte"},
+	"<=": {"lte", "gt"},
+}
+
+// priorityValues maps priority names to Linear's priority numbers.
+var priorityValues = map[string]int{
+	"none":   0,
+	"urgent": 1,
+	"high":   2,
+	"normal": 3,
+	"medium": 3,
+	"low":    4,
+}
+
+// compilePriorityTerm compares the priority number (1 urgent ... 4 low, 0 none).
+func compilePriorityTerm(value string, negated bool) (map[string]interface{}, error) {
+	op, raw := splitComparison(value)
+	priority, ok := priorityValues[strings.ToLower(raw)]
+	if !ok {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 || n > 4 {
+			return nil, fmt.Errorf("invalid priority %q (use 0-4 or none, urgent, high, normal, low)", raw)
+		}
+		priority = n
+	}
+	comparator := comparatorOps[op][0]
+	if negated {
+		comparator = comparatorOps[op][1]
+	}
+	return map[string]interface{}{"priority": map[string]interface{}{comparator: priority}}, nil
+}
+
+// compileDateTerm compares a timestamp against a date (2024-05-01) or an age (7d, 12h, 2w).
+// Ages read as "updated less than 7 days ago" for updated:<7d.
+func compileDateTerm(field, value string, negated bool, now time.Time) (map[string]interface{}, error) {
+	op, raw := splitComparison(value)
+
+	if match := queryRelativeDate.FindStringSubmatch(raw); match != nil {
+		n, _ := strconv.Atoi(match[1])
+		unit := map[string]time.Duration{"h": time.Hour, "d": 24 * time.Hour, "w": 7 * 24 * time.Hour}[match[2]]
+		cutoff := now.Add(-time.Duration(n) * unit).UTC().Format(time.RFC3339)
+		// A smaller age is a later timestamp, so the comparison flips
+		op = map[string]string{"=": ">=", "!=": "<", ">": "<", ">=": "<=", "<": ">", "<=": ">="}[op]
+		comparator := comparatorOps[op][0]
+		if negated {
+			comparator = comparatorOps[op][1]
+		}
+		return map[string]interface{}{field: map[string]interface{}{comparator: cutoff}}, nil
+	}
+
+	day, err := time.ParseInLocation("2006-01-02", raw, now.Location())
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q (use 2006-01-02 or an age like 7d, 12h, 2w)", raw)
+	}
+	start := day.UTC().Format(time.RFC3339)
+	end := day.AddDate(0, 0, 1).UTC().Format(time.RFC3339)
+	var conditions []map[string]interface{}
+	switch op {
+	case "=", "!=":
+		// Match the whole day
+		if (op == "=") != negated {
+			conditions = []map[string]interface{}{{field: map[string]interface{}{"gte": start}}, {field: map[string]interface{}{"lt": end}}}
+			return map[string]interface{}{"and": conditions}, nil
+		}
+		conditions = []map[string]interface{}{{field: map[string]interface{}{"lt": start}}, {field: map[string]interface{}{"gte": end}}}
+		return map[string]interface{}{"or": conditions}, nil
+	case ">", "<=":
+		// After the day, or up to its end
+		bound := end
+		comparator := map[string]string{">": "gte", "<=": "lt"}[op]
+		if negated {
+			comparator = map[string]string{"gte": "lt", "lt": "gte"}[comparator]
+		}
+		return map[string]interface{}{field: map[string]interface{}{comparator: bound}}, nil
+	default:
+		// ">=" and "<": from the day's start, or before it
+		comparator := map[string]string{">=": "gte", "<": "lt"}[op]
+		if negated {
+			comparator = map[string]string{"gte": "lt", "lt": "gte"}[comparator]
+		}
+		return map[string]interface{}{field: map[string]interface{}{comparator: start}}, nil
+	}
+}
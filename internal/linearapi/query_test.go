@@ -0,0 +1,114 @@
+package linearapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// queryTestNow is the reference time for relative dates in query tests.
+var queryTestNow = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+// compactFilterJSON marshals a filter for comparison against expected JSON.
+func compactFilterJSON(t *testing.T, filter IssueFilter) string {
+	t.Helper()
+	data, err := json.Marshal(map[string]interface{}(filter))
+	if err != nil {
+		t.Fatalf("marshal filter: %v", err)
+	}
+	return string(data)
+}
+
+// TestParseIssueQuery verifies field terms, negation, grouping and free text compile to Linear filters.
+func TestParseIssueQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "assignee me and state",
+			query: "assignee:me state:started",
+			want:  `{"and":[{"assignee":{"isMe":{"eq":true}}},{"state":{"or":[{"name":{"eqIgnoreCase":"started"}},{"type":{"eq":"started"}}]}}]}`,
+		},
+		{
+			name:  "quoted project and priority comparison",
+			query: `project:"Q3 Launch" priority:>=2`,
+			want:  `{"and":[{"project":{"name":{"eqIgnoreCase":"Q3 Launch"}}},{"priority":{"gte":2}}]}`,
+		},
+		{
+			name:  "negated label",
+			query: "-label:wontfix",
+			want:  `{"labels":{"every":{"name":{"neqIgnoreCase":"wontfix"}}}}`,
+		},
+		{
+			name:  "updated within 7 days",
+			query: "updated:<7d",
+			want:  `{"updatedAt":{"gt":"2024-05-03T12:00:00Z"}}`,
+		},
+		{
+			name:  "created on a day",
+			query: "created:2024-05-01",
+			want:  `{"and":[{"createdAt":{"gte":"2024-05-01T00:00:00Z"}},{"createdAt":{"lt":"2024-05-02T00:00:00Z"}}]}`,
+		},
+		{
+			name:  "or with comma list and free text",
+			query: "(label:bug,crash OR priority:urgent) login",
+			want:  `{"and":[{"or":[{"or":[{"labels":{"some":{"name":{"eqIgnoreCase":"bug"}}}},{"labels":{"some":{"name":{"eqIgnoreCase":"crash"}}}}]},{"priority":{"eq":1}}]},{"or":[{"title":{"containsIgnoreCase":"login"}},{"description":{"containsIgnoreCase":"login"}}]}]}`,
+		},
+		{
+			name:  "not group uses de morgan",
+			query: "NOT (assignee:none OR team:ENG)",
+			want:  `{"and":[{"assignee":{"null":false}},{"team":{"key":{"neqIgnoreCase":"ENG"}}}]}`,
+		},
+		{
+			name:  "negated assignee includes unassigned",
+			query: "-assignee:me",
+			want:  `{"or":[{"assignee":{"null":true}},{"assignee":{"isMe":{"eq":false}}}]}`,
+		},
+		{
+			name:  "quoted phrase is free text",
+			query: `"login page"`,
+			want:  `{"or":[{"title":{"containsIgnoreCase":"login page"}},{"description":{"containsIgnoreCase":"login page"}}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := ParseIssueQuery(tt.query, queryTestNow)
+			if err != nil {
+				t.Fatalf("ParseIssueQuery(%q) error: %v", tt.query, err)
+			}
+			if got := compactFilterJSON(t, filter); got != tt.want {
+				t.Errorf("ParseIssueQuery(%q) =\n%s\nwant\n%s", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseIssueQuery_PlainTextAndErrors verifies plain words fall back to search and bad syntax is reported.
+func TestParseIssueQuery_PlainTextAndErrors(t *testing.T) {
+	for _, query := range []string{"", "login bug", "ENG-123"} {
+		if filter, err := ParseIssueQuery(query, queryTestNow); err != nil || filter != nil {
+			t.Errorf("ParseIssueQuery(%q) = %v, %v; want nil filter", query, filter, err)
+		}
+	}
+
+	errorTests := map[string]string{
+		`project:"Q3`:        "unterminated quote",
+		"(label:bug":         `missing ")"`,
+		"label:bug)":         `unexpected ")"`,
+		"label:bug OR":       "expected a term",
+		"foo:bar":            `unknown filter "foo:"`,
+		"label:":             `missing value for "label:"`,
+		"priority:>=huge":    "invalid priority",
+		"updated:<yesterday": "invalid date",
+	}
+	for query, want := range errorTests {
+		_, err := ParseIssueQuery(query, queryTestNow)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ParseIssueQuery(%q) error = %v, want %q", query, err, want)
+		}
+	}
+}
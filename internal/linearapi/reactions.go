@@ -0,0 +1,203 @@
+package linearapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/shurcooL/graphql"
+)
+
+// ReactionCreateInput is a custom scalar type for Linear's ReactionCreateInput.
+// The Go type name must match the GraphQL type name exactly.
+type ReactionCreateInput map[string]interface{}
+
+// GetGraphQLType returns the GraphQL type name for the input.
+func (ReactionCreateInput) GetGraphQLType() string {
+	return "ReactionCreateInput"
+}
+
+// MarshalJSON implements json.Marshaler for ReactionCreateInput.
+func (r ReactionCreateInput) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}(r))
+}
+
+// Reaction is one user's emoji reaction on an issue or comment.
+type Reaction struct {
+	ID       string
+	Emoji    string // Emoji name as stored by Linear, e.g. "+1" or "heart"
+	UserID   string
+	UserName string
+	IsMine   bool
+}
+
+// ReactionSummary aggregates the reactions with the same emoji.
+type ReactionSummary struct {
+	Emoji        string
+	Count        int
+	Users        []string
+	MyReactionID string // ID of my reaction with this emoji, empty when I have not reacted
+}
+
+// ReactionEmoji is an emoji offered by the reaction picker.
+type ReactionEmoji struct {
+	Name  string // Linear emoji name
+	Glyph string
+}
+
+// DefaultReactions are the emoji offered by the reaction picker, in picker order.
+var DefaultReactions = []ReactionEmoji{
+	{Name: "+1", Glyph: "👍"},
+	{Name: "-1", Glyph: "👎"},
+	{Name: "heart", Glyph: "❤️"},
+	{Name: "tada", Glyph: "🎉"},
+	{Name: "joy", Glyph: "😂"},
+	{Name: "eyes", Glyph: "👀"},
+	{Name: "rocket", Glyph: "🚀"},
+	{Name: "white_check_mark", Glyph: "✅"},
+	{Name: "thinking_face", Glyph: "🤔"},
+	{Name: "fire", Glyph: "🔥"},
+}
+
+// reactionGlyphAliases maps other common Linear emoji names to glyphs.
+var reactionGlyphAliases = map[string]string{
+	"thumbsup":   "👍",
+	"thumbsdown": "👎",
+	"smile":      "😄",
+	"pray":       "🙏",
+	"clap":       "👏",
+	"100":        "💯",
+}
+
+// ReactionGlyph returns the glyph for a Linear emoji name, or ":name:" when unknown.
+// Names that are already emoji are returned unchanged.
+func ReactionGlyph(name string) string {
+	for _, emoji := range DefaultReactions {
+		if emoji.Name == name {
+			return emoji.Glyph
+		}
+	}
+	if glyph, ok := reactionGlyphAliases[name]; ok {
+		return glyph
+	}
+	if r, _ := utf8.DecodeRuneInString(name); r > 0x2000 {
+		return name
+	}
+	return ":" + name + ":"
+}
+
+// SummarizeReactions groups reactions by emoji in order of first appearance.
+func SummarizeReactions(reactions []Reaction) []ReactionSummary {
+	var summaries []ReactionSummary
+	index := make(map[string]int)
+	for _, reaction := range reactions {
+		i, ok := index[reaction.Emoji]
+		if !ok {
+			i = len(summaries)
+			index[reaction.Emoji] = i
+			summaries = append(summaries, ReactionSummary{Emoji: reaction.Emoji})
+		}
+		summaries[i].Count++
+		summaries[i].Users = append(summaries[i].Users, reaction.UserName)
+		if reaction.IsMine {
+			summaries[i].MyReactionID = reaction.ID
+		}
+	}
+	return summaries
+}
+
+// parseReactions reads the Reactions field of a GraphQL issue or comment node.
+// Nodes without that field have no reactions.
+func parseReactions(v reflect.Value) []Reaction {
+	field := v.FieldByName("Reactions")
+	if !field.IsValid() {
+		return nil
+	}
+	reactions := make([]Reaction, 0, field.Len())
+	for i := 0; i < field.Len(); i++ {
+		node := field.Index(i)
+		reaction := Reaction{
+			ID:    node.FieldByName("ID").String(),
+			Emoji: node.FieldByName("Emoji").String(),
+		}
+		// Reactions from integrations have no user
+		if user := node.FieldByName("User"); !user.IsNil() {
+			user = user.Elem()
+			reaction.UserID = user.FieldByName("ID").String()
+			reaction.UserName = user.FieldByName("Name").String()
+			reaction.IsMine = user.FieldByName("IsMe").Bool()
+		}
+		reactions = append(reactions, reaction)
+	}
+	return reactions
+}
+
+// AddIssueReaction reacts to an issue with an emoji.
+func (c *Client) AddIssueReaction(ctx context.Context, issueID, emoji string) (Reaction, error) {
+	return c.createReaction(ctx, ReactionCreateInput{"issueId": graphql.ID(issueID), "emoji": graphql.String(emoji)})
+}
+
+// AddCommentReaction reacts to a comment with an emoji.
+func (c *Client) AddCommentReaction(ctx context.Context, commentID, emoji string) (Reaction, error) {
+	return c.createReaction(ctx, ReactionCreateInput{"commentId": graphql.ID(commentID), "emoji": graphql.String(emoji)})
+}
+
+// createReaction runs the reactionCreate mutation.
+func (c *Client) createReaction(ctx context.Context, input ReactionCreateInput) (Reaction, error) {
+	var mutation struct {
+		ReactionCreate struct {
+			Success  graphql.Boolean
+			Reaction struct {
+				ID    graphql.String
+				Emoji graphql.String
+			}
+		} `graphql:"reactionCreate(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	err := c.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.reactions: createReaction failed emoji=%v", input["emoji"])
+		return Reaction{}, fmt.Errorf("add reaction: %w", err)
+	}
+	if !bool(mutation.ReactionCreate.Success) {
+		logger.Error("linearapi.reactions: createReaction operation failed success=false emoji=%v", input["emoji"])
+		return Reaction{}, fmt.Errorf("add reaction: operation failed")
+	}
+
+	return Reaction{
+		ID:     string(mutation.ReactionCreate.Reaction.ID),
+		Emoji:  string(mutation.ReactionCreate.Reaction.Emoji),
+		IsMine: true,
+	}, nil
+}
+
+// DeleteReaction removes a reaction by its ID.
+func (c *Client) DeleteReaction(ctx context.Context, reactionID string) error {
+	var mutation struct {
+		ReactionDelete struct {
+			Success graphql.Boolean
+		} `graphql:"reactionDelete(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id": graphql.String(reactionID),
+	}
+
+	err := c.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.reactions: DeleteReaction failed reaction_id=%s", reactionID)
+		return fmt.Errorf("remove reaction %s: %w", reactionID, err)
+	}
+	if !bool(mutation.ReactionDelete.Success) {
+		logger.Error("linearapi.reactions: DeleteReaction operation failed success=false reaction_id=%s", reactionID)
+		return fmt.Errorf("remove reaction %s: operation failed", reactionID)
+	}
+	return nil
+}
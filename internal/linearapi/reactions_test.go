@@ -0,0 +1,91 @@
+package linearapi
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/shurcooL/graphql"
+)
+
+// TestSummarizeReactions verifies grouping by emoji and tracking my reaction.
+func TestSummarizeReactions(t *testing.T) {
+	summaries := SummarizeReactions([]Reaction{
+		{ID: "r-1", Emoji: "+1", UserName: "Bob"},
+		{ID: "r-2", Emoji: "heart", UserName: "Ada", IsMine: true},
+		{ID: "r-3", Emoji: "+1", UserName: "Ada", IsMine: true},
+	})
+	if len(summaries) != 2 {
+		t.Fatalf("SummarizeReactions() = %+v", summaries)
+	}
+	if summaries[0].Emoji != "+1" || summaries[0].Count != 2 || summaries[0].MyReactionID != "r-3" {
+		t.Fatalf("+1 summary = %+v", summaries[0])
+	}
+	if summaries[1].Emoji != "heart" || summaries[1].Count != 1 || summaries[1].MyReactionID != "r-2" {
+		t.Fatalf("heart summary = %+v", summaries[1])
+	}
+}
+
+// TestReactionGlyph verifies known names, raw emoji and unknown names.
+func TestReactionGlyph(t *testing.T) {
+	for name, want := range map[string]string{"+1": "👍", "thumbsup": "👍", "🦄": "🦄", "party_parrot": ":party_parrot:"} {
+		if got := ReactionGlyph(name); got != want {
+			t.Fatalf("ReactionGlyph(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+// TestParseReactions verifies reactions with and without a user.
+func TestParseReactions(t *testing.T) {
+	type user struct {
+		ID   graphql.String
+		Name graphql.String
+		IsMe graphql.Boolean
+	}
+	type reactionNode struct {
+		ID    graphql.String
+		Emoji graphql.String
+		User  *user
+	}
+	node := struct{ Reactions []reactionNode }{Reactions: []reactionNode{
+		{ID: "r-1", Emoji: "eyes", User: &user{ID: "u-1", Name: "Ada", IsMe: true}},
+		{ID: "r-2", Emoji: "rocket"},
+	}}
+
+	reactions := parseReactions(reflect.ValueOf(node))
+	if len(reactions) != 2 || !reactions[0].IsMine || reactions[0].UserName != "Ada" || reactions[1].UserID != "" {
+		t.Fatalf("parseReactions() = %+v", reactions)
+	}
+	if parseReactions(reflect.ValueOf(struct{ ID string }{})) != nil {
+		t.Fatal("parseReactions() without a Reactions field should return nil")
+	}
+}
+
+// TestAddCommentReaction_SendsCommentID verifies the reaction target and emoji.
+func TestAddCommentReaction_SendsCommentID(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"reactionCreate": {"success": true, "reaction": {"id": "r-9", "emoji": "tada"}}}}`, &request)
+
+	reaction, err := client.AddCommentReaction(context.Background(), "c-1", "tada")
+	if err != nil {
+		t.Fatalf("AddCommentReaction() error = %v", err)
+	}
+	if reaction.ID != "r-9" || !reaction.IsMine {
+		t.Fatalf("AddCommentReaction() = %+v", reaction)
+	}
+	input := request["variables"].(map[string]interface{})["input"].(map[string]interface{})
+	if input["commentId"] != "c-1" || input["emoji"] != "tada" {
+		t.Fatalf("input = %+v", input)
+	}
+	if _, ok := input["issueId"]; ok {
+		t.Fatalf("input = %+v, want no issueId", input)
+	}
+}
+
+// TestDeleteReaction_ReportsFailure verifies success=false is an error.
+func TestDeleteReaction_ReportsFailure(t *testing.T) {
+	client := graphQLTestServer(t, `{"data": {"reactionDelete": {"success": false}}}`, nil)
+	if err := client.DeleteReaction(context.Background(), "r-1"); err == nil {
+		t.Fatal("DeleteReaction() expected error on success=false")
+	}
+}
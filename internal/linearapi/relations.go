@@ -0,0 +1,289 @@
+package linearapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/shurcooL/graphql"
+)
+
+// Relation types, seen from the issue that owns the relation list.
+// Linear stores "blocked by" and "duplicated by" as the inverse of blocks and duplicate.
+const (
+	RelationBlocks       = "blocks"
+	RelationBlockedBy    = "blocked_by"
+	RelationDuplicate    = "duplicate" // This issue is a duplicate of the related issue
+	RelationDuplicatedBy = "duplicated_by"
+	RelationRelated      = "related"
+)
+
+// IssueRelationCreateInput is a custom scalar type for Linear's IssueRelationCreateInput.
+// The Go type name must match the GraphQL type name exactly.
+type IssueRelationCreateInput map[string]interface{}
+
+// GetGraphQLType returns the GraphQL type name for the input.
+func (IssueRelationCreateInput) GetGraphQLType() string {
+	return "IssueRelationCreateInput"
+}
+
+// MarshalJSON implements json.Marshaler for IssueRelationCreateInput.
+func (i IssueRelationCreateInput) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}(i))
+}
+
+// IssueRelation links an issue to another issue.
+type IssueRelation struct {
+	ID        string // Relation ID, used to delete it
+	Type      string // One of the Relation* constants
+	Issue     IssueRef
+	State     string // Workflow state name of the related issue
+	StateType string // Workflow state type of the related issue (e.g. "started", "completed")
+}
+
+// RelationLabel returns the display label for a relation type, e.g. "Blocked by".
+func RelationLabel(relationType string) string {
+	switch relationType {
+	case RelationBlocks:
+		return "Blocks"
+	case RelationBlockedBy:
+		return "Blocked by"
+	case RelationDuplicate:
+		return "Duplicate of"
+	case RelationDuplicatedBy:
+		return "Duplicated by"
+	case RelationRelated:
+		return "Related to"
+	default:
+		return relationType
+	}
+}
+
+// IsResolved reports whether the related issue is completed or canceled.
+func (r IssueRelation) IsResolved() bool {
+	return r.StateType == "completed" || r.StateType == "canceled"
+}
+
+// BlockingIssues returns the unresolved issues blocking this issue.
+func (i Issue) BlockingIssues() []IssueRelation {
+	var blockers []IssueRelation
+	for _, rel := range i.Relations {
+		if rel.Type == RelationBlockedBy && !rel.IsResolved() {
+			blockers = append(blockers, rel)
+		}
+	}
+	return blockers
+}
+
+// IsBlocked reports whether any unresolved issue blocks this issue.
+func (i Issue) IsBlocked() bool {
+	return len(i.BlockingIssues()) > 0
+}
+
+// stringField returns the named string field of a struct value, or "" when the query
+// did not select it.
+func stringField(v reflect.Value, name string) string {
+	field := v.FieldByName(name)
+	if !field.IsValid() {
+		return ""
+	}
+	return field.String()
+}
+
+// blockingRelations returns the relations of issues blocking this one.
+func blockingRelations(relations []IssueRelation) []IssueRelation {
+	var blockers []IssueRelation
+	for _, rel := range relations {
+		if rel.Type == RelationBlockedBy {
+			blockers = append(blockers, rel)
+		}
+	}
+	return blockers
+}
+
+// parseIssueRelations reads the Relations and InverseRelations connections of a
+// GraphQL issue node. Nodes without those fields have no relations, and fields a
+// query leaves out stay empty.
+func parseIssueRelations(v reflect.Value) []IssueRelation {
+	var relations []IssueRelation
+	parse := func(field, issueField string, inverse bool) {
+		conn := v.FieldByName(field)
+		if !conn.IsValid() {
+			return
+		}
+		nodes := conn.FieldByName("Nodes")
+		for i := 0; i < nodes.Len(); i++ {
+			node := nodes.Index(i)
+			relType := relationTypeFromAPI(node.FieldByName("Type").String(), inverse)
+			if relType == "" {
+				continue
+			}
+			other := node.FieldByName(issueField)
+			relations = append(relations, IssueRelation{
+				ID:   node.FieldByName("ID").String(),
+				Type: relType,
+				Issue: IssueRef{
+					ID:         other.FieldByName("ID").String(),
+					Identifier: other.FieldByName("Identifier").String(),
+					Title:      stringField(other, "Title"),
+				},
+				State:     stringField(other.FieldByName("State"), "Name"),
+				StateType: other.FieldByName("State").FieldByName("Type").String(),
+			})
+		}
+	}
+	parse("Relations", "RelatedIssue", false)
+	parse("InverseRelations", "Issue", true)
+	return relations
+}
+
+// relationTypeFromAPI maps a Linear relation type to a Relation* constant.
+// Inverse relations point at this issue, so blocks and duplicate flip direction.
+// Unsupported types (such as "similar") return an empty string.
+func relationTypeFromAPI(apiType string, inverse bool) string {
+	switch apiType {
+	case "blocks":
+		if inverse {
+			return RelationBlockedBy
+		}
+		return RelationBlocks
+	case "duplicate":
+		if inverse {
+			return RelationDuplicatedBy
+		}
+		return RelationDuplicate
+	case "related":
+		return RelationRelated
+	default:
+		return ""
+	}
+}
+
+// relationAPIInput returns the Linear relation type and the issue that owns the
+// relation, swapping the issues for inverse types such as "blocked by".
+func relationAPIInput(issueID, relatedIssueID, relationType string) (apiType, fromID, toID string, err error) {
+	switch relationType {
+	case RelationBlocks:
+		return "blocks", issueID, relatedIssueID, nil
+	case RelationBlockedBy:
+		return "blocks", relatedIssueID, issueID, nil
+	case RelationDuplicate:
+		return "duplicate", issueID, relatedIssueID, nil
+	case RelationDuplicatedBy:
+		return "duplicate", relatedIssueID, issueID, nil
+	case RelationRelated:
+		return "related", issueID, relatedIssueID, nil
+	default:
+		return "", "", "", fmt.Errorf("unknown relation type %q", relationType)
+	}
+}
+
+// ListIssueRelations fetches all relations of an issue in both directions.
+func (c *Client) ListIssueRelations(ctx context.Context, issueID string) ([]IssueRelation, error) {
+	var query struct {
+		Issue struct {
+			Relations struct {
+				Nodes []struct {
+					ID           graphql.String
+					Type         graphql.String
+					RelatedIssue struct {
+						ID         graphql.String
+						Identifier graphql.String
+						Title      graphql.String
+						State      struct {
+							Name graphql.String
+							Type graphql.String
+						}
+					}
+				}
+			} `graphql:"relations(first: 100)"`
+			InverseRelations struct {
+				Nodes []struct {
+					ID    graphql.String
+					Type  graphql.String
+					Issue struct {
+						ID         graphql.String
+						Identifier graphql.String
+						Title      graphql.String
+						State      struct {
+							Name graphql.String
+							Type graphql.String
+						}
+					}
+				}
+			} `graphql:"inverseRelations(first: 100)"`
+		} `graphql:"issue(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id": graphql.String(issueID),
+	}
+
+	err := c.client.Query(ctx, &query, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.relations: ListIssueRelations failed issue_id=%s", issueID)
+		return nil, fmt.Errorf("list relations for issue %s: %w", issueID, err)
+	}
+
+	return parseIssueRelations(reflect.ValueOf(query.Issue)), nil
+}
+
+// CreateIssueRelation links issueID to relatedIssueID with a Relation* type.
+func (c *Client) CreateIssueRelation(ctx context.Context, issueID, relatedIssueID, relationType string) error {
+	apiType, fromID, toID, err := relationAPIInput(issueID, relatedIssueID, relationType)
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		IssueRelationCreate struct {
+			Success graphql.Boolean
+		} `graphql:"issueRelationCreate(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": IssueRelationCreateInput{
+			"issueId":        graphql.String(fromID),
+			"relatedIssueId": graphql.String(toID),
+			"type":           apiType,
+		},
+	}
+
+	err = c.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.relations: CreateIssueRelation failed issue_id=%s related_issue_id=%s type=%s",
+			issueID, relatedIssueID, relationType)
+		return fmt.Errorf("create relation: %w", err)
+	}
+	if !bool(mutation.IssueRelationCreate.Success) {
+		logger.Error("linearapi.relations: CreateIssueRelation operation failed success=false issue_id=%s", issueID)
+		return fmt.Errorf("create relation: operation failed")
+	}
+	return nil
+}
+
+// DeleteIssueRelation removes a relation by its ID.
+func (c *Client) DeleteIssueRelation(ctx context.Context, relationID string) error {
+	var mutation struct {
+		IssueRelationDelete struct {
+			Success graphql.Boolean
+		} `graphql:"issueRelationDelete(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id": graphql.String(relationID),
+	}
+
+	err := c.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.relations: DeleteIssueRelation failed relation_id=%s", relationID)
+		return fmt.Errorf("delete relation %s: %w", relationID, err)
+	}
+	if !bool(mutation.IssueRelationDelete.Success) {
+		logger.Error("linearapi.relations: DeleteIssueRelation operation failed success=false relation_id=%s", relationID)
+		return fmt.Errorf("delete relation %s: operation failed", relationID)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package linearapi
+
+import (
+	"context"
+	"testing"
+)
+
+// TestListIssueRelations_MapsDirections verifies inverse relations flip direction and unsupported types are skipped.
+func TestListIssueRelations_MapsDirections(t *testing.T) {
+	client := graphQLTestServer(t, `{"data": {"issue": {
+		"relations": {"nodes": [
+			{"id": "rel-1", "type": "blocks", "relatedIssue": {"id": "i-2", "identifier": "ABC-2", "title": "API", "state": {"name": "Todo", "type": "unstarted"}}},
+			{"id": "rel-2", "type": "similar", "relatedIssue": {"id": "i-3", "identifier": "ABC-3", "title": "Other", "state": {"name": "Todo", "type": "unstarted"}}}
+		]},
+		"inverseRelations": {"nodes": [
+			{"id": "rel-3", "type": "blocks", "issue": {"id": "i-4", "identifier": "ABC-4", "title": "Schema", "state": {"name": "Done", "type": "completed"}}},
+			{"id": "rel-4", "type": "blocks", "issue": {"id": "i-5", "identifier": "ABC-5", "title": "Auth", "state": {"name": "In Progress", "type": "started"}}},
+			{"id": "rel-5", "type": "duplicate", "issue": {"id": "i-6", "identifier": "ABC-6", "title": "Dup", "state": {"name": "Canceled", "type": "canceled"}}}
+		]}
+	}}}`, nil)
+
+	relations, err := client.ListIssueRelations(context.Background(), "i-1")
+	if err != nil {
+		t.Fatalf("ListIssueRelations() error = %v", err)
+	}
+
+	want := map[string]string{
+		"rel-1": RelationBlocks,
+		"rel-3": RelationBlockedBy,
+		"rel-4": RelationBlockedBy,
+		"rel-5": RelationDuplicatedBy,
+	}
+	if len(relations) != len(want) {
+		t.Fatalf("ListIssueRelations() = %+v, want %d relations", relations, len(want))
+	}
+	for _, rel := range relations {
+		if want[rel.ID] != rel.Type {
+			t.Errorf("relation %s type = %q, want %q", rel.ID, rel.Type, want[rel.ID])
+		}
+	}
+
+	issue := Issue{Relations: relations}
+	blockers := issue.BlockingIssues()
+	if !issue.IsBlocked() || len(blockers) != 1 || blockers[0].Issue.Identifier != "ABC-5" {
+		t.Fatalf("BlockingIssues() = %+v, want only the unresolved ABC-5", blockers)
+	}
+}
+
+// TestCreateIssueRelation_SwapsInverseTypes verifies "blocked by" is created as the blocker's "blocks" relation.
+func TestCreateIssueRelation_SwapsInverseTypes(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"issueRelationCreate": {"success": true}}}`, &request)
+
+	if err := client.CreateIssueRelation(context.Background(), "i-1", "i-2", RelationBlockedBy); err != nil {
+		t.Fatalf("CreateIssueRelation() error = %v", err)
+	}
+	input := request["variables"].(map[string]interface{})["input"].(map[string]interface{})
+	if input["issueId"] != "i-2" || input["relatedIssueId"] != "i-1" || input["type"] != "blocks" {
+		t.Fatalf("input = %+v, want i-2 blocks i-1", input)
+	}
+
+	if err := client.CreateIssueRelation(context.Background(), "i-1", "i-2", "parent"); err == nil {
+		t.Fatal("CreateIssueRelation() with an unknown type succeeded")
+	}
+}
+
+// TestDeleteIssueRelation_ReportsFailure verifies unsuccessful deletes return an error.
+func TestDeleteIssueRelation_ReportsFailure(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, `{"data": {"issueRelationDelete": {"success": false}}}`, &request)
+
+	if err := client.DeleteIssueRelation(context.Background(), "rel-1"); err == nil {
+		t.Fatal("DeleteIssueRelation() error = nil, want operation failed")
+	}
+	if request["variables"].(map[string]interface{})["id"] != "rel-1" {
+		t.Fatalf("variables = %+v", request["variables"])
+	}
+}
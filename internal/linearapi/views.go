@@ -0,0 +1,350 @@
+package linearapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/shurcooL/graphql"
+)
+
+// Favorite types returned by Linear that the TUI can open as an issue list.
+const (
+	FavoriteTypeCustomView = "customView"
+	FavoriteTypeProject    = "project"
+	FavoriteTypeIssue      = "issue"
+	FavoriteTypeLabel      = "label"
+	FavoriteTypeCycle      = "cycle"
+	FavoriteTypeFolder     = "folder"
+)
+
+// CustomView represents a view saved in Linear's web app.
+type CustomView struct {
+	ID          string
+	Name        string
+	Description string
+	TeamID      string // Empty for workspace views
+	Shared      bool
+}
+
+// Favorite represents an entry in the user's Linear sidebar favorites.
+// Exactly one of the target IDs is set, depending on Type; folders have Children instead.
+type Favorite struct {
+	ID           string
+	Type         string
+	Title        string // Display name of the favorited item or folder
+	CustomViewID string
+	ProjectID    string
+	IssueID      string
+	LabelID      string
+	CycleID      string
+	Children     []Favorite // Favorites inside a folder
+}
+
+// IssueFilter returns the issue filter for project, label, cycle and issue favorites.
+// Custom views are fetched through FetchIssuesParams.CustomViewID, so they return nil.
+func (f Favorite) IssueFilter() IssueFilter {
+	switch {
+	case f.ProjectID != "":
+		return IssueFilter{"project": map[string]interface{}{"id": map[string]interface{}{"eq": f.ProjectID}}}
+	case f.IssueID != "":
+		return IssueFilter{"id": map[string]interface{}{"eq": f.IssueID}}
+	case f.LabelID != "":
+		return IssueFilter{"labels": map[string]interface{}{"some": map[string]interface{}{"id": map[string]interface{}{"eq": f.LabelID}}}}
+	case f.CycleID != "":
+		return Cycle{ID: f.CycleID}.IssueFilter()
+	}
+	return nil
+}
+
+// ListCustomViews fetches the custom views visible to the user.
+func (c *Client) ListCustomViews(ctx context.Context) ([]CustomView, error) {
+	var query struct {
+		CustomViews struct {
+			Nodes []struct {
+				ID          graphql.String
+				Name        graphql.String
+				Description *graphql.String
+				Shared      graphql.Boolean
+				Team        *struct {
+					ID graphql.String
+				}
+			}
+		} `graphql:"customViews(first: 250)"`
+	}
+
+	err := c.client.Query(ctx, &query, nil)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.views: ListCustomViews failed")
+		return nil, fmt.Errorf("list custom views: %w", err)
+	}
+
+	views := make([]CustomView, 0, len(query.CustomViews.Nodes))
+	for _, node := range query.CustomViews.Nodes {
+		view := CustomView{
+			ID:     string(node.ID),
+			Name:   string(node.Name),
+			Shared: bool(node.Shared),
+		}
+		if node.Description != nil {
+			view.Description = string(*node.Description)
+		}
+		if node.Team != nil {
+			view.TeamID = string(node.Team.ID)
+		}
+		views = append(views, view)
+	}
+	sort.SliceStable(views, func(i, j int) bool {
+		return views[i].Name < views[j].Name
+	})
+
+	return views, nil
+}
+
+// ListFavorites fetches the user's favorites in sidebar order, nesting favorites under their folders.
+func (c *Client) ListFavorites(ctx context.Context) ([]Favorite, error) {
+	var query struct {
+		Favorites struct {
+			Nodes []struct {
+				ID         graphql.String
+				Type       graphql.String
+				SortOrder  graphql.Float
+				FolderName *graphql.String
+				Parent     *struct {
+					ID graphql.String
+				}
+				CustomView *struct {
+					ID   graphql.String
+					Name graphql.String
+				}
+				Project *struct {
+					ID   graphql.String
+					Name graphql.String
+				}
+				Issue *struct {
+					ID         graphql.String
+					Identifier graphql.String
+					Title      graphql.String
+				}
+				Label *struct {
+					ID   graphql.String
+					Name graphql.String
+				}
+				Cycle *struct {
+					ID     graphql.String
+					Name   *graphql.String
+					Number graphql.Float
+				}
+			}
+		} `graphql:"favorites(first: 250)"`
+	}
+
+	err := c.client.Query(ctx, &query, nil)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.views: ListFavorites failed")
+		return nil, fmt.Errorf("list favorites: %w", err)
+	}
+
+	nodes := query.Favorites.Nodes
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].SortOrder < nodes[j].SortOrder
+	})
+
+	var top []Favorite
+	children := make(map[string][]Favorite)
+	for _, node := range nodes {
+		fav := Favorite{
+			ID:   string(node.ID),
+			Type: string(node.Type),
+		}
+		switch {
+		case node.CustomView != nil:
+			fav.CustomViewID = string(node.CustomView.ID)
+			fav.Title = string(node.CustomView.Name)
+		case node.Project != nil:
+			fav.ProjectID = string(node.Project.ID)
+			fav.Title = string(node.Project.Name)
+		case node.Issue != nil:
+			fav.IssueID = string(node.Issue.ID)
+			fav.Title = fmt.Sprintf("%s %s", node.Issue.Identifier, node.Issue.Title)
+		case node.Label != nil:
+			fav.LabelID = string(node.Label.ID)
+			fav.Title = string(node.Label.Name)
+		case node.Cycle != nil:
+			fav.CycleID = string(node.Cycle.ID)
+			fav.Title = cycleDisplayNameFromNode(node.Cycle.Number, node.Cycle.Name)
+		case fav.Type == FavoriteTypeFolder && node.FolderName != nil:
+			fav.Title = string(*node.FolderName)
+		default:
+			// Documents, roadmaps and other favorites have no issue list to show
+			continue
+		}
+		if node.Parent != nil {
+			parentID := string(node.Parent.ID)
+			children[parentID] = append(children[parentID], fav)
+			continue
+		}
+		top = append(top, fav)
+	}
+
+	favorites := make([]Favorite, 0, len(top))
+	for _, fav := range top {
+		if fav.Type == FavoriteTypeFolder {
+			fav.Children = children[fav.ID]
+			if len(fav.Children) == 0 {
+				continue
+			}
+		}
+		favorites = append(favorites, fav)
+	}
+
+	return favorites, nil
+}
+
+// fetchCustomViewIssuesPage fetches a page of a custom view's issues. Linear applies
+// the view's saved filter; params add search terms and sorting on top.
+//
+//nolint:dupl // GraphQL library requires inline struct definitions; duplication with fetchIssuesWithFilterPage is unavoidable.
+func (c *Client) fetchCustomViewIssuesPage(ctx context.Context, params FetchIssuesParams, after *string) (IssuePage, error) {
+	first := params.First
+	if first <= 0 {
+		first = 50
+	}
+
+	filter := buildIssueFilter(params)
+
+	orderBy := PaginationOrderBy(params.OrderBy)
+	if orderBy == "" || params.OrderBy == "priority" {
+		orderBy = OrderByUpdatedAt
+	}
+
+	var afterCursor *graphql.String
+	if after != nil {
+		cursor := graphql.String(*after)
+		afterCursor = &cursor
+	}
+
+	var query struct {
+		CustomView struct {
+			Issues struct {
+				Nodes []struct {
+					ID         graphql.String
+					Identifier graphql.String
+					Title      graphql.String
+					State      struct {
+						ID   graphql.String
+						Name graphql.String
+					}
+					Assignee *struct {
+						ID   graphql.String
+						Name graphql.String
+					}
+					Priority    graphql.Float
+					UpdatedAt   graphql.String
+					CreatedAt   graphql.String
+					Description *graphql.String
+					Team        struct {
+						ID graphql.String
+					}
+					Project *struct {
+						ID   graphql.String
+						Name graphql.String
+					}
+					Cycle *struct {
+						ID     graphql.String
+						Number graphql.Float
+						Name   *graphql.String
+					}
+					Estimate      *graphql.Float
+					DueDate       *graphql.String
+					StartedAt     *graphql.String
+					CompletedAt   *graphql.String
+					CanceledAt    *graphql.String
+					SlaBreachesAt *graphql.String
+					Creator       *struct {
+						ID   graphql.String
+						Name graphql.String
+					}
+					// Only what the blocked marker needs; details load the full relation list
+					InverseRelations struct {
+						Nodes []struct {
+							ID    graphql.String
+							Type  graphql.String
+							Issue struct {
+								ID         graphql.String
+								Identifier graphql.String
+								State      struct {
+									Type graphql.String
+								}
+							}
+						}
+					} `graphql:"inverseRelations(first: 10)"`
+					Labels struct {
+						Nodes []struct {
+							ID    graphql.String
+							Name  graphql.String
+							Color graphql.String
+						}
+					}
+					URL        graphql.String
+					BranchName graphql.String
+					ArchivedAt *graphql.String
+					Parent     *struct {
+						ID         graphql.String
+						Identifier graphql.String
+						Title      graphql.String
+					}
+					Children struct {
+						Nodes []struct {
+							ID         graphql.String
+							Identifier graphql.String
+							Title      graphql.String
+							State      struct {
+								ID   graphql.String
+								Name graphql.String
+							}
+						}
+					}
+				}
+				PageInfo struct {
+					HasNextPage graphql.Boolean
+					EndCursor   graphql.String
+				}
+			} `graphql:"issues(first: $first, after: $after, filter: $filter, orderBy: $orderBy)"`
+		} `graphql:"customView(id: $viewId)"`
+	}
+
+	variables := map[string]interface{}{
+		"viewId":  graphql.String(params.CustomViewID),
+		"first":   graphql.Int(first),
+		"filter":  filter,
+		"orderBy": orderBy,
+		"after":   afterCursor,
+	}
+
+	err := c.client.Query(ctx, &query, variables)
+	if err != nil {
+		logger.ErrorWithErr(err, "linearapi.views: custom view issues failed view_id=%s", params.CustomViewID)
+		return IssuePage{}, fmt.Errorf("fetch custom view issues: %w", err)
+	}
+
+	nodes := query.CustomView.Issues.Nodes
+	issues := make([]Issue, 0, len(nodes))
+	for _, node := range nodes {
+		issues = append(issues, c.parseIssueNode(node))
+	}
+
+	hasNext := bool(query.CustomView.Issues.PageInfo.HasNextPage)
+	var endCursor *string
+	if hasNext {
+		cursor := string(query.CustomView.Issues.PageInfo.EndCursor)
+		endCursor = &cursor
+	}
+
+	return IssuePage{
+		Issues:    issues,
+		HasNext:   hasNext,
+		EndCursor: endCursor,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package linearapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// graphQLTestServer serves a fixed response and records the last request body.
+func graphQLTestServer(t *testing.T, response string, lastRequest *map[string]interface{}) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+		if lastRequest != nil {
+			*lastRequest = body
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return NewClient(ClientConfig{Token: "test-token", Endpoint: server.URL})
+}
+
+// TestListFavorites_NestsFoldersAndSkipsUnsupported verifies ordering, folder nesting and skipped types.
+func TestListFavorites_NestsFoldersAndSkipsUnsupported(t *testing.T) {
+	client := graphQLTestServer(t, `{"data": {"favorites": {"nodes": [
+		{"id": "fav-label", "type": "label", "sortOrder": 3, "folderName": null, "parent": {"id": "fav-folder"},
+		 "customView": null, "project": null, "issue": null, "label": {"id": "label-1", "name": "bug"}, "cycle": null},
+		{"id": "fav-view", "type": "customView", "sortOrder": 1, "folderName": null, "parent": null,
+		 "customView": {"id": "view-1", "name": "Triage"}, "project": null, "issue": null, "label": null, "cycle": null},
+		{"id": "fav-doc", "type": "document", "sortOrder": 2, "folderName": null, "parent": null,
+		 "customView": null, "project": null, "issue": null, "label": null, "cycle": null},
+		{"id": "fav-folder", "type": "folder", "sortOrder": 4, "folderName": "Team", "parent": null,
+		 "customView": null, "project": null, "issue": null, "label": null, "cycle": null},
+		{"id": "fav-empty", "type": "folder", "sortOrder": 5, "folderName": "Empty", "parent": null,
+		 "customView": null, "project": null, "issue": null, "label": null, "cycle": null},
+		{"id": "fav-issue", "type": "issue", "sortOrder": 0, "folderName": null, "parent": null,
+		 "customView": null, "project": null, "issue": {"id": "issue-1", "identifier": "ABC-1", "title": "Login"}, "label": null, "cycle": null}
+	]}}}`, nil)
+
+	favorites, err := client.ListFavorites(context.Background())
+	if err != nil {
+		t.Fatalf("ListFavorites() error = %v", err)
+	}
+
+	want := []Favorite{
+		{ID: "fav-issue", Type: FavoriteTypeIssue, Title: "ABC-1 Login", IssueID: "issue-1"},
+		{ID: "fav-view", Type: FavoriteTypeCustomView, Title: "Triage", CustomViewID: "view-1"},
+		{ID: "fav-folder", Type: FavoriteTypeFolder, Title: "Team", Children: []Favorite{
+			{ID: "fav-label", Type: FavoriteTypeLabel, Title: "bug", LabelID: "label-1"},
+		}},
+	}
+	if !reflect.DeepEqual(favorites, want) {
+		t.Fatalf("ListFavorites() = %+v, want %+v", favorites, want)
+	}
+}
+
+// TestFetchIssuesPage_CustomView verifies custom views query the view's issues connection.
+func TestFetchIssuesPage_CustomView(t *testing.T) {
+	var request map[string]interface{}
+	client := graphQLTestServer(t, fmt.Sprintf(`{"data": {"customView": {"issues": {
+		"nodes": [%s], "pageInfo": {"hasNextPage": false, "endCursor": ""}}}}}`,
+		issueNodeJSON("issue-1", "ABC-1", "First")), &request)
+
+	page, err := client.FetchIssuesPage(context.Background(), FetchIssuesParams{
+		CustomViewID: "view-1",
+		Search:       "login",
+		OrderBy:      "priority",
+	}, nil)
+	if err != nil {
+		t.Fatalf("FetchIssuesPage() error = %v", err)
+	}
+	if len(page.Issues) != 1 || page.Issues[0].Identifier != "ABC-1" {
+		t.Fatalf("FetchIssuesPage() issues = %+v", page.Issues)
+	}
+
+	query, _ := request["query"].(string)
+	if !strings.Contains(query, "customView(id: $viewId)") {
+		t.Fatalf("query = %s, want customView(id: $viewId)", query)
+	}
+	variables, _ := request["variables"].(map[string]interface{})
+	if variables["viewId"] != "view-1" || variables["orderBy"] != "updatedAt" {
+		t.Fatalf("variables = %+v", variables)
+	}
+	if _, ok := variables["filter"].(map[string]interface{})["or"]; !ok {
+		t.Fatalf("filter = %+v, want search terms", variables["filter"])
+	}
+}
+
+// TestFavoriteIssueFilter verifies favorites map to issue filters.
+func TestFavoriteIssueFilter(t *testing.T) {
+	if got := (Favorite{IssueID: "issue-1"}).IssueFilter(); !reflect.DeepEqual(got, IssueFilter{"id": map[string]interface{}{"eq": "issue-1"}}) {
+		t.Fatalf("issue favorite filter = %+v", got)
+	}
+	if got := (Favorite{CycleID: "cycle-1"}).IssueFilter(); got["cycle"] == nil {
+		t.Fatalf("cycle favorite filter = %+v", got)
+	}
+	if got := (Favorite{CustomViewID: "view-1"}).IssueFilter(); got != nil {
+		t.Fatalf("custom view favorite filter = %+v, want nil", got)
+	}
+}
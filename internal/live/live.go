@@ -0,0 +1,31 @@
+package live
+
+import (
+	"context"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// Action is what happened to the entity of an event.
+type Action string
+
+// Event actions, matching Linear's webhook actions.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionRemove Action = "remove"
+)
+
+// Event is one change to an issue or comment. Exactly one of Issue and Comment is set.
+type Event struct {
+	Action  Action
+	Issue   *linearapi.Issue   // Issue fields from the payload; comments, children and relations are not included
+	Comment *linearapi.Comment // Comment fields from the payload; IssueID names the commented issue
+}
+
+// Source delivers live events.
+type Source interface {
+	// Run calls handle for each event until ctx is cancelled or the source fails.
+	// handle is called from the source's goroutines.
+	Run(ctx context.Context, handle func(Event)) error
+}
@@ -0,0 +1,313 @@
+package live
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// Webhook delivery constants.
+const (
+	// SignatureHeader carries the hex HMAC-SHA256 of the request body under the webhook secret.
+	SignatureHeader = "Linear-Signature"
+	// maxWebhookBody bounds the size of a webhook delivery.
+	maxWebhookBody = 1 << 20
+	// maxWebhookAge rejects deliveries older than this, so captured requests cannot be replayed.
+	maxWebhookAge = time.Minute
+)
+
+// webhookPayload is the body of a Linear webhook delivery.
+type webhookPayload struct {
+	Action           Action          `json:"action"`
+	Type             string          `json:"type"`
+	Data             json.RawMessage `json:"data"`
+	WebhookTimestamp int64           `json:"webhookTimestamp"` // Unix milliseconds
+}
+
+// webhookEntity is a named entity embedded in webhook data.
+type webhookEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// webhookIssue is the issue data of an Issue webhook.
+type webhookIssue struct {
+	ID          string         `json:"id"`
+	Identifier  string         `json:"identifier"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Priority    float64        `json:"priority"`
+	Estimate    *float64       `json:"estimate"`
+	DueDate     string         `json:"dueDate"`
+	URL         string         `json:"url"`
+	BranchName  string         `json:"branchName"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
+	ArchivedAt  *time.Time     `json:"archivedAt"`
+	StartedAt   *time.Time     `json:"startedAt"`
+	CompletedAt *time.Time     `json:"completedAt"`
+	CanceledAt  *time.Time     `json:"canceledAt"`
+	TeamID      string         `json:"teamId"`
+	StateID     string         `json:"stateId"`
+	State       *webhookEntity `json:"state"`
+	AssigneeID  string         `json:"assigneeId"`
+	Assignee    *webhookEntity `json:"assignee"`
+	CreatorID   string         `json:"creatorId"`
+	ProjectID   string         `json:"projectId"`
+	Project     *webhookEntity `json:"project"`
+	CycleID     string         `json:"cycleId"`
+	Cycle       *struct {
+		ID     string  `json:"id"`
+		Name   string  `json:"name"`
+		Number float64 `json:"number"`
+	} `json:"cycle"`
+	ParentID string `json:"parentId"`
+	Labels   []struct {
+		ID    string `json:"id"`
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	} `json:"labels"`
+}
+
+// webhookComment is the comment data of a Comment webhook.
+type webhookComment struct {
+	ID        string         `json:"id"`
+	Body      string         `json:"body"`
+	IssueID   string         `json:"issueId"`
+	ParentID  string         `json:"parentId"`
+	UserID    string         `json:"userId"`
+	User      *webhookEntity `json:"user"`
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+}
+
+// WebhookSource receives Linear webhooks on a local address, typically forwarded by a
+// relay or tunnel from Linear's public webhook delivery.
+type WebhookSource struct {
+	Addr   string // Listen address, e.g. "127.0.0.1:8765"
+	Secret string // Webhook signing secret shown by Linear
+}
+
+// NewWebhookSource creates a webhook source listening on addr.
+func NewWebhookSource(addr, secret string) *WebhookSource {
+	return &WebhookSource{Addr: addr, Secret: secret}
+}
+
+// Run serves webhooks until ctx is cancelled.
+func (s *WebhookSource) Run(ctx context.Context, handle func(Event)) error {
+	listener, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return fmt.Errorf("listen for webhooks on %s: %w", s.Addr, err)
+	}
+	logger.Info("live.webhook: listening addr=%s", listener.Addr())
+	return serveWebhooks(ctx, listener, NewWebhookHandler(s.Secret, handle))
+}
+
+// serveWebhooks serves handler on listener until ctx is cancelled.
+func serveWebhooks(ctx context.Context, listener net.Listener, handler http.Handler) error {
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("serve webhooks: %w", err)
+	}
+	return nil
+}
+
+// NewWebhookHandler returns a handler verifying Linear webhook deliveries and passing
+// Issue and Comment events to handle. Other entity types are acknowledged and ignored.
+func NewWebhookHandler(secret string, handle func(Event)) http.Handler {
+	return &webhookHandler{secret: secret, handle: handle, now: time.Now}
+}
+
+// webhookHandler implements NewWebhookHandler.
+type webhookHandler struct {
+	secret string
+	handle func(Event)
+	now    func() time.Time
+}
+
+// ServeHTTP verifies and decodes one webhook delivery.
+func (h *webhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody+1))
+	if err != nil || len(body) > maxWebhookBody {
+		http.Error(w, "unreadable body", http.StatusBadRequest)
+		return
+	}
+	if !VerifySignature(h.secret, body, r.Header.Get(SignatureHeader)) {
+		logger.Warning("live.webhook: rejected delivery with invalid signature remote=%s", r.RemoteAddr)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		logger.Warning("live.webhook: rejected malformed delivery error=%v", err)
+		http.Error(w, "malformed payload", http.StatusBadRequest)
+		return
+	}
+	sentAt := time.UnixMilli(payload.WebhookTimestamp)
+	if age := h.now().Sub(sentAt); age > maxWebhookAge || age < -maxWebhookAge {
+		logger.Warning("live.webhook: rejected stale delivery sent_at=%s", sentAt.Format(time.RFC3339))
+		http.Error(w, "stale delivery", http.StatusUnauthorized)
+		return
+	}
+
+	event, ok, err := decodeEvent(payload)
+	if err != nil {
+		logger.Warning("live.webhook: rejected delivery type=%s error=%v", payload.Type, err)
+		http.Error(w, "malformed payload", http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	if !ok {
+		logger.Debug("live.webhook: ignored delivery type=%s action=%s", payload.Type, payload.Action)
+		return
+	}
+	logger.Debug("live.webhook: received delivery type=%s action=%s", payload.Type, payload.Action)
+	h.handle(event)
+}
+
+// Sign returns the Linear-Signature value for body under secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature is the valid Linear-Signature of body under secret.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	got, err := hex.DecodeString(signature)
+	if err != nil || secret == "" {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// decodeEvent converts a webhook payload into an event. It returns false for entity
+// types and actions live updates do not handle.
+func decodeEvent(payload webhookPayload) (Event, bool, error) {
+	switch payload.Action {
+	case ActionCreate, ActionUpdate, ActionRemove:
+	default:
+		return Event{}, false, nil
+	}
+
+	switch payload.Type {
+	case "Issue":
+		var data webhookIssue
+		if err := json.Unmarshal(payload.Data, &data); err != nil {
+			return Event{}, false, fmt.Errorf("decode issue: %w", err)
+		}
+		if data.ID == "" {
+			return Event{}, false, fmt.Errorf("issue has no id")
+		}
+		issue := data.toIssue()
+		return Event{Action: payload.Action, Issue: &issue}, true, nil
+	case "Comment":
+		var data webhookComment
+		if err := json.Unmarshal(payload.Data, &data); err != nil {
+			return Event{}, false, fmt.Errorf("decode comment: %w", err)
+		}
+		if data.ID == "" || data.IssueID == "" {
+			return Event{}, false, fmt.Errorf("comment has no id or issue id")
+		}
+		comment := data.toComment()
+		return Event{Action: payload.Action, Comment: &comment}, true, nil
+	}
+	return Event{}, false, nil
+}
+
+// toIssue converts webhook issue data to an issue.
+func (d webhookIssue) toIssue() linearapi.Issue {
+	issue := linearapi.Issue{
+		ID:          d.ID,
+		Identifier:  d.Identifier,
+		Title:       d.Title,
+		Description: d.Description,
+		StateID:     d.StateID,
+		AssigneeID:  d.AssigneeID,
+		Priority:    int(d.Priority),
+		UpdatedAt:   d.UpdatedAt,
+		CreatedAt:   d.CreatedAt,
+		TeamID:      d.TeamID,
+		ProjectID:   d.ProjectID,
+		CycleID:     d.CycleID,
+		URL:         d.URL,
+		BranchName:  d.BranchName,
+		Archived:    d.ArchivedAt != nil,
+		DueDate:     d.DueDate,
+		CreatorID:   d.CreatorID,
+		Labels:      make([]linearapi.IssueLabel, 0, len(d.Labels)),
+	}
+	if d.State != nil {
+		issue.State = d.State.Name
+	}
+	if d.Assignee != nil {
+		issue.Assignee = d.Assignee.Name
+	}
+	if d.Project != nil {
+		issue.ProjectName = d.Project.Name
+	}
+	if d.Cycle != nil {
+		issue.CycleName = linearapi.CycleDisplayName(int(d.Cycle.Number), d.Cycle.Name)
+	}
+	if d.ParentID != "" {
+		issue.Parent = &linearapi.IssueRef{ID: d.ParentID}
+	}
+	if d.Estimate != nil {
+		estimate := int(*d.Estimate)
+		issue.Estimate = &estimate
+	}
+	if d.StartedAt != nil {
+		issue.StartedAt = *d.StartedAt
+	}
+	if d.CompletedAt != nil {
+		issue.CompletedAt = *d.CompletedAt
+	}
+	if d.CanceledAt != nil {
+		issue.CanceledAt = *d.CanceledAt
+	}
+	for _, label := range d.Labels {
+		issue.Labels = append(issue.Labels, linearapi.IssueLabel{ID: label.ID, Name: label.Name, Color: label.Color})
+	}
+	return issue
+}
+
+// toComment converts webhook comment data to a comment.
+func (d webhookComment) toComment() linearapi.Comment {
+	comment := linearapi.Comment{
+		ID:        d.ID,
+		Body:      d.Body,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+		Author:    linearapi.User{ID: d.UserID},
+		IssueID:   d.IssueID,
+		ParentID:  d.ParentID,
+	}
+	if d.User != nil {
+		comment.Author.Name = d.User.Name
+	}
+	return comment
+}
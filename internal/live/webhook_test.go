@@ -0,0 +1,176 @@
+package live
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSender posts signed webhook deliveries the way Linear does.
+type fakeSender struct {
+	t      *testing.T
+	url    string
+	secret string
+}
+
+// send posts a delivery of entity type with data JSON and returns the response status.
+func (s fakeSender) send(action, entity, data string, sentAt time.Time) int {
+	s.t.Helper()
+	body := []byte(fmt.Sprintf(`{"action": %q, "type": %q, "data": %s, "webhookTimestamp": %d}`,
+		action, entity, data, sentAt.UnixMilli()))
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		s.t.Fatalf("new request: %v", err)
+	}
+	req.Header.Set(SignatureHeader, Sign(s.secret, body))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		s.t.Fatalf("send delivery: %v", err)
+	}
+	_ = resp.Body.Close()
+	return resp.StatusCode
+}
+
+// eventRecorder collects events handed to a source's handler.
+type eventRecorder struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+// handle records event.
+func (r *eventRecorder) handle(event Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+// all returns the recorded events.
+func (r *eventRecorder) all() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Event(nil), r.events...)
+}
+
+const testIssueData = `{"id": "issue-1", "identifier": "ENG-1", "title": "Fix login", "priority": 2,
+	"estimate": 3, "dueDate": "2026-11-01", "teamId": "team-1", "stateId": "state-2",
+	"state": {"id": "state-2", "name": "In Progress"}, "assigneeId": "user-1",
+	"assignee": {"id": "user-1", "name": "Ada"}, "cycleId": "cycle-1",
+	"cycle": {"id": "cycle-1", "name": null, "number": 7}, "parentId": "issue-0",
+	"labels": [{"id": "label-1", "name": "bug", "color": "#ff0000"}],
+	"createdAt": "2026-10-01T10:00:00.000Z", "updatedAt": "2026-10-17T09:30:00.000Z", "archivedAt": null}`
+
+// TestWebhookHandler_DecodesIssueAndComment verifies signed Issue and Comment deliveries become events.
+func TestWebhookHandler_DecodesIssueAndComment(t *testing.T) {
+	recorder := &eventRecorder{}
+	server := httptest.NewServer(NewWebhookHandler("secret", recorder.handle))
+	t.Cleanup(server.Close)
+	sender := fakeSender{t: t, url: server.URL, secret: "secret"}
+
+	if status := sender.send("update", "Issue", testIssueData, time.Now()); status != http.StatusOK {
+		t.Fatalf("issue delivery status = %d, want 200", status)
+	}
+	comment := `{"id": "comment-1", "body": "On it", "issueId": "issue-1", "userId": "user-1",
+		"user": {"id": "user-1", "name": "Ada"}, "createdAt": "2026-10-17T09:31:00.000Z"}`
+	if status := sender.send("create", "Comment", comment, time.Now()); status != http.StatusOK {
+		t.Fatalf("comment delivery status = %d, want 200", status)
+	}
+
+	events := recorder.all()
+	if len(events) != 2 {
+		t.Fatalf("events = %d, want 2", len(events))
+	}
+	issue := events[0].Issue
+	if events[0].Action != ActionUpdate || issue == nil {
+		t.Fatalf("first event = %+v, want issue update", events[0])
+	}
+	if issue.Identifier != "ENG-1" || issue.State != "In Progress" || issue.Assignee != "Ada" || issue.Priority != 2 {
+		t.Errorf("issue = %+v", *issue)
+	}
+	if issue.Estimate == nil || *issue.Estimate != 3 || issue.CycleName != "Cycle 7" || issue.Parent == nil || issue.Parent.ID != "issue-0" {
+		t.Errorf("issue estimate/cycle/parent = %v %q %v", issue.Estimate, issue.CycleName, issue.Parent)
+	}
+	if len(issue.Labels) != 1 || issue.Labels[0].Name != "bug" || issue.Archived {
+		t.Errorf("issue labels/archived = %v %t", issue.Labels, issue.Archived)
+	}
+	if want := time.Date(2026, 10, 17, 9, 30, 0, 0, time.UTC); !issue.UpdatedAt.Equal(want) {
+		t.Errorf("UpdatedAt = %s, want %s", issue.UpdatedAt, want)
+	}
+
+	got := events[1].Comment
+	if events[1].Action != ActionCreate || got == nil || got.IssueID != "issue-1" || got.Author.Name != "Ada" || got.Body != "On it" {
+		t.Errorf("second event = %+v comment=%+v", events[1], got)
+	}
+}
+
+// TestWebhookHandler_RejectsUnverifiedDeliveries verifies bad signatures and stale timestamps are refused.
+func TestWebhookHandler_RejectsUnverifiedDeliveries(t *testing.T) {
+	recorder := &eventRecorder{}
+	server := httptest.NewServer(NewWebhookHandler("secret", recorder.handle))
+	t.Cleanup(server.Close)
+
+	forged := fakeSender{t: t, url: server.URL, secret: "wrong"}
+	if status := forged.send("update", "Issue", testIssueData, time.Now()); status != http.StatusUnauthorized {
+		t.Errorf("forged delivery status = %d, want 401", status)
+	}
+	sender := fakeSender{t: t, url: server.URL, secret: "secret"}
+	if status := sender.send("update", "Issue", testIssueData, time.Now().Add(-5*time.Minute)); status != http.StatusUnauthorized {
+		t.Errorf("stale delivery status = %d, want 401", status)
+	}
+	if status := sender.send("update", "Issue", `{"title": "no id"}`, time.Now()); status != http.StatusBadRequest {
+		t.Errorf("issue without id status = %d, want 400", status)
+	}
+	if status := sender.send("create", "Project", `{"id": "project-1"}`, time.Now()); status != http.StatusOK {
+		t.Errorf("unhandled type status = %d, want 200", status)
+	}
+
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET status = %d, want 405", resp.StatusCode)
+	}
+
+	if events := recorder.all(); len(events) != 0 {
+		t.Errorf("events = %+v, want none", events)
+	}
+}
+
+// TestServeWebhooks_StopsOnCancel verifies the webhook server delivers events and shuts down with its context.
+func TestServeWebhooks_StopsOnCancel(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	recorder := &eventRecorder{}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- serveWebhooks(ctx, listener, NewWebhookHandler("secret", recorder.handle))
+	}()
+
+	sender := fakeSender{t: t, url: "http://" + listener.Addr().String(), secret: "secret"}
+	if status := sender.send("remove", "Issue", testIssueData, time.Now()); status != http.StatusOK {
+		t.Fatalf("delivery status = %d, want 200", status)
+	}
+	if events := recorder.all(); len(events) != 1 || events[0].Action != ActionRemove {
+		t.Errorf("events = %+v, want one remove", events)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("serveWebhooks() error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serveWebhooks() did not stop after cancel")
+	}
+}
@@ -0,0 +1,353 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// outboxVersion is bumped whenever the on-disk layout changes incompatibly.
+const outboxVersion = 1
+
+// Kind identifies the API call a queued mutation replays.
+type Kind string
+
+const (
+	KindUpdateIssue   Kind = "update_issue"
+	KindCreateComment Kind = "create_comment"
+	KindCreateIssue   Kind = "create_issue"
+	KindArchiveIssue  Kind = "archive_issue"
+)
+
+// State tracks where a queued mutation is in its replay lifecycle.
+type State string
+
+const (
+	// StatePending mutations are replayed automatically when the API is reachable.
+	StatePending State = "pending"
+	// StateConflict mutations target an issue that changed remotely after they were queued.
+	StateConflict State = "conflict"
+	// StateFailed mutations were rejected by the API and need user attention.
+	StateFailed State = "failed"
+)
+
+// Mutation is a single queued write against the Linear API.
+type Mutation struct {
+	ID         string `json:"id"`
+	Kind       Kind   `json:"kind"`
+	State      State  `json:"state"`
+	IssueID    string `json:"issue_id,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+	// BaseUpdatedAt is the issue's updatedAt when the mutation was queued.
+	BaseUpdatedAt time.Time `json:"base_updated_at,omitempty"`
+	QueuedAt      time.Time `json:"queued_at"`
+	// Force skips the conflict check on the next replay.
+	Force     bool   `json:"force,omitempty"`
+	LastError string `json:"last_error,omitempty"`
+
+	Update  *linearapi.UpdateIssueInput   `json:"update,omitempty"`
+	Comment *linearapi.CreateCommentInput `json:"comment,omitempty"`
+	Create  *linearapi.CreateIssueInput   `json:"create,omitempty"`
+}
+
+// NewUpdateIssue builds a queued issue update based on the issue as last seen.
+func NewUpdateIssue(issue linearapi.Issue, input linearapi.UpdateIssueInput) Mutation {
+	return Mutation{
+		Kind:          KindUpdateIssue,
+		IssueID:       issue.ID,
+		Identifier:    issue.Identifier,
+		BaseUpdatedAt: issue.UpdatedAt,
+		Update:        &input,
+	}
+}
+
+// NewArchiveIssue builds a queued archive of the issue as last seen.
+func NewArchiveIssue(issue linearapi.Issue) Mutation {
+	return Mutation{
+		Kind:          KindArchiveIssue,
+		IssueID:       issue.ID,
+		Identifier:    issue.Identifier,
+		BaseUpdatedAt: issue.UpdatedAt,
+	}
+}
+
+// NewCreateComment builds a queued comment on an issue.
+func NewCreateComment(identifier string, input linearapi.CreateCommentInput) Mutation {
+	return Mutation{
+		Kind:       KindCreateComment,
+		IssueID:    input.IssueID,
+		Identifier: identifier,
+		Comment:    &input,
+	}
+}
+
+// NewCreateIssue builds a queued issue creation.
+func NewCreateIssue(input linearapi.CreateIssueInput) Mutation {
+	return Mutation{
+		Kind:   KindCreateIssue,
+		Create: &input,
+	}
+}
+
+// Describe returns a short human-readable summary of the mutation.
+func (m Mutation) Describe() string {
+	target := m.Identifier
+	if target == "" {
+		target = m.IssueID
+	}
+
+	switch m.Kind {
+	case KindUpdateIssue:
+		return fmt.Sprintf("Update %s (%s)", target, strings.Join(m.updatedFields(), ", "))
+	case KindArchiveIssue:
+		return fmt.Sprintf("Archive %s", target)
+	case KindCreateComment:
+		return fmt.Sprintf("Comment on %s", target)
+	case KindCreateIssue:
+		if m.Create != nil {
+			return fmt.Sprintf("Create issue %q", m.Create.Title)
+		}
+		return "Create issue"
+	default:
+		return string(m.Kind)
+	}
+}
+
+// updatedFields lists the fields an update mutation changes.
+func (m Mutation) updatedFields() []string {
+	if m.Update == nil {
+		return nil
+	}
+	fields := make([]string, 0, 11)
+	if m.Update.Title != nil {
+		fields = append(fields, "title")
+	}
+	if m.Update.Description != nil {
+		fields = append(fields, "description")
+	}
+	if m.Update.StateID != nil {
+		fields = append(fields, "status")
+	}
+	if m.Update.AssigneeID != nil {
+		fields = append(fields, "assignee")
+	}
+	if m.Update.Priority != nil {
+		fields = append(fields, "priority")
+	}
+	if m.Update.LabelIDs != nil {
+		fields = append(fields, "labels")
+	}
+	if m.Update.ParentID != nil {
+		fields = append(fields, "parent")
+	}
+	if m.Update.CycleID != nil {
+		fields = append(fields, "cycle")
+	}
+	if m.Update.ProjectID != nil {
+		fields = append(fields, "project")
+	}
+	if m.Update.DueDate != nil {
+		fields = append(fields, "due date")
+	}
+	if m.Update.Estimate != nil {
+		fields = append(fields, "estimate")
+	}
+	return fields
+}
+
+// outboxData is the JSON layout persisted to disk.
+type outboxData struct {
+	Version   int        `json:"version"`
+	Mutations []Mutation `json:"mutations"`
+}
+
+// Outbox is a durable FIFO queue of mutations that could not reach the API.
+// Every change is written to disk immediately so queued edits survive restarts.
+type Outbox struct {
+	mu        sync.RWMutex
+	path      string
+	mutations []Mutation
+	seq       int64
+}
+
+// FilePath returns the default outbox file path.
+func FilePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".linear-tui", "outbox.json"), nil
+}
+
+// Open loads the outbox at path, starting empty if it does not exist.
+func Open(path string) (*Outbox, error) {
+	if path == "" {
+		return nil, fmt.Errorf("outbox path is empty")
+	}
+
+	ob := &Outbox{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ob, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read outbox: %w", err)
+	}
+
+	var loaded outboxData
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		return nil, fmt.Errorf("parse outbox: %w", err)
+	}
+	if loaded.Version != outboxVersion {
+		return nil, fmt.Errorf("unsupported outbox version %d", loaded.Version)
+	}
+	ob.mutations = loaded.Mutations
+
+	return ob, nil
+}
+
+// Enqueue appends a mutation, assigning its ID and queue time, and persists the outbox.
+func (o *Outbox) Enqueue(m Mutation) (Mutation, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	now := time.Now()
+	o.seq++
+	m.ID = fmt.Sprintf("%d-%d", now.UnixNano(), o.seq)
+	m.QueuedAt = now
+	if m.State == "" {
+		m.State = StatePending
+	}
+	o.mutations = append(o.mutations, m)
+
+	if err := o.saveLocked(); err != nil {
+		o.mutations = o.mutations[:len(o.mutations)-1]
+		return Mutation{}, err
+	}
+	return m, nil
+}
+
+// Update replaces a queued mutation with the same ID and persists the outbox.
+func (o *Outbox) Update(m Mutation) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i := range o.mutations {
+		if o.mutations[i].ID == m.ID {
+			o.mutations[i] = m
+			return o.saveLocked()
+		}
+	}
+	return fmt.Errorf("mutation %s not found", m.ID)
+}
+
+// Requeue marks a conflicted or failed mutation as pending again. With force set,
+// the next replay applies it without checking for remote changes.
+func (o *Outbox) Requeue(id string, force bool) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i := range o.mutations {
+		if o.mutations[i].ID == id {
+			o.mutations[i].State = StatePending
+			o.mutations[i].Force = force
+			o.mutations[i].LastError = ""
+			return o.saveLocked()
+		}
+	}
+	return fmt.Errorf("mutation %s not found", id)
+}
+
+// Remove drops a queued mutation and persists the outbox.
+func (o *Outbox) Remove(id string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i := range o.mutations {
+		if o.mutations[i].ID == id {
+			o.mutations = append(o.mutations[:i], o.mutations[i+1:]...)
+			return o.saveLocked()
+		}
+	}
+	return nil
+}
+
+// Mutations returns a copy of all queued mutations in queue order.
+func (o *Outbox) Mutations() []Mutation {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return append([]Mutation(nil), o.mutations...)
+}
+
+// Get returns a queued mutation by ID.
+func (o *Outbox) Get(id string) (Mutation, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	for _, m := range o.mutations {
+		if m.ID == id {
+			return m, true
+		}
+	}
+	return Mutation{}, false
+}
+
+// Len returns the number of queued mutations.
+func (o *Outbox) Len() int {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return len(o.mutations)
+}
+
+// IssueStates returns the most severe state of queued mutations per issue ID.
+// Conflicts and failures outrank pending mutations.
+func (o *Outbox) IssueStates() map[string]State {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	states := make(map[string]State)
+	for _, m := range o.mutations {
+		if m.IssueID == "" {
+			continue
+		}
+		if current, ok := states[m.IssueID]; ok && current != StatePending {
+			continue
+		}
+		states[m.IssueID] = m.State
+	}
+	return states
+}
+
+// saveLocked writes the outbox to disk. Callers must hold o.mu.
+func (o *Outbox) saveLocked() error {
+	dir := filepath.Dir(o.path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create outbox directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(outboxData{Version: outboxVersion, Mutations: o.mutations}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal outbox: %w", err)
+	}
+	data = append(data, '\n')
+
+	// Write to a temp file and rename so a crash never loses already queued edits.
+	tmpPath := o.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("write outbox: %w", err)
+	}
+	if err := os.Rename(tmpPath, o.path); err != nil {
+		return fmt.Errorf("replace outbox: %w", err)
+	}
+	return nil
+}
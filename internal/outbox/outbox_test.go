@@ -0,0 +1,216 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// fakeAPI records replayed calls and serves issues from a map.
+type fakeAPI struct {
+	issues      map[string]linearapi.Issue
+	unreachable bool
+	rejectErr   error
+	updates     []linearapi.UpdateIssueInput
+	comments    []linearapi.CreateCommentInput
+	creates     []linearapi.CreateIssueInput
+	archives    []string
+}
+
+// errOffline mimics a dial failure.
+var errOffline = &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+
+func (f *fakeAPI) FetchIssueByID(ctx context.Context, id string) (linearapi.Issue, error) {
+	if f.unreachable {
+		return linearapi.Issue{}, errOffline
+	}
+	return f.issues[id], nil
+}
+
+func (f *fakeAPI) UpdateIssue(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+	if f.unreachable {
+		return linearapi.Issue{}, errOffline
+	}
+	if f.rejectErr != nil {
+		return linearapi.Issue{}, f.rejectErr
+	}
+	f.updates = append(f.updates, input)
+	issue := f.issues[input.ID]
+	issue.UpdatedAt = issue.UpdatedAt.Add(time.Minute)
+	f.issues[input.ID] = issue
+	return issue, nil
+}
+
+func (f *fakeAPI) CreateComment(ctx context.Context, input linearapi.CreateCommentInput) (linearapi.Comment, error) {
+	if f.unreachable {
+		return linearapi.Comment{}, errOffline
+	}
+	f.comments = append(f.comments, input)
+	return linearapi.Comment{ID: "comment-1", Body: input.Body}, nil
+}
+
+func (f *fakeAPI) CreateIssue(ctx context.Context, input linearapi.CreateIssueInput) (linearapi.Issue, error) {
+	if f.unreachable {
+		return linearapi.Issue{}, errOffline
+	}
+	f.creates = append(f.creates, input)
+	return linearapi.Issue{ID: "new-issue", Title: input.Title}, nil
+}
+
+func (f *fakeAPI) ArchiveIssue(ctx context.Context, issueID string) error {
+	if f.unreachable {
+		return errOffline
+	}
+	f.archives = append(f.archives, issueID)
+	return nil
+}
+
+// openTestOutbox opens an outbox in a temporary directory.
+func openTestOutbox(t *testing.T) (*Outbox, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "outbox.json")
+	ob, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	return ob, path
+}
+
+// TestOutbox_EnqueuePersists verifies queued mutations survive a reopen.
+func TestOutbox_EnqueuePersists(t *testing.T) {
+	ob, path := openTestOutbox(t)
+
+	title := "Renamed"
+	issue := linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", UpdatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if _, err := ob.Enqueue(NewUpdateIssue(issue, linearapi.UpdateIssueInput{ID: issue.ID, Title: &title})); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	if _, err := ob.Enqueue(NewCreateIssue(linearapi.CreateIssueInput{TeamID: "team-1", Title: "New"})); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() reload error = %v", err)
+	}
+	mutations := reopened.Mutations()
+	if len(mutations) != 2 {
+		t.Fatalf("Mutations() len = %d, want 2", len(mutations))
+	}
+	first := mutations[0]
+	if first.Kind != KindUpdateIssue || first.State != StatePending || first.Update == nil || *first.Update.Title != "Renamed" {
+		t.Fatalf("first mutation = %#v", first)
+	}
+	if !first.BaseUpdatedAt.Equal(issue.UpdatedAt) {
+		t.Fatalf("BaseUpdatedAt = %v, want %v", first.BaseUpdatedAt, issue.UpdatedAt)
+	}
+	if got := first.Describe(); got != "Update ABC-1 (title)" {
+		t.Fatalf("Describe() = %q", got)
+	}
+	if states := reopened.IssueStates(); states["issue-1"] != StatePending || len(states) != 1 {
+		t.Fatalf("IssueStates() = %#v", states)
+	}
+}
+
+// TestReplay_AppliesInOrder verifies pending mutations are sent and removed.
+func TestReplay_AppliesInOrder(t *testing.T) {
+	ob, _ := openTestOutbox(t)
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	issue := linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", UpdatedAt: base}
+	api := &fakeAPI{issues: map[string]linearapi.Issue{"issue-1": issue}}
+
+	stateID := "state-done"
+	assigneeID := "user-1"
+	_, _ = ob.Enqueue(NewUpdateIssue(issue, linearapi.UpdateIssueInput{ID: issue.ID, StateID: &stateID}))
+	// A second edit of the same issue must not conflict with the first replayed one.
+	_, _ = ob.Enqueue(NewUpdateIssue(issue, linearapi.UpdateIssueInput{ID: issue.ID, AssigneeID: &assigneeID}))
+	_, _ = ob.Enqueue(NewCreateComment("ABC-1", linearapi.CreateCommentInput{IssueID: issue.ID, Body: "hi"}))
+
+	result := Replay(context.Background(), ob, api)
+	if len(result.Applied) != 3 || len(result.Conflicts) != 0 || result.Unreachable {
+		t.Fatalf("Replay() = %+v", result)
+	}
+	if len(api.updates) != 2 || *api.updates[0].StateID != stateID || len(api.comments) != 1 {
+		t.Fatalf("api calls = %+v", api)
+	}
+	if ob.Len() != 0 {
+		t.Fatalf("Len() = %d after replay, want 0", ob.Len())
+	}
+}
+
+// TestReplay_FlagsConflicts verifies remote edits after queueing block the mutation.
+func TestReplay_FlagsConflicts(t *testing.T) {
+	ob, _ := openTestOutbox(t)
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	queued := linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", UpdatedAt: base}
+	api := &fakeAPI{issues: map[string]linearapi.Issue{
+		"issue-1": {ID: "issue-1", Identifier: "ABC-1", UpdatedAt: base.Add(time.Hour)},
+	}}
+
+	m, _ := ob.Enqueue(NewArchiveIssue(queued))
+
+	result := Replay(context.Background(), ob, api)
+	if len(result.Conflicts) != 1 || len(api.archives) != 0 {
+		t.Fatalf("Replay() = %+v, archives = %v", result, api.archives)
+	}
+	stored, _ := ob.Get(m.ID)
+	if stored.State != StateConflict || stored.LastError == "" {
+		t.Fatalf("stored mutation = %#v, want conflict", stored)
+	}
+
+	// Conflicts are skipped until the user forces them.
+	if result := Replay(context.Background(), ob, api); len(result.Applied) != 0 || len(result.Conflicts) != 0 {
+		t.Fatalf("second Replay() = %+v, want no-op", result)
+	}
+	if err := ob.Requeue(m.ID, true); err != nil {
+		t.Fatalf("Requeue() error = %v", err)
+	}
+	if result := Replay(context.Background(), ob, api); len(result.Applied) != 1 || len(api.archives) != 1 {
+		t.Fatalf("forced Replay() = %+v", result)
+	}
+}
+
+// TestReplay_StopsWhenUnreachable verifies mutations stay queued while offline.
+func TestReplay_StopsWhenUnreachable(t *testing.T) {
+	ob, _ := openTestOutbox(t)
+	api := &fakeAPI{issues: map[string]linearapi.Issue{}, unreachable: true}
+
+	_, _ = ob.Enqueue(NewCreateIssue(linearapi.CreateIssueInput{TeamID: "team-1", Title: "New"}))
+	_, _ = ob.Enqueue(NewCreateComment("ABC-1", linearapi.CreateCommentInput{IssueID: "issue-1", Body: "hi"}))
+
+	result := Replay(context.Background(), ob, api)
+	if !result.Unreachable || len(result.Applied) != 0 {
+		t.Fatalf("Replay() = %+v, want unreachable", result)
+	}
+	if ob.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", ob.Len())
+	}
+	for _, m := range ob.Mutations() {
+		if m.State != StatePending {
+			t.Fatalf("mutation state = %s, want pending", m.State)
+		}
+	}
+}
+
+// TestReplay_MarksRejectedMutationsFailed verifies API errors are not retried automatically.
+func TestReplay_MarksRejectedMutationsFailed(t *testing.T) {
+	ob, _ := openTestOutbox(t)
+	issue := linearapi.Issue{ID: "issue-1", Identifier: "ABC-1"}
+	api := &fakeAPI{issues: map[string]linearapi.Issue{"issue-1": issue}, rejectErr: errors.New("invalid state")}
+
+	title := "x"
+	m, _ := ob.Enqueue(NewUpdateIssue(issue, linearapi.UpdateIssueInput{ID: issue.ID, Title: &title}))
+
+	result := Replay(context.Background(), ob, api)
+	if len(result.Failed) != 1 {
+		t.Fatalf("Replay() = %+v, want one failure", result)
+	}
+	if stored, _ := ob.Get(m.ID); stored.State != StateFailed || stored.LastError != "invalid state" {
+		t.Fatalf("stored mutation = %#v", stored)
+	}
+}
@@ -0,0 +1,136 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// API is the subset of the Linear client used to replay queued mutations.
+type API interface {
+	FetchIssueByID(ctx context.Context, id string) (linearapi.Issue, error)
+	UpdateIssue(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error)
+	CreateComment(ctx context.Context, input linearapi.CreateCommentInput) (linearapi.Comment, error)
+	CreateIssue(ctx context.Context, input linearapi.CreateIssueInput) (linearapi.Issue, error)
+	ArchiveIssue(ctx context.Context, issueID string) error
+}
+
+// ReplayResult summarizes a replay pass.
+type ReplayResult struct {
+	Applied   []Mutation
+	Conflicts []Mutation
+	Failed    []Mutation
+	// Unreachable is set when replay stopped early because the API could not be reached.
+	Unreachable bool
+}
+
+// Replay sends pending mutations to the API in queue order. Updates and archives
+// are checked for conflicts first: if the issue's updatedAt moved since the
+// mutation was queued, it is flagged as a conflict instead of overwriting the
+// remote change. Replay stops at the first connectivity failure so the remaining
+// mutations keep their order for the next attempt.
+func Replay(ctx context.Context, ob *Outbox, api API) ReplayResult {
+	var result ReplayResult
+
+	// Issues already written during this pass; their updatedAt moved because of us.
+	touched := make(map[string]bool)
+
+	for _, m := range ob.Mutations() {
+		if m.State != StatePending {
+			continue
+		}
+
+		if needsConflictCheck(m) && !m.Force && !touched[m.IssueID] {
+			remote, err := api.FetchIssueByID(ctx, m.IssueID)
+			if linearapi.IsUnreachable(err) {
+				result.Unreachable = true
+				return result
+			}
+			if err != nil {
+				result.Failed = append(result.Failed, markFailed(ob, m, err))
+				continue
+			}
+			if remote.UpdatedAt.After(m.BaseUpdatedAt) {
+				m.State = StateConflict
+				m.LastError = fmt.Sprintf("issue changed remotely at %s", remote.UpdatedAt.Format("2006-01-02 15:04"))
+				if err := ob.Update(m); err != nil {
+					logger.ErrorWithErr(err, "outbox: failed to flag conflict id=%s", m.ID)
+				}
+				logger.Warning("outbox: conflict issue=%s kind=%s", m.Identifier, m.Kind)
+				result.Conflicts = append(result.Conflicts, m)
+				continue
+			}
+		}
+
+		err := apply(ctx, api, m)
+		if linearapi.IsUnreachable(err) {
+			result.Unreachable = true
+			return result
+		}
+		if err != nil {
+			result.Failed = append(result.Failed, markFailed(ob, m, err))
+			continue
+		}
+
+		if err := ob.Remove(m.ID); err != nil {
+			logger.ErrorWithErr(err, "outbox: failed to remove applied mutation id=%s", m.ID)
+		}
+		if m.IssueID != "" {
+			touched[m.IssueID] = true
+		}
+		logger.Info("outbox: replayed mutation issue=%s kind=%s", m.Identifier, m.Kind)
+		result.Applied = append(result.Applied, m)
+	}
+
+	return result
+}
+
+// needsConflictCheck reports whether a mutation can overwrite remote changes.
+// Comments and new issues only add data, so they are never in conflict.
+func needsConflictCheck(m Mutation) bool {
+	if m.IssueID == "" || m.BaseUpdatedAt.IsZero() {
+		return false
+	}
+	return m.Kind == KindUpdateIssue || m.Kind == KindArchiveIssue
+}
+
+// apply performs the API call for a mutation.
+func apply(ctx context.Context, api API, m Mutation) error {
+	switch m.Kind {
+	case KindUpdateIssue:
+		if m.Update == nil {
+			return fmt.Errorf("update mutation %s has no input", m.ID)
+		}
+		_, err := api.UpdateIssue(ctx, *m.Update)
+		return err
+	case KindArchiveIssue:
+		return api.ArchiveIssue(ctx, m.IssueID)
+	case KindCreateComment:
+		if m.Comment == nil {
+			return fmt.Errorf("comment mutation %s has no input", m.ID)
+		}
+		_, err := api.CreateComment(ctx, *m.Comment)
+		return err
+	case KindCreateIssue:
+		if m.Create == nil {
+			return fmt.Errorf("create mutation %s has no input", m.ID)
+		}
+		_, err := api.CreateIssue(ctx, *m.Create)
+		return err
+	default:
+		return fmt.Errorf("unknown mutation kind %q", m.Kind)
+	}
+}
+
+// markFailed records an API rejection so the mutation is not retried automatically.
+func markFailed(ob *Outbox, m Mutation, cause error) Mutation {
+	logger.ErrorWithErr(cause, "outbox: mutation rejected issue=%s kind=%s", m.Identifier, m.Kind)
+	m.State = StateFailed
+	m.LastError = cause.Error()
+	if err := ob.Update(m); err != nil {
+		logger.ErrorWithErr(err, "outbox: failed to record failure id=%s", m.ID)
+	}
+	return m
+}
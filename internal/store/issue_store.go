@@ -0,0 +1,433 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// issueStoreVersion is bumped whenever the on-disk layout changes incompatibly.
+const issueStoreVersion = 1
+
+// FullSyncInterval is how long an incremental sync cursor stays valid before
+// a scope is fully re-fetched. Full syncs are needed to notice issues that were
+// deleted remotely or moved to another team, since those never show up in a team's
+// updatedAt delta.
+const FullSyncInterval = 24 * time.Hour
+
+// Scope identifies a navigation filter whose issues are synced together.
+type Scope struct {
+	TeamID    string
+	ProjectID string
+	StateID   string
+}
+
+// Key returns a stable identifier for the scope.
+func (s Scope) Key() string {
+	return fmt.Sprintf("team=%s|project=%s|state=%s", s.TeamID, s.ProjectID, s.StateID)
+}
+
+// Matches reports whether an issue belongs to the scope.
+func (s Scope) Matches(issue linearapi.Issue) bool {
+	if s.TeamID != "" && issue.TeamID != s.TeamID {
+		return false
+	}
+	if s.ProjectID != "" && issue.ProjectID != s.ProjectID {
+		return false
+	}
+	if s.StateID != "" && issue.StateID != s.StateID {
+		return false
+	}
+	return true
+}
+
+// ScopeSync records sync progress for a scope.
+type ScopeSync struct {
+	// Cursor is the newest issue updatedAt seen for the scope (server clock).
+	Cursor time.Time `json:"cursor"`
+	// FullSyncedAt is the local time of the last complete fetch of the scope.
+	FullSyncedAt time.Time `json:"full_synced_at"`
+}
+
+// issueStoreData is the JSON layout persisted to disk.
+type issueStoreData struct {
+	Version        int                                  `json:"version"`
+	Issues         map[string]linearapi.Issue           `json:"issues"`
+	Scopes         map[string]ScopeSync                 `json:"scopes"`
+	CurrentUser    *linearapi.User                      `json:"current_user,omitempty"`
+	Teams          []linearapi.Team                     `json:"teams,omitempty"`
+	Projects       map[string][]linearapi.Project       `json:"projects,omitempty"`
+	WorkflowStates map[string][]linearapi.WorkflowState `json:"workflow_states,omitempty"`
+}
+
+// IssueStore persists fetched issues, comments and team metadata on disk so the
+// UI can start with last-known data and remain browsable while offline.
+type IssueStore struct {
+	mu    sync.RWMutex
+	path  string
+	data  issueStoreData
+	dirty bool
+}
+
+// IssueStoreFilePath returns the default issue store file path.
+func IssueStoreFilePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".linear-tui", "issues.json"), nil
+}
+
+// newIssueStoreData returns an empty store layout.
+func newIssueStoreData() issueStoreData {
+	return issueStoreData{
+		Version:        issueStoreVersion,
+		Issues:         make(map[string]linearapi.Issue),
+		Scopes:         make(map[string]ScopeSync),
+		Projects:       make(map[string][]linearapi.Project),
+		WorkflowStates: make(map[string][]linearapi.WorkflowState),
+	}
+}
+
+// OpenIssueStore loads the issue store at path, starting empty if it does not exist.
+// A store written by an incompatible version is discarded rather than treated as an error.
+func OpenIssueStore(path string) (*IssueStore, error) {
+	if path == "" {
+		return nil, fmt.Errorf("issue store path is empty")
+	}
+
+	store := &IssueStore{path: path, data: newIssueStoreData()}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read issue store: %w", err)
+	}
+
+	var loaded issueStoreData
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		return nil, fmt.Errorf("parse issue store: %w", err)
+	}
+	if loaded.Version != issueStoreVersion {
+		return store, nil
+	}
+
+	if loaded.Issues != nil {
+		store.data.Issues = loaded.Issues
+	}
+	if loaded.Scopes != nil {
+		store.data.Scopes = loaded.Scopes
+	}
+	if loaded.Projects != nil {
+		store.data.Projects = loaded.Projects
+	}
+	if loaded.WorkflowStates != nil {
+		store.data.WorkflowStates = loaded.WorkflowStates
+	}
+	store.data.CurrentUser = loaded.CurrentUser
+	store.data.Teams = loaded.Teams
+
+	return store, nil
+}
+
+// Save writes the store to disk if anything changed since the last save.
+func (s *IssueStore) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.dirty {
+		return nil
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create issue store directory: %w", err)
+	}
+
+	data, err := json.Marshal(s.data)
+	if err != nil {
+		return fmt.Errorf("marshal issue store: %w", err)
+	}
+
+	// Write to a temp file and rename so a crash never leaves a truncated store.
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("write issue store: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("replace issue store: %w", err)
+	}
+
+	s.dirty = false
+	return nil
+}
+
+// UpsertIssues stores issues, keeping previously fetched comments when the
+// incoming copy was fetched without them (list queries do not include comments).
+func (s *IssueStore) UpsertIssues(issues []linearapi.Issue) {
+	if len(issues) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, issue := range issues {
+		s.upsertLocked(issue)
+	}
+	s.dirty = true
+}
+
+// UpsertIssue stores a single issue.
+func (s *IssueStore) UpsertIssue(issue linearapi.Issue) {
+	s.UpsertIssues([]linearapi.Issue{issue})
+}
+
+// upsertLocked stores an issue; callers must hold the write lock.
+func (s *IssueStore) upsertLocked(issue linearapi.Issue) {
+	if issue.ID == "" {
+		return
+	}
+	existing, ok := s.data.Issues[issue.ID]
+	if ok && issue.Comments == nil {
+		issue.Comments = existing.Comments
+	}
+	s.data.Issues[issue.ID] = issue
+}
+
+// RemoveIssue deletes an issue from the store.
+func (s *IssueStore) RemoveIssue(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.data.Issues[id]; !ok {
+		return
+	}
+	delete(s.data.Issues, id)
+	s.dirty = true
+}
+
+// Issue returns a stored issue by ID.
+func (s *IssueStore) Issue(id string) (linearapi.Issue, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	issue, ok := s.data.Issues[id]
+	return issue, ok
+}
+
+// ScopeIssues returns stored, non-archived issues in a scope ordered like the API
+// would return them for orderBy ("createdAt", otherwise updatedAt, newest first).
+func (s *IssueStore) ScopeIssues(scope Scope, orderBy string) []linearapi.Issue {
+	s.mu.RLock()
+	issues := make([]linearapi.Issue, 0)
+	for _, issue := range s.data.Issues {
+		if issue.Archived || !scope.Matches(issue) {
+			continue
+		}
+		issues = append(issues, issue)
+	}
+	s.mu.RUnlock()
+
+	sortIssues(issues, orderBy)
+	return issues
+}
+
+// SearchIssues does a local, case-insensitive match of every search term against
+// identifier, title and description. It is the offline fallback for full-text search.
+func (s *IssueStore) SearchIssues(scope Scope, search string, orderBy string) []linearapi.Issue {
+	terms := strings.Fields(strings.ToLower(search))
+	if len(terms) == 0 {
+		return s.ScopeIssues(scope, orderBy)
+	}
+
+	matches := make([]linearapi.Issue, 0)
+	for _, issue := range s.ScopeIssues(scope, orderBy) {
+		haystack := strings.ToLower(issue.Identifier + "\n" + issue.Title + "\n" + issue.Description)
+		matched := true
+		for _, term := range terms {
+			if !strings.Contains(haystack, term) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			matches = append(matches, issue)
+		}
+	}
+	return matches
+}
+
+// ScopeSyncState returns the sync progress recorded for a scope.
+func (s *IssueStore) ScopeSyncState(scope Scope) (ScopeSync, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	state, ok := s.data.Scopes[scope.Key()]
+	return state, ok
+}
+
+// IncrementalCursor returns the updatedAt cursor to use for an incremental sync
+// of the scope, or false when the scope needs a full sync instead.
+func (s *IssueStore) IncrementalCursor(scope Scope, now time.Time) (time.Time, bool) {
+	state, ok := s.ScopeSyncState(scope)
+	if !ok || state.Cursor.IsZero() || state.FullSyncedAt.IsZero() {
+		return time.Time{}, false
+	}
+	if now.Sub(state.FullSyncedAt) > FullSyncInterval {
+		return time.Time{}, false
+	}
+	return state.Cursor, true
+}
+
+// CompleteFullSync records a complete fetch of a scope. Stored issues in the scope
+// that were not returned are removed, since they were archived, deleted or moved.
+func (s *IssueStore) CompleteFullSync(scope Scope, fetched []linearapi.Issue, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]bool, len(fetched))
+	for _, issue := range fetched {
+		s.upsertLocked(issue)
+		seen[issue.ID] = true
+	}
+	for id, issue := range s.data.Issues {
+		if scope.Matches(issue) && !seen[id] {
+			delete(s.data.Issues, id)
+		}
+	}
+
+	state := s.data.Scopes[scope.Key()]
+	state.Cursor = newestUpdatedAt(fetched, time.Time{})
+	state.FullSyncedAt = now
+	s.data.Scopes[scope.Key()] = state
+	s.dirty = true
+}
+
+// CompleteIncrementalSync records an updatedAt delta for a scope. The delta may include
+// issues outside the scope, such as ones that moved to another state; they are stored
+// as they are now, so they leave the scope. Archived issues are removed.
+func (s *IssueStore) CompleteIncrementalSync(scope Scope, changed []linearapi.Issue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, issue := range changed {
+		if issue.Archived {
+			delete(s.data.Issues, issue.ID)
+			continue
+		}
+		s.upsertLocked(issue)
+	}
+
+	state := s.data.Scopes[scope.Key()]
+	state.Cursor = newestUpdatedAt(changed, state.Cursor)
+	s.data.Scopes[scope.Key()] = state
+	s.dirty = true
+}
+
+// CurrentUser returns the stored authenticated user.
+func (s *IssueStore) CurrentUser() (linearapi.User, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.data.CurrentUser == nil {
+		return linearapi.User{}, false
+	}
+	return *s.data.CurrentUser, true
+}
+
+// SetCurrentUser stores the authenticated user.
+func (s *IssueStore) SetCurrentUser(user linearapi.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data.CurrentUser = &user
+	s.dirty = true
+}
+
+// Teams returns the stored teams.
+func (s *IssueStore) Teams() []linearapi.Team {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]linearapi.Team(nil), s.data.Teams...)
+}
+
+// SetTeams stores the teams list.
+func (s *IssueStore) SetTeams(teams []linearapi.Team) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data.Teams = append([]linearapi.Team(nil), teams...)
+	s.dirty = true
+}
+
+// Projects returns the stored projects for a team.
+func (s *IssueStore) Projects(teamID string) []linearapi.Project {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]linearapi.Project(nil), s.data.Projects[teamID]...)
+}
+
+// SetProjects stores the projects for a team.
+func (s *IssueStore) SetProjects(teamID string, projects []linearapi.Project) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data.Projects[teamID] = append([]linearapi.Project(nil), projects...)
+	s.dirty = true
+}
+
+// WorkflowStates returns the stored workflow states for a team.
+func (s *IssueStore) WorkflowStates(teamID string) []linearapi.WorkflowState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]linearapi.WorkflowState(nil), s.data.WorkflowStates[teamID]...)
+}
+
+// SetWorkflowStates stores the workflow states for a team.
+func (s *IssueStore) SetWorkflowStates(teamID string, states []linearapi.WorkflowState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data.WorkflowStates[teamID] = append([]linearapi.WorkflowState(nil), states...)
+	s.dirty = true
+}
+
+// newestUpdatedAt returns the latest UpdatedAt among issues, or fallback if none is newer.
+func newestUpdatedAt(issues []linearapi.Issue, fallback time.Time) time.Time {
+	newest := fallback
+	for _, issue := range issues {
+		if issue.UpdatedAt.After(newest) {
+			newest = issue.UpdatedAt
+		}
+	}
+	return newest
+}
+
+// sortIssues orders issues newest first by createdAt or updatedAt, breaking ties by ID
+// so results are deterministic regardless of map iteration order.
+func sortIssues(issues []linearapi.Issue, orderBy string) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		ti, tj := issues[i].UpdatedAt, issues[j].UpdatedAt
+		if orderBy == string(linearapi.OrderByCreatedAt) {
+			ti, tj = issues[i].CreatedAt, issues[j].CreatedAt
+		}
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return issues[i].ID < issues[j].ID
+	})
+}
@@ -0,0 +1,230 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestOpenIssueStore_MissingFile verifies a missing store starts empty.
+func TestOpenIssueStore_MissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.json")
+
+	st, err := OpenIssueStore(path)
+	if err != nil {
+		t.Fatalf("OpenIssueStore() error = %v", err)
+	}
+	if issues := st.ScopeIssues(Scope{}, ""); len(issues) != 0 {
+		t.Fatalf("ScopeIssues() len = %d, want 0", len(issues))
+	}
+	if _, ok := st.IncrementalCursor(Scope{}, time.Now()); ok {
+		t.Fatal("IncrementalCursor() ok = true, want false for a new store")
+	}
+}
+
+// TestIssueStore_SaveAndReload verifies issues, comments and metadata survive a round trip.
+func TestIssueStore_SaveAndReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "issues.json")
+	st, err := OpenIssueStore(path)
+	if err != nil {
+		t.Fatalf("OpenIssueStore() error = %v", err)
+	}
+
+	updated := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	st.UpsertIssue(linearapi.Issue{
+		ID:         "issue-1",
+		Identifier: "ABC-1",
+		Title:      "First",
+		TeamID:     "team-1",
+		UpdatedAt:  updated,
+		Comments:   []linearapi.Comment{{ID: "comment-1", Body: "hello"}},
+	})
+	st.SetTeams([]linearapi.Team{{ID: "team-1", Key: "ABC", Name: "Alpha"}})
+	st.SetCurrentUser(linearapi.User{ID: "user-1", Name: "Me", IsMe: true})
+	st.SetWorkflowStates("team-1", []linearapi.WorkflowState{{ID: "state-1", Name: "Todo"}})
+	st.SetProjects("team-1", []linearapi.Project{{ID: "project-1", Name: "Launch"}})
+	if err := st.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	reloaded, err := OpenIssueStore(path)
+	if err != nil {
+		t.Fatalf("OpenIssueStore() reload error = %v", err)
+	}
+	issue, ok := reloaded.Issue("issue-1")
+	if !ok {
+		t.Fatal("Issue() ok = false after reload")
+	}
+	if !issue.UpdatedAt.Equal(updated) || len(issue.Comments) != 1 || issue.Comments[0].Body != "hello" {
+		t.Fatalf("reloaded issue = %#v", issue)
+	}
+	if teams := reloaded.Teams(); len(teams) != 1 || teams[0].Key != "ABC" {
+		t.Fatalf("Teams() = %#v", teams)
+	}
+	if user, ok := reloaded.CurrentUser(); !ok || user.ID != "user-1" {
+		t.Fatalf("CurrentUser() = %#v, %v", user, ok)
+	}
+	if states := reloaded.WorkflowStates("team-1"); len(states) != 1 {
+		t.Fatalf("WorkflowStates() = %#v", states)
+	}
+	if projects := reloaded.Projects("team-1"); len(projects) != 1 {
+		t.Fatalf("Projects() = %#v", projects)
+	}
+}
+
+// TestIssueStore_UpsertKeepsComments verifies list results do not drop stored comments.
+func TestIssueStore_UpsertKeepsComments(t *testing.T) {
+	st, err := OpenIssueStore(filepath.Join(t.TempDir(), "issues.json"))
+	if err != nil {
+		t.Fatalf("OpenIssueStore() error = %v", err)
+	}
+
+	st.UpsertIssue(linearapi.Issue{ID: "issue-1", Comments: []linearapi.Comment{{ID: "comment-1"}}})
+	st.UpsertIssue(linearapi.Issue{ID: "issue-1", Title: "Renamed"})
+
+	issue, _ := st.Issue("issue-1")
+	if issue.Title != "Renamed" || len(issue.Comments) != 1 {
+		t.Fatalf("Issue() = %#v, want renamed issue with comments", issue)
+	}
+}
+
+// TestIssueStore_ScopeIssues verifies scope filtering and ordering.
+func TestIssueStore_ScopeIssues(t *testing.T) {
+	st, err := OpenIssueStore(filepath.Join(t.TempDir(), "issues.json"))
+	if err != nil {
+		t.Fatalf("OpenIssueStore() error = %v", err)
+	}
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	st.UpsertIssues([]linearapi.Issue{
+		{ID: "a", TeamID: "team-1", StateID: "todo", UpdatedAt: base, CreatedAt: base.Add(2 * time.Hour)},
+		{ID: "b", TeamID: "team-1", StateID: "done", UpdatedAt: base.Add(time.Hour), CreatedAt: base},
+		{ID: "c", TeamID: "team-2", StateID: "todo", UpdatedAt: base.Add(2 * time.Hour)},
+		{ID: "d", TeamID: "team-1", StateID: "todo", Archived: true},
+	})
+
+	got := st.ScopeIssues(Scope{TeamID: "team-1"}, "")
+	if len(got) != 2 || got[0].ID != "b" || got[1].ID != "a" {
+		t.Fatalf("ScopeIssues(updatedAt) = %v, want [b a]", issueIDs(got))
+	}
+
+	got = st.ScopeIssues(Scope{TeamID: "team-1"}, "createdAt")
+	if len(got) != 2 || got[0].ID != "a" {
+		t.Fatalf("ScopeIssues(createdAt) = %v, want [a b]", issueIDs(got))
+	}
+
+	got = st.ScopeIssues(Scope{TeamID: "team-1", StateID: "todo"}, "")
+	if len(got) != 1 || got[0].ID != "a" {
+		t.Fatalf("ScopeIssues(state) = %v, want [a]", issueIDs(got))
+	}
+}
+
+// TestIssueStore_SyncCursors verifies full and incremental sync bookkeeping.
+func TestIssueStore_SyncCursors(t *testing.T) {
+	st, err := OpenIssueStore(filepath.Join(t.TempDir(), "issues.json"))
+	if err != nil {
+		t.Fatalf("OpenIssueStore() error = %v", err)
+	}
+
+	scope := Scope{TeamID: "team-1"}
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	st.UpsertIssues([]linearapi.Issue{
+		{ID: "stale", TeamID: "team-1", UpdatedAt: base},
+		{ID: "other-team", TeamID: "team-2", UpdatedAt: base},
+	})
+	st.CompleteFullSync(scope, []linearapi.Issue{
+		{ID: "a", TeamID: "team-1", UpdatedAt: base.Add(time.Hour)},
+		{ID: "b", TeamID: "team-1", UpdatedAt: base.Add(2 * time.Hour)},
+	}, now)
+
+	if _, ok := st.Issue("stale"); ok {
+		t.Fatal("full sync should prune issues missing from the scope")
+	}
+	if _, ok := st.Issue("other-team"); !ok {
+		t.Fatal("full sync should not prune issues outside the scope")
+	}
+
+	cursor, ok := st.IncrementalCursor(scope, now.Add(time.Minute))
+	if !ok || !cursor.Equal(base.Add(2*time.Hour)) {
+		t.Fatalf("IncrementalCursor() = %v, %v", cursor, ok)
+	}
+
+	st.CompleteIncrementalSync(scope, []linearapi.Issue{
+		{ID: "a", TeamID: "team-1", Title: "changed", UpdatedAt: base.Add(3 * time.Hour)},
+	})
+	cursor, _ = st.IncrementalCursor(scope, now.Add(time.Minute))
+	if !cursor.Equal(base.Add(3 * time.Hour)) {
+		t.Fatalf("IncrementalCursor() after delta = %v", cursor)
+	}
+	if issue, _ := st.Issue("a"); issue.Title != "changed" {
+		t.Fatalf("Issue(a).Title = %q, want changed", issue.Title)
+	}
+
+	if _, ok := st.IncrementalCursor(scope, now.Add(FullSyncInterval+time.Minute)); ok {
+		t.Fatal("IncrementalCursor() should require a full sync after FullSyncInterval")
+	}
+}
+
+// TestIssueStore_IncrementalSyncMovesIssuesOutOfScope verifies a delta re-buckets issues
+// that changed state and drops archived ones.
+func TestIssueStore_IncrementalSyncMovesIssuesOutOfScope(t *testing.T) {
+	st, err := OpenIssueStore(filepath.Join(t.TempDir(), "issues.json"))
+	if err != nil {
+		t.Fatalf("OpenIssueStore() error = %v", err)
+	}
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	todo := Scope{TeamID: "team-1", StateID: "state-todo"}
+	st.CompleteFullSync(todo, []linearapi.Issue{
+		{ID: "a", TeamID: "team-1", StateID: "state-todo", UpdatedAt: base},
+		{ID: "b", TeamID: "team-1", StateID: "state-todo", UpdatedAt: base},
+		{ID: "c", TeamID: "team-1", StateID: "state-todo", UpdatedAt: base},
+	}, time.Now())
+
+	st.CompleteIncrementalSync(todo, []linearapi.Issue{
+		{ID: "a", TeamID: "team-1", StateID: "state-done", UpdatedAt: base.Add(time.Hour)},
+		{ID: "b", TeamID: "team-1", StateID: "state-todo", UpdatedAt: base.Add(time.Hour), Archived: true},
+	})
+
+	if got := st.ScopeIssues(todo, ""); len(got) != 1 || got[0].ID != "c" {
+		t.Fatalf("ScopeIssues(todo) = %+v, want only c", got)
+	}
+	if got := st.ScopeIssues(Scope{TeamID: "team-1", StateID: "state-done"}, ""); len(got) != 1 || got[0].ID != "a" {
+		t.Fatalf("ScopeIssues(done) = %+v, want a", got)
+	}
+	if _, ok := st.Issue("b"); ok {
+		t.Fatal("archived issue b is still stored")
+	}
+}
+
+// TestIssueStore_SearchIssues verifies the offline text search fallback.
+func TestIssueStore_SearchIssues(t *testing.T) {
+	st, err := OpenIssueStore(filepath.Join(t.TempDir(), "issues.json"))
+	if err != nil {
+		t.Fatalf("OpenIssueStore() error = %v", err)
+	}
+
+	st.UpsertIssues([]linearapi.Issue{
+		{ID: "a", Identifier: "ABC-1", Title: "Fix login bug"},
+		{ID: "b", Identifier: "ABC-2", Title: "Login page", Description: "redesign"},
+	})
+
+	if got := st.SearchIssues(Scope{}, "login BUG", ""); len(got) != 1 || got[0].ID != "a" {
+		t.Fatalf("SearchIssues(login bug) = %v, want [a]", issueIDs(got))
+	}
+	if got := st.SearchIssues(Scope{}, "abc-2", ""); len(got) != 1 || got[0].ID != "b" {
+		t.Fatalf("SearchIssues(abc-2) = %v, want [b]", issueIDs(got))
+	}
+}
+
+// issueIDs returns the IDs of issues for readable assertions.
+func issueIDs(issues []linearapi.Issue) []string {
+	ids := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		ids = append(ids, issue.ID)
+	}
+	return ids
+}
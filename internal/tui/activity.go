@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// issueHistoryCacheEntry is the fetched history of one issue.
+type issueHistoryCacheEntry struct {
+	UpdatedAt time.Time // Issue UpdatedAt when fetched; a newer issue refetches its history
+	Entries   []linearapi.IssueHistoryEntry
+}
+
+// activityItem is one entry of the activity timeline: either history changes or a comment.
+type activityItem struct {
+	At      time.Time
+	Actor   string
+	Changes []string
+	Comment *linearapi.Comment
+}
+
+// activityTimeline interleaves an issue's history and comments chronologically, oldest first.
+// The issue's creation is the first item.
+func activityTimeline(issue linearapi.Issue, history []linearapi.IssueHistoryEntry) []activityItem {
+	items := make([]activityItem, 0, len(history)+len(issue.Comments)+1)
+	if !issue.CreatedAt.IsZero() {
+		items = append(items, activityItem{At: issue.CreatedAt, Actor: issue.Creator, Changes: []string{"created the issue"}})
+	}
+	for _, entry := range history {
+		items = append(items, activityItem{At: entry.CreatedAt, Actor: entry.Actor, Changes: entry.Changes()})
+	}
+	for i := range issue.Comments {
+		comment := issue.Comments[i]
+		items = append(items, activityItem{At: comment.CreatedAt, Actor: commentAuthorName(comment), Comment: &comment})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].At.Before(items[j].At)
+	})
+	return items
+}
+
+// ToggleActivityView switches the bottom of the details pane between comments and the activity timeline.
+func (a *App) ToggleActivityView() {
+	a.detailsActivityMode = !a.detailsActivityMode
+	logger.Debug("tui.activity: toggled activity view enabled=%t", a.detailsActivityMode)
+	a.updateDetailsView()
+	if a.detailsActivityMode && a.detailsCommentsVisible {
+		a.focusedPane = FocusDetails
+		a.focusedDetailsView = true
+	}
+	a.updateFocus()
+}
+
+// detailsBottomTitle returns the title of the bottom details view for its current mode.
+func (a *App) detailsBottomTitle() string {
+	if a.detailsActivityMode {
+		return "Activity"
+	}
+	return "Comments"
+}
+
+// cachedIssueHistory returns the fetched history of issue, and false when it is missing or stale.
+func (a *App) cachedIssueHistory(issue *linearapi.Issue) ([]linearapi.IssueHistoryEntry, bool) {
+	cached, ok := a.issueHistory[issue.ID]
+	if !ok || cached.UpdatedAt.Before(issue.UpdatedAt) {
+		return nil, false
+	}
+	return cached.Entries, true
+}
+
+// loadIssueHistory fetches the history of issue in the background and redraws the
+// activity view when it arrives. Only one fetch runs per issue at a time.
+func (a *App) loadIssueHistory(issue *linearapi.Issue) {
+	if a.fetchingHistoryID == issue.ID {
+		return
+	}
+	a.fetchingHistoryID = issue.ID
+	issueID, updatedAt := issue.ID, issue.UpdatedAt
+
+	go func() {
+		entries, err := a.fetchIssueHistory(context.Background(), issueID)
+		a.QueueUpdateDraw(func() {
+			if a.fetchingHistoryID == issueID {
+				a.fetchingHistoryID = ""
+			}
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.activity: failed to load history issue_id=%s", issueID)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			logger.Debug("tui.activity: loaded history issue_id=%s entries=%d", issueID, len(entries))
+			a.issueHistory[issueID] = issueHistoryCacheEntry{UpdatedAt: updatedAt, Entries: entries}
+			if selected := a.GetSelectedIssue(); a.detailsActivityMode && selected != nil && selected.ID == issueID {
+				a.updateDetailsView()
+			}
+		})
+	}()
+}
+
+// renderActivity writes the activity timeline of issue, fetching its history when needed.
+func (a *App) renderActivity(writer io.Writer, issue *linearapi.Issue) {
+	keyColor := a.themeTags.SecondaryText
+	accentColor := a.themeTags.Accent
+
+	history, ok := a.cachedIssueHistory(issue)
+	if !ok {
+		a.loadIssueHistory(issue)
+	}
+	items := activityTimeline(*issue, history)
+
+	_, _ = fmt.Fprintf(writer, "%sActivity:[-] (%d)\n", keyColor, len(items))
+	if !ok {
+		_, _ = fmt.Fprintf(writer, "%sLoading history...[-]\n", keyColor)
+	}
+
+	for _, item := range items {
+		actor := item.Actor
+		if actor == "" {
+			actor = "Linear"
+		}
+		timeStr := item.At.Local().Format("Jan 2, 2006 3:04 PM")
+
+		if item.Comment != nil {
+			_, _ = fmt.Fprintf(writer, "\n%s%s[-] commented %s%s[-]\n\n", accentColor, actor, keyColor, timeStr)
+			_, _ = fmt.Fprint(writer, indentLines(strings.TrimRight(renderMarkdown(item.Comment.Body), "\n"), commentIndent))
+			_, _ = fmt.Fprint(writer, "\n")
+			continue
+		}
+		for _, change := range item.Changes {
+			_, _ = fmt.Fprintf(writer, "\n%s%s[-] %s %s%s[-]", accentColor, actor, change, keyColor, timeStr)
+		}
+		_, _ = fmt.Fprint(writer, "\n")
+	}
+}
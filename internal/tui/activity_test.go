@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestActivityTimeline_InterleavesHistoryAndComments verifies items are ordered oldest first.
+func TestActivityTimeline_InterleavesHistoryAndComments(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	issue := linearapi.Issue{
+		CreatedAt: base,
+		Creator:   "Ada",
+		Comments: []linearapi.Comment{
+			{ID: "c-1", Body: "On it", CreatedAt: base.Add(2 * time.Hour), Author: linearapi.User{Name: "Grace"}},
+		},
+	}
+	history := []linearapi.IssueHistoryEntry{
+		{ID: "h-2", CreatedAt: base.Add(3 * time.Hour), Actor: "Grace", FromState: "In Progress", ToState: "Done"},
+		{ID: "h-1", CreatedAt: base.Add(time.Hour), Actor: "Ada", AssigneeSet: true, ToAssignee: "Grace"},
+	}
+
+	var got []string
+	for _, item := range activityTimeline(issue, history) {
+		if item.Comment != nil {
+			got = append(got, item.Actor+": "+item.Comment.Body)
+			continue
+		}
+		got = append(got, item.Actor+" "+strings.Join(item.Changes, ", "))
+	}
+	want := []string{
+		"Ada created the issue",
+		"Ada assigned to Grace",
+		"Grace: On it",
+		"Grace changed status from In Progress to Done",
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("activityTimeline() = %q, want %q", got, want)
+	}
+}
+
+// TestToggleActivityView_FetchesHistoryOnce verifies the toggle swaps the bottom view and
+// that history is cached until the issue changes.
+func TestToggleActivityView_FetchesHistoryOnce(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+	app.queueUpdateDraw = func(f func()) { f() }
+	var fetches atomic.Int32
+	app.fetchIssueHistory = func(ctx context.Context, issueID string) ([]linearapi.IssueHistoryEntry, error) {
+		fetches.Add(1)
+		return []linearapi.IssueHistoryEntry{
+			{ID: "h-1", CreatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), Actor: "Ada", AddedLabels: []string{"bug"}},
+		}, nil
+	}
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	issue := &linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", UpdatedAt: base}
+	app.selectedIssue = issue
+
+	onUI(app, func() {
+		app.ToggleActivityView()
+		if !app.detailsCommentsVisible {
+			t.Fatal("activity view hidden for an issue without comments")
+		}
+	})
+	// Wait for the history-loaded callback, which redraws the activity view
+	waitForCondition(t, time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return strings.Contains(app.detailsCommentsView.GetText(true), "Ada added label bug")
+	})
+
+	onUI(app, func() {
+		if title := app.detailsCommentsView.GetTitle(); !strings.Contains(title, "Activity") {
+			t.Fatalf("title = %q, want Activity", title)
+		}
+		app.updateDetailsView()
+		if got := fetches.Load(); got != 1 {
+			t.Fatalf("fetches = %d, want cached history", got)
+		}
+		issue.UpdatedAt = base.Add(time.Hour)
+		app.updateDetailsView()
+	})
+	waitForCondition(t, time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return app.issueHistory[issue.ID].UpdatedAt.Equal(issue.UpdatedAt)
+	})
+	if got := fetches.Load(); got != 2 {
+		t.Fatalf("fetches = %d, want a refetch after the issue changed", got)
+	}
+
+	onUI(app, func() {
+		app.ToggleActivityView()
+		if app.detailsCommentsVisible {
+			t.Fatal("comments view still shown for an issue without comments")
+		}
+	})
+}
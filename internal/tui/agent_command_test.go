@@ -2,19 +2,22 @@ package tui
 
 import (
 	"context"
+	"os/exec"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
+	"github.com/roeyazroel/linear-tui/internal/agents"
 	"github.com/roeyazroel/linear-tui/internal/config"
 	"github.com/roeyazroel/linear-tui/internal/linearapi"
 )
 
-// TestAskAgentCommand_SetsPendingExec verifies the command flow sets pendingExec
-// with the correct binary, args, and dir, then stops the TUI.
-func TestAskAgentCommand_SetsPendingExec(t *testing.T) {
+// newAgentTestApp returns an app with a selected issue, immediate UI updates guarded by mu,
+// and a provider built from a fake-resolved command template.
+func newAgentTestApp(t *testing.T, mu *sync.Mutex) *App {
+	t.Helper()
 	cfg := config.Config{
 		PageSize: 1,
 		CacheTTL: time.Minute,
@@ -23,17 +26,13 @@ func TestAskAgentCommand_SetsPendingExec(t *testing.T) {
 		},
 	}
 	app := NewApp(&linearapi.Client{}, cfg, nil)
-
-	// Use a mutex to synchronize access to pages and other shared state
-	var pagesMu sync.Mutex
-	var stopped bool
 	app.queueUpdateDraw = func(f func()) {
-		pagesMu.Lock()
+		mu.Lock()
 		f()
-		pagesMu.Unlock()
+		mu.Unlock()
 	}
 
-	selectedIssue := linearapi.Issue{ID: "issue-1", Title: "Test"}
+	selectedIssue := linearapi.Issue{ID: "issue-1", Identifier: "ENG-1", Title: "Test"}
 	app.issuesMu.Lock()
 	app.selectedIssue = &selectedIssue
 	app.issuesMu.Unlock()
@@ -41,6 +40,7 @@ func TestAskAgentCommand_SetsPendingExec(t *testing.T) {
 	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
 		return linearapi.Issue{
 			ID:          id,
+			Identifier:  "ENG-1",
 			Title:       "Test",
 			Description: "Desc",
 			Comments: []linearapi.Comment{
@@ -48,75 +48,328 @@ func TestAskAgentCommand_SetsPendingExec(t *testing.T) {
 			},
 		}, nil
 	}
-
-	workspaceDir := t.TempDir()
-
-	app.parseCommand = func(commandTemplate, fullPrompt, branchName string) (string, []string, error) {
-		return "/usr/bin/test-agent", []string{"/usr/bin/test-agent", "--flag", fullPrompt}, nil
+	app.newAgentProvider = func(commandTemplate string, data agents.TemplateData) (agents.Provider, error) {
+		return agents.NewCommandProvider(commandTemplate, data, func(name string) (string, error) {
+			return "/usr/bin/" + name, nil
+		})
+	}
+	// No team metadata, so the default start hook resolves to nothing and skips the API
+	app.loadLifecycleTeamData = func(ctx context.Context, action *config.AgentLifecycleAction, teamID string) (agentLifecycleTeamData, error) {
+		return agentLifecycleTeamData{}, nil
 	}
+	return app
+}
 
+// submitAgentPrompt runs the ask_agent command and submits the prompt modal.
+func submitAgentPrompt(t *testing.T, app *App, mu *sync.Mutex, workspace string) {
+	t.Helper()
 	command := findCommandByID(DefaultCommands(app), "ask_agent")
 	if command == nil {
 		t.Fatal("ask_agent command not found")
 	}
 
-	pagesMu.Lock()
+	mu.Lock()
+	defer mu.Unlock()
 	command.Run(app)
-	hasPrompt := app.pages.HasPage("agent_prompt")
-	pagesMu.Unlock()
-	if !hasPrompt {
+	if !app.pages.HasPage("agent_prompt") {
 		t.Fatal("expected agent prompt modal to be visible")
 	}
-
-	pagesMu.Lock()
 	app.agentPromptModal.promptField.SetText("Summarize", true)
-	app.agentPromptModal.workspaceField.SetText(workspaceDir)
-	// Select the first command in the dropdown
+	app.agentPromptModal.workspaceField.SetText(workspace)
 	if app.agentPromptModal.commandField != nil {
 		app.agentPromptModal.commandField.SetCurrentOption(0)
 	}
 	app.agentPromptModal.HandleKey(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModCtrl))
-	pagesMu.Unlock()
+}
+
+// TestAskAgentCommand_StreamsIntoOutputPane verifies the agent runs in-app with the
+// configured command and its events are rendered into the output pane.
+func TestAskAgentCommand_StreamsIntoOutputPane(t *testing.T) {
+	var mu sync.Mutex
+	app := newAgentTestApp(t, &mu)
+	app.config.AgentCommands[0].Command = "test-agent --flag --issue={{.Identifier}} {prompt}"
+	app.agentPromptModal = NewAgentPromptModal(app)
+	workspaceDir := t.TempDir()
+
+	var gotBinary, gotWorkspace string
+	var gotArgs []string
+	app.runAgent = func(ctx context.Context, p agents.Provider, prompt, issueContext string, options agents.AgentRunOptions, onEvent func(agents.AgentEvent), onLine func(string), onErr func(error)) error {
+		gotBinary, _ = p.ResolveBinary()
+		gotArgs = p.BuildArgs(prompt, issueContext, options)
+		gotWorkspace = options.Workspace
+		onEvent(agents.AgentEvent{Type: agents.AgentEventAssistant, Text: "Looking at the issue"})
+		onEvent(agents.AgentEvent{Type: agents.AgentEventToolCall, Tool: &agents.AgentToolCall{Name: "read", Path: "main.go"}})
+		onLine("raw output")
+		onEvent(agents.AgentEvent{Type: agents.AgentEventResult, Subtype: "success"})
+		return nil
+	}
+
+	submitAgentPrompt(t, app, &mu, workspaceDir)
 
 	waitForCondition(t, time.Second, func() bool {
-		pagesMu.Lock()
-		defer pagesMu.Unlock()
-		return app.pendingExec != nil
+		mu.Lock()
+		defer mu.Unlock()
+		return strings.Contains(app.agentOutputView.GetText(true), "Finished")
 	})
 
-	pagesMu.Lock()
-	pending := app.pendingExec
-	stopped = pending != nil // app.Stop() was called via QueueUpdateDraw
-	pagesMu.Unlock()
+	mu.Lock()
+	output := app.agentOutputView.GetText(true)
+	visible := app.agentOutputVisible
+	mu.Unlock()
+
+	if _, active := app.agentJobs.ActiveForIssue("issue-1"); active {
+		t.Fatal("expected no active job after completion")
+	}
+	if !visible {
+		t.Fatal("expected agent output pane to be visible")
+	}
+	for _, want := range []string{"Looking at the issue", "Tool call: read (main.go)", "raw output"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in output: %s", want, output)
+		}
+	}
+
+	if gotBinary != "/usr/bin/test-agent" {
+		t.Fatalf("binary = %q, want %q", gotBinary, "/usr/bin/test-agent")
+	}
+	if gotWorkspace != workspaceDir {
+		t.Fatalf("workspace = %q, want %q", gotWorkspace, workspaceDir)
+	}
+	joined := strings.Join(gotArgs, " ")
+	if !strings.Contains(joined, "--flag --issue=ENG-1") || !strings.Contains(joined, "Issue Context") {
+		t.Fatalf("expected rendered template flags and issue context in args: %s", joined)
+	}
+}
+
+// TestCancelAgentCommand_CancelsRun verifies the cancel command stops the run via its context.
+func TestCancelAgentCommand_CancelsRun(t *testing.T) {
+	var mu sync.Mutex
+	app := newAgentTestApp(t, &mu)
 
-	if !stopped || pending == nil {
-		t.Fatal("expected pendingExec to be set")
+	started := make(chan struct{})
+	app.runAgent = func(ctx context.Context, p agents.Provider, prompt, issueContext string, options agents.AgentRunOptions, onEvent func(agents.AgentEvent), onLine func(string), onErr func(error)) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
 	}
 
-	if pending.Binary != "/usr/bin/test-agent" {
-		t.Fatalf("binary = %q, want %q", pending.Binary, "/usr/bin/test-agent")
+	submitAgentPrompt(t, app, &mu, t.TempDir())
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("agent run did not start")
+	}
+	if _, active := app.agentJobs.ActiveForIssue("issue-1"); !active {
+		t.Fatal("expected an active job")
 	}
 
-	if pending.Dir != workspaceDir {
-		t.Fatalf("dir = %q, want %q", pending.Dir, workspaceDir)
+	cancelCmd := findCommandByID(DefaultCommands(app), "cancel_agent")
+	if cancelCmd == nil {
+		t.Fatal("cancel_agent command not found")
 	}
+	mu.Lock()
+	cancelCmd.Run(app)
+	mu.Unlock()
 
-	// Args[0] should be the binary name
-	if len(pending.Args) == 0 || pending.Args[0] != "/usr/bin/test-agent" {
-		t.Fatalf("args[0] = %q, want %q", pending.Args[0], "/usr/bin/test-agent")
+	waitForCondition(t, time.Second, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return strings.Contains(app.agentOutputView.GetText(true), "Cancelled")
+	})
+	if jobs := app.agentJobs.Jobs(); len(jobs) != 1 || jobs[0].State != agents.JobCancelled {
+		t.Fatalf("jobs = %+v, want one cancelled job", jobs)
 	}
+}
 
-	joined := strings.Join(pending.Args, " ")
+// TestAgentJobs_ConcurrencyLimitAndBadges verifies jobs over the limit queue and
+// both states are marked in the issues table.
+func TestAgentJobs_ConcurrencyLimitAndBadges(t *testing.T) {
+	var mu sync.Mutex
+	app := newAgentTestApp(t, &mu)
+	app.agentJobs.SetLimit(1)
 
-	// Should contain the --flag from the command template
-	if !strings.Contains(joined, "--flag") {
-		t.Fatalf("expected --flag in args: %s", joined)
+	release := make(chan struct{})
+	app.runAgent = func(ctx context.Context, p agents.Provider, prompt, issueContext string, options agents.AgentRunOptions, onEvent func(agents.AgentEvent), onLine func(string), onErr func(error)) error {
+		onEvent(agents.AgentEvent{Type: agents.AgentEventSystem, SessionID: "s-" + prompt, ResumeCommand: "claude --resume s-" + prompt})
+		select {
+		case <-release:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
 
-	// Should contain issue context (the prompt includes it)
-	if !strings.Contains(joined, "Issue Context") {
-		t.Fatalf("expected issue context in args: %s", joined)
+	provider, _ := app.newAgentProvider("claude {prompt}", agents.TemplateData{})
+	for _, issue := range []linearapi.Issue{{ID: "issue-1", Identifier: "ENG-1"}, {ID: "issue-2", Identifier: "ENG-2"}} {
+		if err := app.startAgentRun(issue, "claude {prompt}", provider, issue.Identifier, "", ""); err != nil {
+			t.Fatalf("startAgentRun(%s) error: %v", issue.Identifier, err)
+		}
+	}
+	if err := app.startAgentRun(linearapi.Issue{ID: "issue-1", Identifier: "ENG-1"}, "claude {prompt}", provider, "again", "", ""); err == nil {
+		t.Fatal("expected error starting a second job on the same issue")
 	}
+
+	waitForCondition(t, time.Second, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return app.agentIssueStates["issue-1"] == agents.JobRunning && app.agentIssueStates["issue-2"] == agents.JobQueued
+	})
+	mu.Lock()
+	queuedBadge := app.agentBadge("issue-2")
+	status := app.agentStatusText()
+	mu.Unlock()
+	if !strings.Contains(queuedBadge, Icons.AgentQueued) {
+		t.Fatalf("agentBadge(queued) = %q", queuedBadge)
+	}
+	if !strings.Contains(status, "1 running, 1 queued") {
+		t.Fatalf("agentStatusText() = %q", status)
+	}
+	waitForCondition(t, time.Second, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return app.agentSpinnerFrame != "" && strings.Contains(app.agentBadge("issue-1"), app.agentSpinnerFrame)
+	})
+
+	close(release)
+	waitForCondition(t, time.Second, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(app.agentIssueStates) == 0
+	})
+	for _, job := range app.agentJobs.Jobs() {
+		if job.State != agents.JobSucceeded || job.ResumeCommand == "" {
+			t.Fatalf("job = %+v, want succeeded with resume command", job)
+		}
+	}
+	if label := agentJobLabel(app.agentJobs.Jobs()[0], time.Now()); !strings.Contains(label, "ENG-2") || !strings.Contains(label, "succeeded") {
+		t.Fatalf("agentJobLabel() = %q", label)
+	}
+}
+
+// TestAgentHistory_ReopensTranscript verifies a finished run is persisted and its
+// transcript can be replayed into the output pane.
+func TestAgentHistory_ReopensTranscript(t *testing.T) {
+	var mu sync.Mutex
+	app := newAgentTestApp(t, &mu)
+	history, err := agents.OpenHistory(t.TempDir())
+	if err != nil {
+		t.Fatalf("OpenHistory() error: %v", err)
+	}
+	app.SetAgentHistory(history)
+
+	app.runAgent = func(ctx context.Context, p agents.Provider, prompt, issueContext string, options agents.AgentRunOptions, onEvent func(agents.AgentEvent), onLine func(string), onErr func(error)) error {
+		onEvent(agents.AgentEvent{Type: agents.AgentEventSystem, SessionID: "s-1", ResumeCommand: "test-agent --resume s-1"})
+		onEvent(agents.AgentEvent{Type: agents.AgentEventAssistant, Text: "Looking at the issue"})
+		onLine("raw output")
+		onEvent(agents.AgentEvent{Type: agents.AgentEventResult, Subtype: "success"})
+		return nil
+	}
+	submitAgentPrompt(t, app, &mu, t.TempDir())
+
+	var record agents.RunRecord
+	waitForCondition(t, time.Second, func() bool {
+		runs, err := history.Runs("issue-1")
+		if err != nil || len(runs) != 1 || runs[0].Status != agents.JobSucceeded {
+			return false
+		}
+		record = runs[0]
+		return true
+	})
+	if record.Prompt != "Summarize" || record.Command != "test-agent --flag {prompt}" || record.ResumeCommand != "test-agent --resume s-1" {
+		t.Fatalf("unexpected record: %+v", record)
+	}
+
+	historyCmd := findCommandByID(DefaultCommands(app), "agent_history")
+	if historyCmd == nil {
+		t.Fatal("agent_history command not found")
+	}
+	mu.Lock()
+	historyCmd.Run(app)
+	pickerActive := app.pickerActive
+	app.showAgentJobOutput("")
+	mu.Unlock()
+	if !pickerActive {
+		t.Fatal("expected agent history picker to be shown")
+	}
+
+	app.openAgentRunTranscript(record)
+
+	mu.Lock()
+	output := app.agentOutputView.GetText(true)
+	title := app.agentOutputView.GetTitle()
+	mu.Unlock()
+	for _, want := range []string{"Resume: test-agent --resume s-1", "Looking at the issue", "raw output", "Finished in"} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in replayed transcript: %s", want, output)
+		}
+	}
+	if !strings.Contains(title, "ENG-1") || !strings.Contains(title, "succeeded") {
+		t.Fatalf("title = %q", title)
+	}
+}
+
+// TestAskAgentCommand_RunsInIssueWorktree verifies the agent runs in a worktree of the
+// issue branch and that merged worktrees are cleaned up.
+func TestAskAgentCommand_RunsInIssueWorktree(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	repo := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "init"},
+	} {
+		if out, err := exec.Command("git", append([]string{"-C", repo}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	var mu sync.Mutex
+	app := newAgentTestApp(t, &mu)
+	root := t.TempDir()
+	app.config.AgentWorktrees = true
+	app.config.AgentWorktreeRoot = root
+	app.config.AgentWorkspace = repo
+	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
+		return linearapi.Issue{ID: id, Identifier: "ENG-1", Title: "Test", BranchName: "eng-1-test"}, nil
+	}
+	workspaces := make(chan string, 1)
+	app.runAgent = func(ctx context.Context, p agents.Provider, prompt, issueContext string, options agents.AgentRunOptions, onEvent func(agents.AgentEvent), onLine func(string), onErr func(error)) error {
+		workspaces <- options.Workspace
+		return nil
+	}
+
+	submitAgentPrompt(t, app, &mu, repo)
+
+	var workspace string
+	select {
+	case workspace = <-workspaces:
+	case <-time.After(5 * time.Second):
+		t.Fatal("agent run did not start")
+	}
+	if !strings.HasPrefix(workspace, root) || !strings.HasSuffix(workspace, "eng-1-test") {
+		t.Fatalf("workspace = %q, want a worktree under %q", workspace, root)
+	}
+	waitForCondition(t, time.Second, func() bool {
+		_, active := app.agentJobs.ActiveForIssue("issue-1")
+		return !active
+	})
+
+	_, worktrees, err := app.listAgentWorktrees(context.Background())
+	if err != nil {
+		t.Fatalf("listAgentWorktrees() error: %v", err)
+	}
+	// The branch has no commits of its own, so it counts as merged
+	if len(worktrees) != 1 || worktrees[0].Branch != "eng-1-test" || !worktrees[0].Stale {
+		t.Fatalf("listAgentWorktrees() = %+v", worktrees)
+	}
+
+	app.cleanupAgentWorktrees()
+	waitForCondition(t, 5*time.Second, func() bool {
+		_, worktrees, err := app.listAgentWorktrees(context.Background())
+		return err == nil && len(worktrees) == 0
+	})
 }
 
 // TestDefaultCommands_GatesAskAgent verifies command gating by AgentCommands.
@@ -0,0 +1,150 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// agentHistoryTranscriptPrefix keys replayed history transcripts apart from live job IDs.
+const agentHistoryTranscriptPrefix = "history:"
+
+// ShowAgentHistory lists past agent runs for the selected issue, or all runs when none is selected.
+func (a *App) ShowAgentHistory() {
+	if a.agentHistory == nil {
+		a.updateStatusBarWithError(fmt.Errorf("agent history is unavailable"))
+		return
+	}
+
+	issueID, title := "", "Agent History"
+	if issue := a.GetSelectedIssue(); issue != nil {
+		issueID = issue.ID
+		title = "Agent History · " + issue.Identifier
+	}
+	records, err := a.agentHistory.Runs(issueID)
+	if err != nil {
+		logger.ErrorWithErr(err, "tui.agent_history: failed to list runs issue=%s", issueID)
+		a.updateStatusBarWithError(err)
+		return
+	}
+	if len(records) == 0 {
+		a.statusBar.SetText(fmt.Sprintf("%sNo agent runs recorded[-]", a.themeTags.SecondaryText))
+		return
+	}
+
+	items := make([]PickerItem, 0, len(records))
+	for _, record := range records {
+		items = append(items, PickerItem{ID: record.ID, Label: tview.Escape(agentRunLabel(record))})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show(tview.Escape(title), items, func(item PickerItem) {
+		a.pickerActive = false
+		a.showAgentRunActions(item.ID)
+	})
+}
+
+// agentRunLabel formats a history row for the runs list.
+func agentRunLabel(record agents.RunRecord) string {
+	label := fmt.Sprintf("%s · %s · %s · %s", record.StartedAt.Local().Format("2006-01-02 15:04"), record.Identifier, record.Provider, record.Status)
+	if duration := record.Duration(); duration > 0 {
+		label += " · " + duration.Round(time.Second).String()
+	}
+	if record.Error != "" {
+		label += " · " + record.Error
+	}
+	return label
+}
+
+// showAgentRunActions offers transcript and resume actions for a past run.
+func (a *App) showAgentRunActions(id string) {
+	record, err := a.agentHistory.Get(id)
+	if err != nil {
+		logger.ErrorWithErr(err, "tui.agent_history: failed to load run id=%s", id)
+		a.updateStatusBarWithError(err)
+		return
+	}
+
+	items := []PickerItem{{ID: "transcript", Label: "Open transcript"}}
+	if record.Status == agents.JobSucceeded {
+		items = append(items, PickerItem{ID: "summary", Label: "Post summary as comment"})
+	}
+	if record.ResumeCommand != "" {
+		items = append(items, PickerItem{ID: "resume", Label: tview.Escape("Copy resume command: " + record.ResumeCommand)})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show(tview.Escape(fmt.Sprintf("%s · %s", record.Identifier, record.Status)), items, func(item PickerItem) {
+		a.pickerActive = false
+		switch item.ID {
+		case "transcript":
+			go a.openAgentRunTranscript(record)
+		case "summary":
+			go func() {
+				// The summary comes from the replayed transcript
+				if a.openAgentRunTranscript(record) {
+					a.QueueUpdateDraw(func() {
+						a.postAgentSummary(record.IssueID, record.Identifier, agentHistoryTranscriptPrefix+record.ID, record.Provider, record.Duration())
+					})
+				}
+			}()
+		case "resume":
+			if err := copyToClipboard(record.ResumeCommand); err != nil {
+				a.updateStatusBarWithError(err)
+				return
+			}
+			a.statusBar.SetText(fmt.Sprintf("%sCopied resume command for %s[-]", a.themeTags.Accent, record.Identifier))
+		}
+	})
+}
+
+// openAgentRunTranscript replays a past run's events into the output pane and reports whether it loaded.
+func (a *App) openAgentRunTranscript(record agents.RunRecord) bool {
+	events, err := a.agentHistory.Events(record.ID)
+	if err != nil {
+		logger.ErrorWithErr(err, "tui.agent_history: failed to read transcript id=%s", record.ID)
+		a.QueueUpdateDraw(func() {
+			a.updateStatusBarWithError(err)
+		})
+		return false
+	}
+
+	// Replay through a fresh buffer so the transcript renders exactly as it streamed
+	transcript := &agentTranscript{
+		buffer: NewAgentStreamBuffer(),
+		title:  fmt.Sprintf(" Agent · %s · %s · %s · %s ", record.Identifier, record.Provider, record.Status, record.StartedAt.Local().Format("2006-01-02 15:04")),
+	}
+	transcript.lines = append(transcript.lines, StreamLine{Kind: StreamLineResult, Text: fmt.Sprintf("Ran %s on %s", record.Provider, record.Identifier)})
+	if record.Workspace != "" {
+		transcript.lines = append(transcript.lines, StreamLine{Kind: StreamLineSystem, Text: "Workspace: " + record.Workspace})
+	}
+	for _, event := range events {
+		update := transcript.buffer.Append(event)
+		transcript.summary.observe(event, update)
+		transcript.lines = append(transcript.lines, agentEventLines(event, update)...)
+	}
+	job := agents.Job{
+		Identifier: record.Identifier,
+		Provider:   record.Provider,
+		State:      record.Status,
+		StartedAt:  record.StartedAt,
+		FinishedAt: record.FinishedAt,
+		Error:      record.Error,
+	}
+	if !record.Status.Active() {
+		transcript.lines = append(transcript.lines, agentJobStateLine(job, time.Now()))
+	}
+
+	key := agentHistoryTranscriptPrefix + record.ID
+	a.agentRunMu.Lock()
+	a.agentTranscripts[key] = transcript
+	a.agentRunMu.Unlock()
+
+	a.QueueUpdateDraw(func() {
+		a.showAgentJobOutput(key)
+	})
+	return true
+}
@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// ShowAgentJobs lists agent jobs, newest first, with their state and elapsed time.
+func (a *App) ShowAgentJobs() {
+	jobs := a.agentJobs.Jobs()
+	if len(jobs) == 0 {
+		a.statusBar.SetText(fmt.Sprintf("%sNo agent jobs[-]", a.themeTags.SecondaryText))
+		return
+	}
+
+	now := time.Now()
+	items := make([]PickerItem, 0, len(jobs))
+	for _, job := range jobs {
+		items = append(items, PickerItem{ID: job.ID, Label: tview.Escape(agentJobLabel(job, now))})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show("Agent Jobs", items, func(item PickerItem) {
+		a.pickerActive = false
+		a.showAgentJobActions(item.ID)
+	})
+}
+
+// agentJobLabel formats a job row for the jobs list.
+func agentJobLabel(job agents.Job, now time.Time) string {
+	label := fmt.Sprintf("%s · %s · %s · %s", job.Identifier, job.Provider, job.State, job.Elapsed(now).Round(time.Second))
+	if job.SessionID != "" {
+		label += " · session " + job.SessionID
+	}
+	if job.Error != "" {
+		label += " · " + job.Error
+	}
+	return label
+}
+
+// showAgentJobActions offers output, cancel and resume actions for a job.
+func (a *App) showAgentJobActions(id string) {
+	job, ok := a.agentJobs.Get(id)
+	if !ok {
+		return
+	}
+
+	items := []PickerItem{{ID: "output", Label: "Show output"}}
+	if job.State.Active() {
+		items = append(items, PickerItem{ID: "cancel", Label: "Cancel"})
+	}
+	if job.State == agents.JobSucceeded {
+		items = append(items, PickerItem{ID: "summary", Label: "Post summary as comment"})
+	}
+	if job.ResumeCommand != "" {
+		items = append(items, PickerItem{ID: "resume", Label: tview.Escape("Copy resume command: " + job.ResumeCommand)})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show(tview.Escape(fmt.Sprintf("%s · %s", job.Identifier, job.State)), items, func(item PickerItem) {
+		a.pickerActive = false
+		switch item.ID {
+		case "output":
+			a.showAgentJobOutput(job.ID)
+		case "cancel":
+			if err := a.agentJobs.Cancel(job.ID); err != nil {
+				logger.ErrorWithErr(err, "tui.agent_jobs: failed to cancel job id=%s", job.ID)
+				a.updateStatusBarWithError(err)
+			}
+		case "summary":
+			a.postAgentSummary(job.IssueID, job.Identifier, job.ID, job.Provider, job.Elapsed(job.FinishedAt))
+		case "resume":
+			if err := copyToClipboard(job.ResumeCommand); err != nil {
+				a.updateStatusBarWithError(err)
+				return
+			}
+			a.statusBar.SetText(fmt.Sprintf("%sCopied resume command for %s[-]", a.themeTags.Accent, job.Identifier))
+		}
+	})
+}
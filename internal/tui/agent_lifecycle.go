@@ -0,0 +1,288 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/outbox"
+)
+
+// agentLifecycleTeamData is the team metadata lifecycle actions are resolved against.
+type agentLifecycleTeamData struct {
+	States []linearapi.WorkflowState
+	Labels []linearapi.IssueLabel
+	Users  []linearapi.User
+	Me     *linearapi.User
+}
+
+// agentCommandConfig returns the configured agent command matching a command template.
+func (a *App) agentCommandConfig(command string) (config.AgentCommand, bool) {
+	for _, agentCommand := range a.config.AgentCommands {
+		if agentCommand.Command == command {
+			return agentCommand, true
+		}
+	}
+	return config.AgentCommand{}, false
+}
+
+// agentLifecycle returns the lifecycle rules for a command template.
+// Commands no longer in the config get the default rules.
+func (a *App) agentLifecycle(command string) config.AgentLifecycle {
+	agentCommand, _ := a.agentCommandConfig(command)
+	return agentCommand.EffectiveLifecycle()
+}
+
+// resolveAgentLifecycleAction turns an action into an issue update. Parts that cannot
+// be resolved are skipped and reported in the returned error; the rest still apply.
+// It reports false when nothing would change.
+func resolveAgentLifecycleAction(action *config.AgentLifecycleAction, issue linearapi.Issue, team agentLifecycleTeamData) (linearapi.UpdateIssueInput, bool, error) {
+	input := linearapi.UpdateIssueInput{ID: issue.ID}
+	changed := false
+	var errs []error
+
+	if name := strings.TrimSpace(action.State); name != "" {
+		if state, ok := findLifecycleState(team.States, name); !ok {
+			errs = append(errs, fmt.Errorf("no workflow state %q", name))
+		} else if state.ID != issue.StateID {
+			stateID := state.ID
+			input.StateID = &stateID
+			changed = true
+		}
+	}
+
+	if assignee := strings.TrimSpace(action.Assignee); assignee != "" {
+		assigneeID, err := findLifecycleAssignee(team, assignee)
+		if err != nil {
+			errs = append(errs, err)
+		} else if assigneeID != issue.AssigneeID {
+			input.AssigneeID = &assigneeID
+			changed = true
+		}
+	}
+
+	if len(action.AddLabels) > 0 || len(action.RemoveLabels) > 0 {
+		labelIDs := make(map[string]bool, len(issue.Labels))
+		for _, label := range issue.Labels {
+			labelIDs[label.ID] = true
+		}
+		labelsChanged := false
+		for _, name := range action.AddLabels {
+			label, ok := findLifecycleLabel(team.Labels, name)
+			if !ok {
+				errs = append(errs, fmt.Errorf("no label %q", name))
+				continue
+			}
+			if !labelIDs[label.ID] {
+				labelIDs[label.ID] = true
+				labelsChanged = true
+			}
+		}
+		for _, name := range action.RemoveLabels {
+			// Match against the issue's own labels too, in case the team list is stale
+			for _, label := range append(append([]linearapi.IssueLabel(nil), issue.Labels...), team.Labels...) {
+				if strings.EqualFold(label.Name, strings.TrimSpace(name)) && labelIDs[label.ID] {
+					delete(labelIDs, label.ID)
+					labelsChanged = true
+				}
+			}
+		}
+		if labelsChanged {
+			ids := make([]string, 0, len(labelIDs))
+			for id := range labelIDs {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+			input.LabelIDs = &ids
+			changed = true
+		}
+	}
+
+	return input, changed, errors.Join(errs...)
+}
+
+// findLifecycleState matches a state by name, falling back to the first state of that type.
+func findLifecycleState(states []linearapi.WorkflowState, name string) (linearapi.WorkflowState, bool) {
+	for _, state := range states {
+		if strings.EqualFold(state.Name, name) {
+			return state, true
+		}
+	}
+	var match *linearapi.WorkflowState
+	for i := range states {
+		if strings.EqualFold(states[i].Type, name) && (match == nil || states[i].Position < match.Position) {
+			match = &states[i]
+		}
+	}
+	if match == nil {
+		return linearapi.WorkflowState{}, false
+	}
+	return *match, true
+}
+
+// findLifecycleAssignee resolves "me", "none" or a user's name, display name or email to an assignee ID.
+// An empty ID unassigns the issue.
+func findLifecycleAssignee(team agentLifecycleTeamData, assignee string) (string, error) {
+	switch strings.ToLower(assignee) {
+	case "me":
+		if team.Me == nil {
+			return "", fmt.Errorf("current user is unknown")
+		}
+		return team.Me.ID, nil
+	case "none", "unassigned":
+		return "", nil
+	}
+	for _, user := range team.Users {
+		if strings.EqualFold(user.Name, assignee) || strings.EqualFold(user.DisplayName, assignee) || strings.EqualFold(user.Email, assignee) {
+			return user.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no user %q", assignee)
+}
+
+// findLifecycleLabel matches a label by name.
+func findLifecycleLabel(labels []linearapi.IssueLabel, name string) (linearapi.IssueLabel, bool) {
+	for _, label := range labels {
+		if strings.EqualFold(label.Name, strings.TrimSpace(name)) {
+			return label, true
+		}
+	}
+	return linearapi.IssueLabel{}, false
+}
+
+// loadAgentLifecycleTeamData fetches only the team metadata the action refers to.
+func (a *App) loadAgentLifecycleTeamData(ctx context.Context, action *config.AgentLifecycleAction, teamID string) (agentLifecycleTeamData, error) {
+	team := agentLifecycleTeamData{Me: a.GetCurrentUser()}
+	var err error
+	if action.State != "" {
+		if team.States, err = a.cache.GetWorkflowStates(ctx, teamID); err != nil {
+			return team, fmt.Errorf("fetch workflow states: %w", err)
+		}
+	}
+	if len(action.AddLabels) > 0 || len(action.RemoveLabels) > 0 {
+		if team.Labels, err = a.cache.GetIssueLabels(ctx, teamID); err != nil {
+			return team, fmt.Errorf("fetch labels: %w", err)
+		}
+	}
+	switch strings.ToLower(strings.TrimSpace(action.Assignee)) {
+	case "", "me", "none", "unassigned":
+	default:
+		if team.Users, err = a.cache.GetUsers(ctx, teamID); err != nil {
+			return team, fmt.Errorf("fetch users: %w", err)
+		}
+	}
+	return team, nil
+}
+
+// applyAgentLifecycleAction applies a lifecycle action to the issue. Problems are
+// logged and shown in the status bar; they never stop the agent run.
+func (a *App) applyAgentLifecycleAction(ctx context.Context, issue linearapi.Issue, action *config.AgentLifecycleAction, phase string) {
+	if action.IsEmpty() {
+		return
+	}
+
+	loadTeamData := a.loadLifecycleTeamData
+	if loadTeamData == nil {
+		loadTeamData = a.loadAgentLifecycleTeamData
+	}
+	team, err := loadTeamData(ctx, action, issue.TeamID)
+	if err != nil {
+		logger.ErrorWithErr(err, "tui.agent_lifecycle: failed to load team data issue=%s phase=%s", issue.Identifier, phase)
+		a.QueueUpdateDraw(func() {
+			a.updateStatusBarWithError(fmt.Errorf("agent %s hook for %s: %w", phase, issue.Identifier, err))
+		})
+		return
+	}
+
+	input, changed, resolveErr := resolveAgentLifecycleAction(action, issue, team)
+	if resolveErr != nil {
+		logger.Warning("tui.agent_lifecycle: partially resolved hook issue=%s phase=%s error=%v", issue.Identifier, phase, resolveErr)
+	}
+	if !changed {
+		if resolveErr != nil {
+			a.QueueUpdateDraw(func() {
+				a.updateStatusBarWithError(fmt.Errorf("agent %s hook for %s: %w", phase, issue.Identifier, resolveErr))
+			})
+		}
+		return
+	}
+
+	updateIssue := a.updateIssue
+	if updateIssue == nil {
+		updateIssue = a.GetAPI().UpdateIssue
+	}
+	_, err = updateIssue(ctx, input)
+	a.QueueUpdateDraw(func() {
+		if err != nil {
+			if a.queueOfflineMutation(err, outbox.NewUpdateIssue(issue, input)) {
+				return
+			}
+			logger.ErrorWithErr(err, "tui.agent_lifecycle: failed to update issue=%s phase=%s", issue.Identifier, phase)
+			a.updateStatusBarWithError(err)
+			return
+		}
+		logger.Info("tui.agent_lifecycle: applied hook issue=%s phase=%s", issue.Identifier, phase)
+		if resolveErr != nil {
+			a.updateStatusBarWithError(fmt.Errorf("agent %s hook for %s: %w", phase, issue.Identifier, resolveErr))
+		}
+		// Runs finish in the background; don't move selection or focus
+		go a.refreshIssuesWithFocusChange(false)
+	})
+}
+
+// runAgentJobHook applies the start, success or failure hook of the job's command when the
+// job starts running, succeeds or fails. Queued jobs and jobs that never start leave the issue alone.
+func (a *App) runAgentJobHook(job agents.Job) {
+	lifecycle := a.agentLifecycle(job.Command)
+	var action *config.AgentLifecycleAction
+	var phase string
+	switch job.State {
+	case agents.JobRunning:
+		action, phase = lifecycle.OnStart, "start"
+	case agents.JobSucceeded:
+		action, phase = lifecycle.OnSuccess, "success"
+	case agents.JobFailed:
+		action, phase = lifecycle.OnFailure, "failure"
+	default:
+		return
+	}
+	if action.IsEmpty() {
+		return
+	}
+
+	// Hooks run one at a time in the order jobs changed, so a start hook never lands
+	// after the success or failure hook of a run that ended at once
+	a.agentHookMu.Lock()
+	previous := a.agentHookTail
+	done := make(chan struct{})
+	a.agentHookTail = done
+	a.agentHookMu.Unlock()
+
+	go func() {
+		defer close(done)
+		if previous != nil {
+			<-previous
+		}
+		ctx := context.Background()
+		fetchIssue := a.fetchIssueByID
+		if fetchIssue == nil {
+			fetchIssue = a.api.FetchIssueByID
+		}
+		// Fetch fresh labels so the hook adds to what the issue has now
+		issue, err := fetchIssue(ctx, job.IssueID)
+		if err != nil {
+			logger.ErrorWithErr(err, "tui.agent_lifecycle: failed to fetch issue=%s phase=%s", job.Identifier, phase)
+			a.QueueUpdateDraw(func() {
+				a.updateStatusBarWithError(err)
+			})
+			return
+		}
+		a.applyAgentLifecycleAction(ctx, issue, action, phase)
+	}()
+}
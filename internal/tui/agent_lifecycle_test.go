@@ -0,0 +1,232 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// lifecycleTestTeam returns team metadata for lifecycle tests.
+func lifecycleTestTeam() agentLifecycleTeamData {
+	return agentLifecycleTeamData{
+		States: []linearapi.WorkflowState{
+			{ID: "state-todo", Name: "Todo", Type: "unstarted", Position: 1},
+			{ID: "state-review", Name: "In Review", Type: "started", Position: 3},
+			{ID: "state-progress", Name: "In Progress", Type: "started", Position: 2},
+			{ID: "state-done", Name: "Done", Type: "completed", Position: 4},
+		},
+		Labels: []linearapi.IssueLabel{
+			{ID: "label-ai", Name: "ai-assisted"},
+			{ID: "label-agent", Name: "agent-running"},
+			{ID: "label-bug", Name: "Bug"},
+		},
+		Users: []linearapi.User{{ID: "user-2", Name: "Dana", Email: "dana@example.com"}},
+		Me:    &linearapi.User{ID: "user-1", Name: "Me"},
+	}
+}
+
+// TestResolveAgentLifecycleAction verifies states, assignees and labels resolve by name,
+// type and email, and unresolvable parts are reported without blocking the rest.
+func TestResolveAgentLifecycleAction(t *testing.T) {
+	team := lifecycleTestTeam()
+	issue := linearapi.Issue{
+		ID:      "issue-1",
+		StateID: "state-todo",
+		Labels:  []linearapi.IssueLabel{{ID: "label-bug", Name: "Bug"}, {ID: "label-agent", Name: "agent-running"}},
+	}
+
+	// A state type picks the lowest-positioned state of that type
+	input, changed, err := resolveAgentLifecycleAction(&config.AgentLifecycleAction{State: "started", Assignee: "me"}, issue, team)
+	if err != nil || !changed {
+		t.Fatalf("resolve start: changed=%v err=%v", changed, err)
+	}
+	if *input.StateID != "state-progress" || *input.AssigneeID != "user-1" || input.LabelIDs != nil {
+		t.Fatalf("unexpected start input: %+v", input)
+	}
+
+	action := &config.AgentLifecycleAction{
+		State:        "in review",
+		Assignee:     "dana@example.com",
+		AddLabels:    []string{"AI-Assisted", "missing"},
+		RemoveLabels: []string{"agent-running"},
+	}
+	input, changed, err = resolveAgentLifecycleAction(action, issue, team)
+	if !changed {
+		t.Fatal("expected changes on success")
+	}
+	if err == nil || !strings.Contains(err.Error(), `no label "missing"`) {
+		t.Fatalf("expected missing label error, got %v", err)
+	}
+	if *input.StateID != "state-review" || *input.AssigneeID != "user-2" {
+		t.Fatalf("unexpected success input: %+v", input)
+	}
+	if got := strings.Join(*input.LabelIDs, ","); got != "label-ai,label-bug" {
+		t.Fatalf("LabelIDs = %s, want label-ai,label-bug", got)
+	}
+
+	// Nothing to do when the issue already matches
+	issue.StateID = "state-review"
+	if _, changed, err := resolveAgentLifecycleAction(&config.AgentLifecycleAction{State: "In Review"}, issue, team); changed || err != nil {
+		t.Fatalf("expected no change: changed=%v err=%v", changed, err)
+	}
+	if _, _, err := resolveAgentLifecycleAction(&config.AgentLifecycleAction{Assignee: "me"}, issue, agentLifecycleTeamData{}); err == nil {
+		t.Fatal("expected error assigning to an unknown current user")
+	}
+}
+
+// TestAgentLifecycle_AppliesSuccessHook verifies the command's success hook updates the issue
+// and that commands without lifecycle rules fall back to the default start hook.
+func TestAgentLifecycle_AppliesSuccessHook(t *testing.T) {
+	var mu sync.Mutex
+	app := newAgentTestApp(t, &mu)
+	app.config.AgentCommands = []config.AgentCommand{{
+		Name:    "Test Agent",
+		Command: "test-agent --flag {prompt}",
+		Lifecycle: &config.AgentLifecycle{
+			OnSuccess: &config.AgentLifecycleAction{State: "In Review", AddLabels: []string{"ai-assisted"}},
+		},
+	}}
+	app.loadLifecycleTeamData = func(ctx context.Context, action *config.AgentLifecycleAction, teamID string) (agentLifecycleTeamData, error) {
+		return lifecycleTestTeam(), nil
+	}
+	refreshed := make(chan struct{}, 1)
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		select {
+		case refreshed <- struct{}{}:
+		default:
+		}
+		return linearapi.IssuePage{}, nil
+	}
+	updates := make(chan linearapi.UpdateIssueInput, 2)
+	app.updateIssue = func(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+		updates <- input
+		return linearapi.Issue{ID: input.ID}, nil
+	}
+	app.runAgent = func(ctx context.Context, p agents.Provider, prompt, issueContext string, options agents.AgentRunOptions, onEvent func(agents.AgentEvent), onLine func(string), onErr func(error)) error {
+		return nil
+	}
+
+	submitAgentPrompt(t, app, &mu, t.TempDir())
+
+	// The start hook is not configured, so the only update is the success hook
+	select {
+	case input := <-updates:
+		if input.StateID == nil || *input.StateID != "state-review" || input.LabelIDs == nil || strings.Join(*input.LabelIDs, ",") != "label-ai" {
+			t.Fatalf("unexpected success update: %+v", input)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("success hook did not update the issue")
+	}
+	select {
+	case input := <-updates:
+		t.Fatalf("unexpected extra update: %+v", input)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// The hook refreshes the issue list in the background; let it settle
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("expected an issues refresh after the hook")
+	}
+	waitForCondition(t, time.Second, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return !app.isLoading
+	})
+
+	if lifecycle := (config.AgentCommand{Command: "other"}).EffectiveLifecycle(); lifecycle.OnStart == nil || lifecycle.OnStart.State != "started" || lifecycle.OnStart.Assignee != "me" {
+		t.Fatalf("default lifecycle = %+v", lifecycle)
+	}
+}
+
+// TestAgentLifecycle_StartHookRunsWhenJobRuns verifies the start hook waits for the job to run,
+// is skipped when the run cannot start, and lands before the hook of a run that ends at once.
+func TestAgentLifecycle_StartHookRunsWhenJobRuns(t *testing.T) {
+	var mu sync.Mutex
+	app := newAgentTestApp(t, &mu)
+	app.config.AgentCommands = []config.AgentCommand{{
+		Name:    "Test Agent",
+		Command: "test-agent --flag {prompt}",
+		Lifecycle: &config.AgentLifecycle{
+			OnStart:   &config.AgentLifecycleAction{State: "In Progress"},
+			OnSuccess: &config.AgentLifecycleAction{State: "In Review"},
+		},
+	}}
+	app.loadLifecycleTeamData = func(ctx context.Context, action *config.AgentLifecycleAction, teamID string) (agentLifecycleTeamData, error) {
+		return lifecycleTestTeam(), nil
+	}
+	refreshed := make(chan struct{}, 2)
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		refreshed <- struct{}{}
+		return linearapi.IssuePage{}, nil
+	}
+	settled := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return !app.isLoading
+	}
+	updates := make(chan linearapi.UpdateIssueInput, 2)
+	app.updateIssue = func(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+		updates <- input
+		switch *input.StateID {
+		case "state-progress":
+			// Slow the start hook so an unordered success hook would overtake it
+			time.Sleep(20 * time.Millisecond)
+		case "state-review":
+			// Let the start hook's refresh finish so the two refreshes don't overlap
+			<-refreshed
+			for !settled() {
+				time.Sleep(time.Millisecond)
+			}
+		}
+		return linearapi.Issue{ID: input.ID}, nil
+	}
+	app.runAgent = func(ctx context.Context, p agents.Provider, prompt, issueContext string, options agents.AgentRunOptions, onEvent func(agents.AgentEvent), onLine func(string), onErr func(error)) error {
+		return nil
+	}
+	provider := app.newAgentProvider
+	app.newAgentProvider = func(commandTemplate string, data agents.TemplateData) (agents.Provider, error) {
+		return nil, errors.New("agent binary not found")
+	}
+
+	submitAgentPrompt(t, app, &mu, t.TempDir())
+	waitForCondition(t, time.Second, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return strings.Contains(app.statusBar.GetText(true), "agent binary not found")
+	})
+	select {
+	case input := <-updates:
+		t.Fatalf("start hook ran for a run that never started: %+v", input)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	mu.Lock()
+	app.newAgentProvider = provider
+	mu.Unlock()
+	submitAgentPrompt(t, app, &mu, t.TempDir())
+	for _, want := range []string{"state-progress", "state-review"} {
+		select {
+		case input := <-updates:
+			if *input.StateID != want {
+				t.Fatalf("update state = %s, want %s", *input.StateID, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no update to %s", want)
+		}
+	}
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("expected an issues refresh after the success hook")
+	}
+	waitForCondition(t, time.Second, settled)
+}
@@ -23,14 +23,15 @@ type AgentPromptModal struct {
 	templatePrompts     []string
 	promptField         *tview.TextArea
 	workspaceField      *tview.InputField
-	onSubmit            func(prompt string, workspace string, command string)
+	worktreeField       *tview.Checkbox
+	onSubmit            func(prompt string, workspace string, command string, useWorktree bool)
 }
 
 const (
 	agentPromptLabel    = "Prompt (issue context included)"
 	minPromptModalWidth = 80
 	maxPromptModalWidth = 140
-	promptModalHeight   = 22
+	promptModalHeight   = 24
 )
 
 // NewAgentPromptModal creates a new agent prompt modal.
@@ -73,6 +74,10 @@ func NewAgentPromptModal(app *App) *AgentPromptModal {
 		SetFieldWidth(0)
 	am.form.AddFormItem(am.workspaceField)
 
+	am.worktreeField = tview.NewCheckbox().
+		SetLabel("Git worktree for issue branch")
+	am.form.AddFormItem(am.worktreeField)
+
 	if len(app.agentPromptTemplates) > 0 {
 		labels := make([]string, 0, len(app.agentPromptTemplates))
 		prompts := make([]string, 0, len(app.agentPromptTemplates))
@@ -116,7 +121,7 @@ func NewAgentPromptModal(app *App) *AgentPromptModal {
 	headerView.SetBackgroundColor(app.theme.HeaderBg)
 
 	helpView := tview.NewTextView()
-	helpView.SetText("Esc: cancel • Ctrl+Enter / Cmd+Enter: run • Template fills prompt • Workspace blank uses CWD • Includes title, description, comments")
+	helpView.SetText("Esc: cancel • Ctrl+Enter / Cmd+Enter: run • Template fills prompt • Workspace blank uses CWD • Worktree runs in a checkout of the issue branch • Includes title, description, comments")
 	helpView.SetTextColor(app.theme.SecondaryText)
 	helpView.SetBackgroundColor(app.theme.HeaderBg)
 	helpView.SetTextAlign(tview.AlignCenter)
@@ -142,7 +147,7 @@ func NewAgentPromptModal(app *App) *AgentPromptModal {
 }
 
 // Show displays the prompt modal.
-func (am *AgentPromptModal) Show(onSubmit func(prompt string, workspace string, command string)) {
+func (am *AgentPromptModal) Show(onSubmit func(prompt string, workspace string, command string, useWorktree bool)) {
 	am.onSubmit = onSubmit
 	defaultPrompt := ""
 	if am.templateField != nil && len(am.templatePrompts) > 0 {
@@ -161,6 +166,9 @@ func (am *AgentPromptModal) Show(onSubmit func(prompt string, workspace string,
 		}
 		am.workspaceField.SetText(defaultWorkspace)
 	}
+	if am.worktreeField != nil {
+		am.worktreeField.SetChecked(am.app.config.AgentWorktrees)
+	}
 	if am.commandField != nil {
 		am.commandField.SetCurrentOption(am.lastSelectedCommand)
 	}
@@ -219,9 +227,11 @@ func (am *AgentPromptModal) submitPrompt() {
 		}
 	}
 
+	useWorktree := am.worktreeField != nil && am.worktreeField.IsChecked()
+
 	am.Hide()
 	if am.onSubmit != nil {
-		am.onSubmit(prompt, workspace, command)
+		am.onSubmit(prompt, workspace, command, useWorktree)
 	}
 }
 
@@ -1,12 +1,16 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/agents"
 	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
 )
 
 // AgentPromptTemplatesModal manages editing of agent prompt templates.
@@ -18,13 +22,17 @@ type AgentPromptTemplatesModal struct {
 	nameField     *tview.InputField
 	promptField   *tview.TextArea
 	helpView      *tview.TextView
+	previewView   *tview.TextView
+	previewData   *agents.TemplateData // Selected issue the preview renders against (nil if none)
+	previewIssue  string               // ID of the issue previewData was built from
 	templates     []config.AgentPromptTemplate
 	selectedIndex int
 	onSave        func([]config.AgentPromptTemplate) error
 }
 
 const (
-	promptTemplatesModalHeight = 24
+	promptTemplatesModalHeight = 32
+	promptTemplatesFormHeight  = 15
 	promptTemplatesModalWidth  = 110
 )
 
@@ -70,6 +78,9 @@ func NewAgentPromptTemplatesModal(app *App) *AgentPromptTemplatesModal {
 	if item := pm.form.GetFormItemByLabel("Prompt"); item != nil {
 		if textArea, ok := item.(*tview.TextArea); ok {
 			pm.promptField = textArea
+			pm.promptField.SetChangedFunc(func() {
+				pm.updatePreview()
+			})
 		}
 	}
 
@@ -92,14 +103,29 @@ func NewAgentPromptTemplatesModal(app *App) *AgentPromptTemplatesModal {
 	titleView.SetBackgroundColor(app.theme.HeaderBg)
 
 	pm.helpView = tview.NewTextView()
-	pm.helpView.SetText("a: add | d: delete | Ctrl+S: save | Esc: cancel")
+	pm.helpView.SetText("a: add | d: delete | Ctrl+S: save | Esc: cancel | {{.Title}}, {{if .Parent}}…{{end}}, {{range .Labels}}…{{end}}")
 	pm.helpView.SetTextColor(app.theme.SecondaryText)
 	pm.helpView.SetBackgroundColor(app.theme.HeaderBg)
 	pm.helpView.SetTextAlign(tview.AlignCenter)
 
+	pm.previewView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(true)
+	pm.previewView.SetTextColor(app.theme.Foreground)
+	pm.previewView.SetBackgroundColor(app.theme.HeaderBg)
+	pm.previewView.SetBorder(true).
+		SetBorderColor(app.theme.Border).
+		SetTitle(" Preview ").
+		SetTitleColor(app.theme.SecondaryText)
+
+	editor := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(pm.form, promptTemplatesFormHeight, 0, false).
+		AddItem(pm.previewView, 0, 1, false)
+
 	body := tview.NewFlex().
 		AddItem(pm.list, 0, 1, true).
-		AddItem(pm.form, 0, 2, false)
+		AddItem(editor, 0, 2, false)
 
 	modalContent := tview.NewFlex().
 		SetDirection(tview.FlexRow).
@@ -133,6 +159,7 @@ func (pm *AgentPromptTemplatesModal) Show(templates []config.AgentPromptTemplate
 	pm.templates = append([]config.AgentPromptTemplate(nil), templates...)
 	pm.onSave = onSave
 	pm.selectedIndex = -1
+	pm.setPreviewIssue(pm.app.GetSelectedIssue())
 
 	pm.refreshList()
 	if len(pm.templates) > 0 {
@@ -220,6 +247,7 @@ func (pm *AgentPromptTemplatesModal) selectTemplate(index int) {
 	if pm.promptField != nil {
 		pm.promptField.SetText(template.Prompt, true)
 	}
+	pm.updatePreview()
 }
 
 func (pm *AgentPromptTemplatesModal) applyFieldsToSelected() {
@@ -302,6 +330,9 @@ func (pm *AgentPromptTemplatesModal) validateTemplates() ([]config.AgentPromptTe
 		if name == "" || prompt == "" {
 			return nil, fmt.Errorf("template %d must include a name and prompt", i+1)
 		}
+		if err := agents.ValidateTemplate(prompt); err != nil {
+			return nil, fmt.Errorf("template %q: %w", name, err)
+		}
 		valid = append(valid, config.AgentPromptTemplate{
 			Name:   name,
 			Prompt: prompt,
@@ -311,6 +342,69 @@ func (pm *AgentPromptTemplatesModal) validateTemplates() ([]config.AgentPromptTe
 	return valid, nil
 }
 
+// setPreviewIssue renders previews against the issue, loading its comments and
+// project in the background. A nil issue previews against no data.
+func (pm *AgentPromptTemplatesModal) setPreviewIssue(issue *linearapi.Issue) {
+	if issue == nil {
+		pm.previewData = nil
+		pm.previewIssue = ""
+		return
+	}
+	data := agents.NewTemplateData(*issue, "")
+	pm.previewData = &data
+	pm.previewIssue = issue.ID
+
+	issueID := issue.ID
+	go func() {
+		ctx := context.Background()
+		fetchIssue := pm.app.fetchIssueByID
+		if fetchIssue == nil {
+			fetchIssue = pm.app.api.FetchIssueByID
+		}
+		fullIssue, err := fetchIssue(ctx, issueID)
+		if err != nil {
+			logger.Warning("tui.agent_prompt_templates_modal: failed to load preview issue=%s error=%v", issueID, err)
+			return
+		}
+		data := pm.app.agentTemplateData(ctx, fullIssue)
+		pm.app.QueueUpdateDraw(func() {
+			// Ignore results for an issue the modal no longer previews
+			if pm.previewIssue != issueID {
+				return
+			}
+			pm.previewData = &data
+			pm.updatePreview()
+		})
+	}()
+}
+
+// updatePreview renders the prompt being edited, or shows why it is invalid.
+func (pm *AgentPromptTemplatesModal) updatePreview() {
+	if pm.previewView == nil || pm.promptField == nil {
+		return
+	}
+	prompt := pm.promptField.GetText()
+	if err := agents.ValidateTemplate(prompt); err != nil {
+		pm.previewView.SetTitle(" Preview ")
+		pm.previewView.SetText(pm.app.themeTags.Error + tview.Escape(err.Error()) + "[-]")
+		return
+	}
+	if pm.previewData == nil {
+		pm.previewView.SetTitle(" Preview ")
+		pm.previewView.SetText(pm.app.themeTags.SecondaryText + "Select an issue to preview this template[-]")
+		return
+	}
+
+	pm.previewView.SetTitle(fmt.Sprintf(" Preview · %s ", tview.Escape(pm.previewData.Identifier)))
+	rendered, err := agents.RenderTemplate(prompt, *pm.previewData)
+	if err != nil {
+		pm.previewView.SetText(pm.app.themeTags.Error + tview.Escape(err.Error()) + "[-]")
+		return
+	}
+	pm.previewView.SetText(tview.Escape(rendered))
+	pm.previewView.ScrollToBeginning()
+}
+
 func (pm *AgentPromptTemplatesModal) nextTemplateName() string {
 	base := "New template"
 	if !pm.templateNameExists(base) {
@@ -0,0 +1,56 @@
+package tui
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+)
+
+// TestAgentPromptTemplatesModal_PreviewsAndValidates verifies the preview renders against the
+// selected issue's fetched comments and that invalid templates are not saved.
+func TestAgentPromptTemplatesModal_PreviewsAndValidates(t *testing.T) {
+	var mu sync.Mutex
+	app := newAgentTestApp(t, &mu)
+	modal := NewAgentPromptTemplatesModal(app)
+
+	saved := false
+	mu.Lock()
+	modal.Show([]config.AgentPromptTemplate{{
+		Name:   "Review",
+		Prompt: "Review {{.Identifier}}{{range .Comments}} ({{.Body}}){{end}}",
+	}}, func([]config.AgentPromptTemplate) error {
+		saved = true
+		return nil
+	})
+	mu.Unlock()
+
+	// The preview re-renders once the full issue with comments is loaded
+	waitForCondition(t, time.Second, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return strings.Contains(modal.previewView.GetText(true), "Review ENG-1 (Comment)")
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	modal.promptField.SetText("Review {{.Parent.Title}}", true)
+	if text := modal.previewView.GetText(true); !strings.Contains(text, "nil pointer") {
+		t.Fatalf("expected preview to show the template error, got %q", text)
+	}
+	modal.saveTemplates()
+	if saved {
+		t.Fatal("expected invalid template not to be saved")
+	}
+
+	modal.promptField.SetText("Review {{if .Parent}}{{.Parent.Title}}{{else}}{{.Title}}{{end}}", true)
+	if text := modal.previewView.GetText(true); text != "Review Test" {
+		t.Fatalf("preview = %q, want %q", text, "Review Test")
+	}
+	modal.saveTemplates()
+	if !saved {
+		t.Fatal("expected valid template to be saved")
+	}
+}
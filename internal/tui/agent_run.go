@@ -0,0 +1,395 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+const (
+	// agentOutputHeight is the height of the agent output pane when shown.
+	agentOutputHeight = 12
+	// agentSpinnerInterval is how often running agent spinners animate.
+	agentSpinnerInterval = 150 * time.Millisecond
+)
+
+// agentTranscript holds the rendered stream of a single job.
+type agentTranscript struct {
+	buffer  *AgentStreamBuffer
+	lines   []StreamLine
+	title   string // Pane title for transcripts replayed from history
+	summary agentRunSummary
+}
+
+// SetAgentHistory attaches the store that persists agent runs and their transcripts.
+func (a *App) SetAgentHistory(history *agents.History) {
+	a.agentHistory = history
+}
+
+// newAgentJobManager creates the job manager and routes its notifications into the UI.
+// The run function is resolved per job so tests can override runAgent after NewApp.
+func (a *App) newAgentJobManager(limit int) *agents.JobManager {
+	run := func(ctx context.Context, p agents.Provider, prompt, issueContext string, options agents.AgentRunOptions, onEvent func(agents.AgentEvent), onLine func(string), onErr func(error)) error {
+		runAgent := a.runAgent
+		if runAgent == nil {
+			runAgent = agents.NewRunner().Run
+		}
+		return runAgent(ctx, p, prompt, issueContext, options, onEvent, onLine, onErr)
+	}
+	manager := agents.NewJobManager(run, limit)
+	manager.SetHandlers(agents.JobHandlers{
+		OnChange: a.onAgentJobChange,
+		OnEvent:  a.onAgentJobEvent,
+		OnLine: func(job agents.Job, line string) {
+			a.recordAgentEvent(job, agents.AgentEvent{Type: agents.AgentEventUnknown, Text: line})
+			a.appendAgentTranscript(job.ID, []StreamLine{{Kind: StreamLineUnknown, Text: line}})
+		},
+	})
+	return manager
+}
+
+// buildAgentOutputView creates the agent output pane shown below the main content.
+func (a *App) buildAgentOutputView() *tview.TextView {
+	view := tview.NewTextView()
+	view.SetDynamicColors(true).
+		SetWrap(true).
+		SetWordWrap(true).
+		SetScrollable(true).
+		SetBorder(true).
+		SetTitle(" Agent ").
+		SetTitleColor(a.theme.Foreground).
+		SetBorderColor(a.theme.Border).
+		SetBackgroundColor(tcell.ColorDefault)
+	padding := a.density.DetailsPadding
+	view.SetBorderPadding(padding.Top, padding.Bottom, padding.Left, padding.Right)
+	return view
+}
+
+// setAgentOutputVisible shows or hides the agent output pane.
+func (a *App) setAgentOutputVisible(visible bool) {
+	if a.mainLayout == nil || a.agentOutputView == nil {
+		return
+	}
+	a.agentOutputVisible = visible
+	height := 0
+	if visible {
+		height = agentOutputHeight
+	}
+	a.mainLayout.ResizeItem(a.agentOutputView, height, 0)
+}
+
+// toggleAgentOutput flips agent output pane visibility.
+func (a *App) toggleAgentOutput() {
+	a.setAgentOutputVisible(!a.agentOutputVisible)
+}
+
+// startAgentRun queues an agent job for the issue and focuses its output.
+func (a *App) startAgentRun(issue linearapi.Issue, command string, provider agents.Provider, prompt, issueContext, workspace string) error {
+	job, err := a.agentJobs.Submit(agents.JobSpec{
+		IssueID:      issue.ID,
+		Identifier:   issue.Identifier,
+		Command:      command,
+		Provider:     provider,
+		Prompt:       prompt,
+		IssueContext: issueContext,
+		Options:      agents.AgentRunOptions{Workspace: workspace},
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("tui.agent_run: agent job submitted id=%s issue=%s provider=%s workspace=%s", job.ID, job.Identifier, job.Provider, workspace)
+	a.QueueUpdateDraw(func() {
+		a.showAgentJobOutput(job.ID)
+	})
+	return nil
+}
+
+// transcriptLocked returns the transcript for a job, creating it if needed. Callers must hold agentRunMu.
+func (a *App) transcriptLocked(jobID string) *agentTranscript {
+	transcript, ok := a.agentTranscripts[jobID]
+	if !ok {
+		transcript = &agentTranscript{buffer: NewAgentStreamBuffer()}
+		a.agentTranscripts[jobID] = transcript
+	}
+	return transcript
+}
+
+// appendAgentTranscript records lines for a job and refreshes the pane if it shows that job.
+func (a *App) appendAgentTranscript(jobID string, lines []StreamLine) {
+	if len(lines) == 0 {
+		return
+	}
+	a.agentRunMu.Lock()
+	transcript := a.transcriptLocked(jobID)
+	transcript.lines = append(transcript.lines, lines...)
+	a.agentRunMu.Unlock()
+	a.QueueUpdateDraw(a.syncAgentOutput)
+}
+
+// onAgentJobEvent converts a job's stream event into transcript lines.
+func (a *App) onAgentJobEvent(job agents.Job, event agents.AgentEvent) {
+	a.recordAgentEvent(job, event)
+	a.agentRunMu.Lock()
+	transcript := a.transcriptLocked(job.ID)
+	update := transcript.buffer.Append(event)
+	transcript.summary.observe(event, update)
+	a.agentRunMu.Unlock()
+	a.appendAgentTranscript(job.ID, agentEventLines(event, update))
+}
+
+// onAgentJobChange records a state transition and refreshes spinners, tables and status.
+func (a *App) onAgentJobChange(job agents.Job) {
+	if a.agentHistory != nil {
+		if err := a.agentHistory.RecordJob(job); err != nil {
+			logger.Warning("tui.agent_run: failed to record job id=%s error=%v", job.ID, err)
+		}
+	}
+	a.appendAgentTranscript(job.ID, []StreamLine{agentJobStateLine(job, time.Now())})
+	switch job.State {
+	case agents.JobRunning:
+		a.ensureAgentSpinner()
+		a.runAgentJobHook(job)
+	case agents.JobSucceeded, agents.JobFailed:
+		a.runAgentJobHook(job)
+	}
+	a.QueueUpdateDraw(func() {
+		a.agentIssueStates = a.agentJobs.ActiveStates()
+		a.redrawIssuesTables()
+		a.updateAgentOutputTitle()
+		a.updateStatusBar()
+		if job.State == agents.JobSucceeded && a.agentCommandPostsSummary(job.Command) {
+			a.postAgentSummary(job.IssueID, job.Identifier, job.ID, job.Provider, job.Elapsed(job.FinishedAt))
+		}
+	})
+}
+
+// recordAgentEvent appends an event to the job's persisted transcript.
+func (a *App) recordAgentEvent(job agents.Job, event agents.AgentEvent) {
+	if a.agentHistory == nil {
+		return
+	}
+	if err := a.agentHistory.AppendEvent(job, event); err != nil {
+		logger.Warning("tui.agent_run: failed to record event id=%s error=%v", job.ID, err)
+	}
+}
+
+// agentJobStateLine describes a job state transition for its transcript.
+func agentJobStateLine(job agents.Job, now time.Time) StreamLine {
+	elapsed := job.Elapsed(now).Round(time.Second)
+	var text string
+	switch job.State {
+	case agents.JobQueued:
+		text = fmt.Sprintf("Queued %s on %s (waiting for a free slot)", job.Provider, job.Identifier)
+	case agents.JobRunning:
+		text = fmt.Sprintf("Running %s on %s", job.Provider, job.Identifier)
+	case agents.JobSucceeded:
+		text = fmt.Sprintf("Finished in %s", elapsed)
+	case agents.JobFailed:
+		text = fmt.Sprintf("Failed after %s: %s", elapsed, job.Error)
+	case agents.JobCancelled:
+		text = fmt.Sprintf("Cancelled after %s", elapsed)
+	}
+	return StreamLine{Kind: StreamLineResult, Text: text}
+}
+
+// ensureAgentSpinner starts the spinner loop if it is not already running.
+func (a *App) ensureAgentSpinner() {
+	if !a.agentSpinnerLoop.CompareAndSwap(false, true) {
+		return
+	}
+	a.agentSpinner.Start()
+	go a.runAgentSpinner()
+}
+
+// runAgentSpinner animates running jobs in the issues table and pane title until none are running.
+func (a *App) runAgentSpinner() {
+	ticker := time.NewTicker(agentSpinnerInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if !a.hasRunningAgentJobs() {
+			a.agentSpinner.Stop()
+			a.agentSpinnerLoop.Store(false)
+			// A job may have started between the check and the reset
+			if a.hasRunningAgentJobs() {
+				a.ensureAgentSpinner()
+			}
+			return
+		}
+		a.QueueUpdateDraw(func() {
+			a.agentSpinnerFrame = a.agentSpinner.NextFrame()
+			a.redrawIssuesTables()
+			a.updateAgentOutputTitle()
+		})
+	}
+}
+
+// hasRunningAgentJobs reports whether any job is running.
+func (a *App) hasRunningAgentJobs() bool {
+	for _, state := range a.agentJobs.ActiveStates() {
+		if state == agents.JobRunning {
+			return true
+		}
+	}
+	return false
+}
+
+// cancelAgentRun cancels the selected issue's active job, or else the job shown in the output pane.
+func (a *App) cancelAgentRun() {
+	var job agents.Job
+	found := false
+	if issue := a.GetSelectedIssue(); issue != nil {
+		job, found = a.agentJobs.ActiveForIssue(issue.ID)
+	}
+	if !found && a.agentOutputJobID != "" {
+		if shown, ok := a.agentJobs.Get(a.agentOutputJobID); ok && shown.State.Active() {
+			job, found = shown, true
+		}
+	}
+	if !found {
+		a.updateStatusBarWithError(fmt.Errorf("no agent is running"))
+		return
+	}
+	if err := a.agentJobs.Cancel(job.ID); err != nil {
+		logger.ErrorWithErr(err, "tui.agent_run: failed to cancel job id=%s", job.ID)
+		a.updateStatusBarWithError(err)
+	}
+}
+
+// showAgentJobOutput switches the output pane to a job's transcript.
+func (a *App) showAgentJobOutput(jobID string) {
+	if a.agentOutputView == nil {
+		return
+	}
+	a.agentOutputJobID = jobID
+	a.agentOutputRendered = 0
+	a.agentOutputView.Clear()
+	a.syncAgentOutput()
+	a.setAgentOutputVisible(true)
+	a.updateAgentOutputTitle()
+}
+
+// syncAgentOutput renders transcript lines of the shown job that are not yet in the pane.
+func (a *App) syncAgentOutput() {
+	if a.agentOutputView == nil || a.agentOutputJobID == "" {
+		return
+	}
+	a.agentRunMu.Lock()
+	var pending []StreamLine
+	if transcript, ok := a.agentTranscripts[a.agentOutputJobID]; ok && a.agentOutputRendered < len(transcript.lines) {
+		pending = append(pending, transcript.lines[a.agentOutputRendered:]...)
+		a.agentOutputRendered = len(transcript.lines)
+	}
+	a.agentRunMu.Unlock()
+	a.appendAgentOutput(pending)
+}
+
+// updateAgentOutputTitle shows the shown job's issue, state and elapsed time in the pane title.
+func (a *App) updateAgentOutputTitle() {
+	if a.agentOutputView == nil {
+		return
+	}
+	job, ok := a.agentJobs.Get(a.agentOutputJobID)
+	if !ok {
+		title := " Agent "
+		a.agentRunMu.Lock()
+		if transcript, found := a.agentTranscripts[a.agentOutputJobID]; found && transcript.title != "" {
+			title = transcript.title
+		}
+		a.agentRunMu.Unlock()
+		a.agentOutputView.SetTitle(title)
+		return
+	}
+	elapsed := job.Elapsed(time.Now()).Round(time.Second)
+	indicator := string(job.State)
+	if job.State == agents.JobRunning && a.agentSpinnerFrame != "" {
+		indicator = a.agentSpinnerFrame
+	}
+	a.agentOutputView.SetTitle(fmt.Sprintf(" Agent · %s · %s · %s · %s ", job.Identifier, job.Provider, indicator, elapsed))
+}
+
+// agentBadge returns the issues table marker for an issue's active agent job.
+func (a *App) agentBadge(issueID string) string {
+	switch a.agentIssueStates[issueID] {
+	case agents.JobRunning:
+		frame := a.agentSpinnerFrame
+		if frame == "" {
+			frame = "-"
+		}
+		return a.themeTags.Accent + tview.Escape(frame) + " [-]"
+	case agents.JobQueued:
+		return a.themeTags.SecondaryText + Icons.AgentQueued + "[-]"
+	default:
+		return ""
+	}
+}
+
+// agentStatusText returns the status bar segment for active agent jobs.
+func (a *App) agentStatusText() string {
+	running, queued := 0, 0
+	for _, state := range a.agentIssueStates {
+		if state == agents.JobRunning {
+			running++
+		} else {
+			queued++
+		}
+	}
+	switch {
+	case running == 0 && queued == 0:
+		return ""
+	case queued == 0:
+		return fmt.Sprintf("%sAgents: %d running[-]", a.themeTags.Accent, running)
+	default:
+		return fmt.Sprintf("%sAgents: %d running, %d queued[-]", a.themeTags.Accent, running, queued)
+	}
+}
+
+// appendAgentOutput writes stream lines to the output pane using theme colors per kind.
+func (a *App) appendAgentOutput(lines []StreamLine) {
+	if a.agentOutputView == nil || len(lines) == 0 {
+		return
+	}
+	var b strings.Builder
+	for _, line := range lines {
+		text := tview.Escape(line.Text)
+		switch line.Kind {
+		case StreamLineThinking:
+			fmt.Fprintf(&b, "%s%s[-]\n", a.themeTags.SecondaryText, text)
+		case StreamLineTool:
+			fmt.Fprintf(&b, "%s%s[-]\n", a.themeTags.Accent, text)
+		case StreamLineSystem, StreamLineResult:
+			fmt.Fprintf(&b, "%s%s[-]\n", a.themeTags.Warning, text)
+		default:
+			fmt.Fprintf(&b, "%s\n", text)
+		}
+	}
+	_, _ = a.agentOutputView.Write([]byte(b.String()))
+	a.agentOutputView.ScrollToEnd()
+}
+
+// agentEventLines converts an event and its buffered update into display lines.
+// Assistant deltas are not shown individually; the full message follows them.
+func agentEventLines(event agents.AgentEvent, update StreamUpdate) []StreamLine {
+	lines := append([]StreamLine(nil), update.Lines...)
+	switch event.Type {
+	case agents.AgentEventSystem:
+		if event.Model != "" {
+			lines = append(lines, StreamLine{Kind: StreamLineSystem, Text: fmt.Sprintf("Session started (%s)", event.Model)})
+		}
+		if event.ResumeCommand != "" {
+			lines = append(lines, StreamLine{Kind: StreamLineSystem, Text: "Resume: " + event.ResumeCommand})
+		}
+	case agents.AgentEventAssistant, agents.AgentEventUnknown:
+		if text := strings.TrimSpace(event.Text); text != "" {
+			lines = append(lines, StreamLine{Kind: StreamLineAssistant, Text: text})
+		}
+	}
+	return lines
+}
@@ -36,6 +36,7 @@ type StreamUpdate struct {
 // AgentStreamBuffer aggregates streaming events into stream lines and final output.
 type AgentStreamBuffer struct {
 	assistant        strings.Builder
+	partial          strings.Builder // Assistant deltas not yet superseded by a full message
 	thinking         strings.Builder
 	thinkingLastChar byte
 	hasThinkingChar  bool
@@ -63,14 +64,14 @@ func (b *AgentStreamBuffer) Append(event agents.AgentEvent) StreamUpdate {
 		b.flushThinkingLine(&update)
 	case agents.AgentEventUser:
 		b.flushThinkingLine(&update)
-	case agents.AgentEventAssistant, agents.AgentEventAssistantDelta:
+	case agents.AgentEventAssistantDelta:
 		b.flushThinkingLine(&update)
-		if event.Text != "" {
-			if b.assistant.Len() > 0 {
-				b.assistant.WriteString("\n")
-			}
-			b.assistant.WriteString(event.Text)
-		}
+		b.partial.WriteString(event.Text)
+	case agents.AgentEventAssistant:
+		b.flushThinkingLine(&update)
+		// The full message replaces any deltas streamed for it
+		b.partial.Reset()
+		b.appendAssistantText(event.Text)
 	case agents.AgentEventToolCall:
 		b.flushThinkingLine(&update)
 		update.Lines = append(update.Lines, StreamLine{
@@ -79,6 +80,8 @@ func (b *AgentStreamBuffer) Append(event agents.AgentEvent) StreamUpdate {
 		})
 	case agents.AgentEventResult:
 		b.flushThinkingLine(&update)
+		b.appendAssistantText(b.partial.String())
+		b.partial.Reset()
 		update.FinalText = strings.TrimSpace(b.assistant.String())
 		update.Done = true
 	default:
@@ -87,6 +90,17 @@ func (b *AgentStreamBuffer) Append(event agents.AgentEvent) StreamUpdate {
 	return update
 }
 
+// appendAssistantText appends a complete assistant message to the final output.
+func (b *AgentStreamBuffer) appendAssistantText(text string) {
+	if text == "" {
+		return
+	}
+	if b.assistant.Len() > 0 {
+		b.assistant.WriteString("\n")
+	}
+	b.assistant.WriteString(text)
+}
+
 // appendThinkingText appends reasoning text while preserving spacing.
 func (b *AgentStreamBuffer) appendThinkingText(text string) {
 	if strings.TrimSpace(text) == "" {
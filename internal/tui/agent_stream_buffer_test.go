@@ -95,3 +95,18 @@ func TestAgentStreamBuffer_AssistantAndResult(t *testing.T) {
 		t.Fatalf("expected no stream lines for result, got %+v", update.Lines)
 	}
 }
+
+// TestAgentStreamBuffer_DeltasReplacedByMessage verifies partial deltas are not duplicated by the full message.
+func TestAgentStreamBuffer_DeltasReplacedByMessage(t *testing.T) {
+	buffer := NewAgentStreamBuffer()
+
+	buffer.Append(agents.AgentEvent{Type: agents.AgentEventAssistantDelta, Text: "Hel"})
+	buffer.Append(agents.AgentEvent{Type: agents.AgentEventAssistantDelta, Text: "lo"})
+	buffer.Append(agents.AgentEvent{Type: agents.AgentEventAssistant, Text: "Hello"})
+	buffer.Append(agents.AgentEvent{Type: agents.AgentEventAssistantDelta, Text: "trailing"})
+	update := buffer.Append(agents.AgentEvent{Type: agents.AgentEventResult})
+
+	if update.FinalText != "Hello\ntrailing" {
+		t.Fatalf("unexpected final text: %q", update.FinalText)
+	}
+}
@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// agentRunSummary collects what an agent run produced, for posting back to its issue.
+type agentRunSummary struct {
+	Model     string
+	ToolCalls int
+	FinalText string
+}
+
+// observe updates the summary from a stream event and its buffered update.
+func (s *agentRunSummary) observe(event agents.AgentEvent, update StreamUpdate) {
+	switch event.Type {
+	case agents.AgentEventSystem:
+		if event.Model != "" {
+			s.Model = event.Model
+		}
+	case agents.AgentEventToolCall:
+		// Providers report a tool call again when it completes; count it once
+		if event.Subtype != "completed" {
+			s.ToolCalls++
+		}
+	}
+	if update.FinalText != "" {
+		s.FinalText = update.FinalText
+	}
+}
+
+// formatAgentSummaryComment renders a run summary as a markdown comment body.
+func formatAgentSummaryComment(provider string, duration time.Duration, summary agentRunSummary) string {
+	var b strings.Builder
+	b.WriteString("**Agent run summary**\n\n")
+	b.WriteString(strings.TrimSpace(summary.FinalText))
+	b.WriteString("\n\n---\n\n")
+
+	details := []string{"Provider: `" + provider + "`"}
+	if summary.Model != "" {
+		details = append(details, "Model: `"+summary.Model+"`")
+	}
+	if duration > 0 {
+		details = append(details, "Duration: "+duration.Round(time.Second).String())
+	}
+	details = append(details, fmt.Sprintf("Tool calls: %d", summary.ToolCalls))
+	b.WriteString("_" + strings.Join(details, " · ") + "_\n")
+	return b.String()
+}
+
+// agentCommandPostsSummary reports whether the configured agent command posts summaries automatically.
+func (a *App) agentCommandPostsSummary(command string) bool {
+	agentCommand, ok := a.agentCommandConfig(command)
+	return ok && agentCommand.PostSummary
+}
+
+// agentTranscriptSummary returns the summary collected for a transcript.
+func (a *App) agentTranscriptSummary(key string) (agentRunSummary, bool) {
+	a.agentRunMu.Lock()
+	defer a.agentRunMu.Unlock()
+	transcript, ok := a.agentTranscripts[key]
+	if !ok {
+		return agentRunSummary{}, false
+	}
+	return transcript.summary, true
+}
+
+// postAgentSummary comments a transcript's final output and run details on the issue.
+// Must be called on the UI goroutine.
+func (a *App) postAgentSummary(issueID, identifier, key, provider string, duration time.Duration) {
+	summary, ok := a.agentTranscriptSummary(key)
+	if !ok || strings.TrimSpace(summary.FinalText) == "" {
+		a.updateStatusBarWithError(fmt.Errorf("agent run on %s produced no final output to post", identifier))
+		return
+	}
+
+	logger.Info("tui.agent_summary: posting agent summary issue=%s provider=%s", identifier, provider)
+	a.statusBar.SetText(fmt.Sprintf("%sPosting agent summary to %s...[-]", a.themeTags.Accent, identifier))
+	a.handleCreateComment(issueID, formatAgentSummaryComment(provider, duration, summary))
+}
@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestAgentRunSummary_CollectsAndFormats verifies the summary picks up model, tool calls
+// and final text and renders them as a markdown comment.
+func TestAgentRunSummary_CollectsAndFormats(t *testing.T) {
+	buffer := NewAgentStreamBuffer()
+	var summary agentRunSummary
+	for _, event := range []agents.AgentEvent{
+		{Type: agents.AgentEventSystem, Model: "sonnet"},
+		{Type: agents.AgentEventToolCall, Subtype: "started", Tool: &agents.AgentToolCall{Name: "read"}},
+		{Type: agents.AgentEventToolCall, Subtype: "completed", Tool: &agents.AgentToolCall{Name: "read"}},
+		{Type: agents.AgentEventToolCall, Subtype: "started", Tool: &agents.AgentToolCall{Name: "edit"}},
+		{Type: agents.AgentEventAssistant, Text: "Fixed the nil check in `main.go`."},
+		{Type: agents.AgentEventResult, Subtype: "success"},
+	} {
+		summary.observe(event, buffer.Append(event))
+	}
+
+	if summary.Model != "sonnet" || summary.ToolCalls != 2 || summary.FinalText != "Fixed the nil check in `main.go`." {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+
+	body := formatAgentSummaryComment("claude", 95*time.Second, summary)
+	for _, want := range []string{"**Agent run summary**", "Fixed the nil check in `main.go`.", "Provider: `claude`", "Model: `sonnet`", "Duration: 1m35s", "Tool calls: 2"} {
+		if !strings.Contains(body, want) {
+			t.Fatalf("expected %q in comment:\n%s", want, body)
+		}
+	}
+}
+
+// TestAgentCommandPostsSummary verifies the automatic post setting is read per command.
+func TestAgentCommandPostsSummary(t *testing.T) {
+	cfg := config.Config{
+		PageSize: 1,
+		CacheTTL: time.Minute,
+		AgentCommands: []config.AgentCommand{
+			{Name: "Claude", Command: "claude {prompt}", PostSummary: true},
+			{Name: "Cursor", Command: "cursor-agent {prompt}"},
+		},
+	}
+	app := NewApp(&linearapi.Client{}, cfg, nil)
+
+	if !app.agentCommandPostsSummary("claude {prompt}") {
+		t.Fatal("expected claude command to post summaries")
+	}
+	if app.agentCommandPostsSummary("cursor-agent {prompt}") || app.agentCommandPostsSummary("unknown") {
+		t.Fatal("expected only the opted-in command to post summaries")
+	}
+}
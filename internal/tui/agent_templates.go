@@ -0,0 +1,28 @@
+package tui
+
+import (
+	"context"
+
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// agentTemplateData builds prompt and command template data for an issue,
+// resolving its project name from the team cache.
+func (a *App) agentTemplateData(ctx context.Context, issue linearapi.Issue) agents.TemplateData {
+	project := ""
+	if issue.ProjectID != "" && a.cache != nil {
+		projects, err := a.cache.GetProjects(ctx, issue.TeamID)
+		if err != nil {
+			logger.Warning("tui.agent_templates: failed to resolve project issue=%s error=%v", issue.Identifier, err)
+		}
+		for _, p := range projects {
+			if p.ID == issue.ProjectID {
+				project = p.Name
+				break
+			}
+		}
+	}
+	return agents.NewTemplateData(issue, project)
+}
@@ -0,0 +1,230 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// agentWorktree is a worktree under the configured root with its cleanup status.
+type agentWorktree struct {
+	agents.Worktree
+	Stale bool // Directory gone, or branch merged into the main checkout
+	InUse bool // An active agent job runs in it
+}
+
+// agentWorktreeRoot returns the configured worktree root, or the default one.
+func (a *App) agentWorktreeRoot() (string, error) {
+	root := strings.TrimSpace(a.config.AgentWorktreeRoot)
+	if root == "" {
+		return agents.WorktreeRootPath()
+	}
+	return root, nil
+}
+
+// agentRepoDir returns the repository directory agents run against: the given
+// workspace, else the configured agent workspace, else the current directory.
+func (a *App) agentRepoDir(workspace string) string {
+	if workspace = strings.TrimSpace(workspace); workspace != "" {
+		return workspace
+	}
+	if workspace = strings.TrimSpace(a.config.AgentWorkspace); workspace != "" {
+		return workspace
+	}
+	cwd, _ := os.Getwd()
+	return cwd
+}
+
+// ensureAgentWorktree returns a worktree of the workspace repository with the
+// issue's branch checked out, creating it under the worktree root if needed.
+func (a *App) ensureAgentWorktree(ctx context.Context, workspace string, issue linearapi.Issue) (string, error) {
+	root, err := a.agentWorktreeRoot()
+	if err != nil {
+		return "", err
+	}
+	path, created, err := agents.EnsureWorktree(ctx, a.agentRepoDir(workspace), root, issue.BranchName)
+	if err != nil {
+		return "", err
+	}
+	if created {
+		logger.Info("tui.agent_worktrees: created worktree issue=%s path=%s", issue.Identifier, path)
+		a.QueueUpdateDraw(func() {
+			a.statusBar.SetText(fmt.Sprintf("%sCreated worktree for %s at %s[-]", a.themeTags.Accent, issue.Identifier, tview.Escape(path)))
+		})
+	}
+	return path, nil
+}
+
+// listAgentWorktrees returns the worktrees under the worktree root for the agent repository.
+func (a *App) listAgentWorktrees(ctx context.Context) (string, []agentWorktree, error) {
+	root, err := a.agentWorktreeRoot()
+	if err != nil {
+		return "", nil, err
+	}
+	// git may report worktree paths with symlinks resolved
+	resolvedRoot := root
+	if resolved, err := filepath.EvalSymlinks(root); err == nil {
+		resolvedRoot = resolved
+	}
+	repo, err := agents.RepoRoot(ctx, a.agentRepoDir(""))
+	if err != nil {
+		return "", nil, err
+	}
+	worktrees, err := agents.ListWorktrees(ctx, repo)
+	if err != nil {
+		return "", nil, err
+	}
+	merged, err := agents.MergedBranches(ctx, repo)
+	if err != nil {
+		return "", nil, err
+	}
+
+	inUse := make(map[string]bool)
+	for _, job := range a.agentJobs.Jobs() {
+		if job.State.Active() && job.Workspace != "" {
+			inUse[filepath.Clean(job.Workspace)] = true
+		}
+	}
+
+	var managed []agentWorktree
+	for _, wt := range worktrees {
+		if wt.Main || !(agents.IsWithin(root, wt.Path) || agents.IsWithin(resolvedRoot, wt.Path)) {
+			continue
+		}
+		managed = append(managed, agentWorktree{
+			Worktree: wt,
+			Stale:    wt.Prunable || (wt.Branch != "" && merged[wt.Branch]),
+			InUse:    inUse[filepath.Clean(wt.Path)],
+		})
+	}
+	return repo, managed, nil
+}
+
+// agentWorktreeLabel formats a worktree row for the worktrees list.
+func agentWorktreeLabel(wt agentWorktree) string {
+	branch := wt.Branch
+	if branch == "" {
+		branch = "(detached)"
+	}
+	label := branch + " · " + wt.Path
+	switch {
+	case wt.InUse:
+		label += " · agent running"
+	case wt.Prunable:
+		label += " · missing"
+	case wt.Stale:
+		label += " · merged"
+	}
+	if wt.Locked {
+		label += " · locked"
+	}
+	return label
+}
+
+// ShowAgentWorktrees lists per-issue agent worktrees and offers to remove them.
+func (a *App) ShowAgentWorktrees() {
+	go func() {
+		ctx := context.Background()
+		repo, worktrees, err := a.listAgentWorktrees(ctx)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.agent_worktrees: failed to list worktrees")
+				a.updateStatusBarWithError(err)
+				return
+			}
+			if len(worktrees) == 0 {
+				a.statusBar.SetText(fmt.Sprintf("%sNo agent worktrees[-]", a.themeTags.SecondaryText))
+				return
+			}
+
+			items := make([]PickerItem, 0, len(worktrees))
+			byPath := make(map[string]agentWorktree, len(worktrees))
+			for _, wt := range worktrees {
+				items = append(items, PickerItem{ID: wt.Path, Label: tview.Escape(agentWorktreeLabel(wt))})
+				byPath[wt.Path] = wt
+			}
+			a.pickerActive = true
+			a.pickerModal.Show("Agent Worktrees", items, func(item PickerItem) {
+				a.pickerActive = false
+				a.showAgentWorktreeActions(repo, byPath[item.ID])
+			})
+		})
+	}()
+}
+
+// showAgentWorktreeActions offers removal actions for a worktree.
+func (a *App) showAgentWorktreeActions(repo string, wt agentWorktree) {
+	if wt.InUse {
+		a.updateStatusBarWithError(fmt.Errorf("an agent is running in %s", wt.Path))
+		return
+	}
+	items := []PickerItem{
+		{ID: "remove", Label: "Remove worktree"},
+		{ID: "force", Label: "Remove worktree and discard local changes"},
+	}
+	a.pickerActive = true
+	a.pickerModal.Show(tview.Escape(wt.Branch), items, func(item PickerItem) {
+		a.pickerActive = false
+		force := item.ID == "force"
+		go func() {
+			err := agents.RemoveWorktree(context.Background(), repo, wt.Path, force)
+			a.QueueUpdateDraw(func() {
+				if err != nil {
+					logger.ErrorWithErr(err, "tui.agent_worktrees: failed to remove worktree path=%s", wt.Path)
+					a.updateStatusBarWithError(err)
+					return
+				}
+				a.statusBar.SetText(fmt.Sprintf("%sRemoved worktree %s[-]", a.themeTags.Accent, tview.Escape(wt.Path)))
+			})
+		}()
+	})
+}
+
+// cleanupAgentWorktrees removes stale worktrees that no agent is using. Worktrees
+// with local changes are kept, since git refuses to remove them without force.
+func (a *App) cleanupAgentWorktrees() {
+	go func() {
+		ctx := context.Background()
+		removed, kept := 0, 0
+		repo, worktrees, err := a.listAgentWorktrees(ctx)
+		if err == nil {
+			for _, wt := range worktrees {
+				if !wt.Stale || wt.InUse {
+					continue
+				}
+				if wt.Prunable {
+					// Missing directories only need their registrations dropped by the prune below
+					removed++
+					continue
+				}
+				if rmErr := agents.RemoveWorktree(ctx, repo, wt.Path, false); rmErr != nil {
+					logger.Warning("tui.agent_worktrees: kept stale worktree path=%s error=%v", wt.Path, rmErr)
+					kept++
+					continue
+				}
+				removed++
+			}
+			err = agents.PruneWorktrees(ctx, repo)
+		}
+
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.agent_worktrees: failed to clean up worktrees")
+				a.updateStatusBarWithError(err)
+				return
+			}
+			text := fmt.Sprintf("Removed %d stale worktree(s)", removed)
+			if kept > 0 {
+				text += fmt.Sprintf(", kept %d with local changes", kept)
+			}
+			a.statusBar.SetText(fmt.Sprintf("%s%s[-]", a.themeTags.Accent, text))
+		})
+	}()
+}
@@ -3,26 +3,26 @@ package tui
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/agents"
 	"github.com/roeyazroel/linear-tui/internal/cache"
 	"github.com/roeyazroel/linear-tui/internal/config"
 	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/live"
 	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/outbox"
+	"github.com/roeyazroel/linear-tui/internal/store"
 )
 
-// PendingExecCommand holds the command to exec after the TUI exits.
-type PendingExecCommand struct {
-	Binary string
-	Args   []string
-	Dir    string
-}
-
 // SortField represents a field to sort issues by.
 type SortField string
 
@@ -63,13 +63,47 @@ type App struct {
 	createIssueModal       *CreateIssueModal
 	createCommentModal     *CreateCommentModal
 	editTitleModal         *EditTitleModal
+	dueDateModal           *DueDateModal
 	editLabelsModal        *EditLabelsModal
 	settingsModal          *SettingsModal
 	promptTemplatesModal   *AgentPromptTemplatesModal
-	agentPromptModal     *AgentPromptModal
-	agentPromptTemplates []config.AgentPromptTemplate
-	pendingExec          *PendingExecCommand
-	parseCommand         func(commandTemplate, fullPrompt, branchName string) (string, []string, error)
+	agentPromptModal       *AgentPromptModal
+	savedViewModal         *SavedViewModal
+	agentPromptTemplates   []config.AgentPromptTemplate
+	agentOutputView        *tview.TextView // Streaming output of the in-app agent run
+	agentOutputVisible     bool
+
+	// Background agent jobs
+	agentJobs           *agents.JobManager
+	agentRunMu          sync.Mutex                  // Guards agentTranscripts
+	agentTranscripts    map[string]*agentTranscript // Stream lines per job ID
+	agentOutputJobID    string                      // Job shown in the output pane
+	agentOutputRendered int                         // Transcript lines already written to the pane
+	agentIssueStates    map[string]agents.JobState  // Active job state per issue for table markers
+	agentSpinner        *agentSpinner
+	agentSpinnerFrame   string
+	agentSpinnerLoop    atomic.Bool
+	newAgentProvider    func(commandTemplate string, data agents.TemplateData) (agents.Provider, error) // Overridable in tests
+	runAgent            func(ctx context.Context, p agents.Provider, prompt, issueContext string, options agents.AgentRunOptions, onEvent func(agents.AgentEvent), onLine func(string), onErr func(error)) error
+	agentHistory        *agents.History // Persisted runs (nil disables history)
+	agentHookMu         sync.Mutex      // Guards agentHookTail
+	agentHookTail       chan struct{}   // Closed when the last queued lifecycle hook is done
+	// Overridable in tests; default to the team cache and API
+	loadLifecycleTeamData func(ctx context.Context, action *config.AgentLifecycleAction, teamID string) (agentLifecycleTeamData, error)
+	updateIssue           func(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error)
+	runEditor             func(path string) error // Opens path in the external editor; overridable in tests
+
+	// Offline issue store (nil disables persistence and incremental sync)
+	issueStore     *store.IssueStore
+	offline        bool        // true while showing stored data because the API is unreachable
+	storeSaveMu    sync.Mutex  // Guards storeSaveTimer
+	storeSaveTimer *time.Timer // Pending batched store save; nil when none is scheduled
+
+	// Offline mutation queue (nil disables queueing edits made while offline)
+	outbox          *outbox.Outbox
+	outboxStates    map[string]outbox.State // Per-issue queue state for table markers
+	outboxReplaying atomic.Bool
+	outboxAPI       outbox.API // Overridable in tests; defaults to api
 
 	// App state (protected by issuesMu)
 	issuesMu            sync.RWMutex
@@ -93,6 +127,21 @@ type App struct {
 	// Filter/sort state
 	searchQuery string
 	sortField   SortField
+	groupBy     string // Issue grouping from the selected saved view; empty splits by assignee
+
+	// Saved views shown in the navigation tree
+	savedViews     []config.SavedView
+	savedViewsPath string // Empty disables persistence
+
+	// Linear custom views and favorites shown in the navigation tree
+	customViews []linearapi.CustomView
+	favorites   []linearapi.Favorite
+
+	// Cycles per team, loaded when a team is expanded or a cycle is picked
+	teamCycles map[string][]linearapi.Cycle
+
+	// Teams by ID from the last navigation load, used for estimation settings
+	teamsByID map[string]linearapi.Team
 
 	// Cached metadata for currently selected team
 	currentUser    *linearapi.User
@@ -108,9 +157,10 @@ type App struct {
 	refreshGeneration              atomic.Int64
 
 	// Lazy loading helpers (overridable in tests)
-	fetchIssuesPage func(context.Context, linearapi.FetchIssuesParams, *string) (linearapi.IssuePage, error)
-	fetchIssueByID  func(context.Context, string) (linearapi.Issue, error)
-	queueUpdateDraw func(func())
+	fetchIssuesPage   func(context.Context, linearapi.FetchIssuesParams, *string) (linearapi.IssuePage, error)
+	fetchIssueByID    func(context.Context, string) (linearapi.Issue, error)
+	fetchIssueHistory func(context.Context, string) ([]linearapi.IssueHistoryEntry, error)
+	queueUpdateDraw   func(func())
 
 	// UI update mutex (for test safety when queueUpdateDraw executes immediately)
 	uiUpdateMu sync.Mutex
@@ -119,8 +169,42 @@ type App struct {
 	fetchingIssueID string // Tracks which issue ID we're currently fetching
 
 	// Details pane sub-view focus
-	focusedDetailsView     bool // false = description, true = comments
-	detailsCommentsVisible bool // Tracks whether comments view is shown
+	focusedDetailsView     bool           // false = description, true = comments
+	commentEntries         []commentEntry // Threaded comments of the selected issue, in display order
+	selectedCommentID      string         // Comment under the comments cursor
+	detailsCommentsVisible bool           // Tracks whether comments view is shown
+	detailsActivityMode    bool           // true = the bottom view shows the activity timeline instead of comments
+
+	// Multi-selection in the issues tables
+	multiSelection    map[string]bool // Selected issue IDs for bulk operations
+	selectionAnchorID string          // Issue range selection extends from
+
+	// Undo/redo history of issue mutations
+	undoStack      []undoEntry
+	redoStack      []undoEntry
+	undoInProgress bool
+
+	// Activity timeline
+	issueHistory      map[string]issueHistoryCacheEntry // Fetched history by issue ID
+	fetchingHistoryID string                            // Issue whose history is being fetched
+
+	// Live updates pushed from Linear (nil source disables them)
+	liveSource        live.Source
+	highlightedIssues map[string]time.Time // Issue IDs marked as recently changed, until the given time
+
+	// Background polling for issue changes
+	stopPolling    context.CancelFunc // Stops the running poll loop; nil when polling is off
+	polling        bool               // A poll fetch is in flight
+	polledUntil    time.Time          // Newest updatedAt returned by a poll since the list was loaded (server clock)
+	newIssuesCount int                // Issues added by polling or live updates since the list was loaded
+
+	// Linear inbox notifications
+	notificationAPI       notificationClient // Overridable in tests; defaults to api
+	notifications         []linearapi.Notification
+	notificationsLoaded   bool            // The first fetch is done; later arrivals are announced
+	seenNotifications     map[string]bool // Notification IDs already fetched
+	fetchingNotifications atomic.Bool
+	alertWriter           io.Writer // Receives terminal alert sequences
 }
 
 // FocusTarget indicates which pane has focus.
@@ -153,16 +237,27 @@ func NewApp(api *linearapi.Client, cfg config.Config, templates []config.AgentPr
 		focusedPane:          FocusNavigation,
 		sortField:            SortByUpdatedAt,
 		expandedState:        make(map[string]bool),
+		teamCycles:           make(map[string][]linearapi.Cycle),
+		teamsByID:            make(map[string]linearapi.Team),
 		idToIssue:            make(map[string]*linearapi.Issue),
 		myIDToIssue:          make(map[string]*linearapi.Issue),
 		otherIDToIssue:       make(map[string]*linearapi.Issue),
 		activeIssuesSection:  IssuesSectionOther, // Default to Other section
 		agentPromptTemplates: templates,
+		agentSpinner:         newAgentSpinner(),
+		agentTranscripts:     make(map[string]*agentTranscript),
+		issueHistory:         make(map[string]issueHistoryCacheEntry),
+		multiSelection:       make(map[string]bool),
+		highlightedIssues:    make(map[string]time.Time),
+		seenNotifications:    make(map[string]bool),
+		alertWriter:          os.Stdout,
 	}
 
+	app.agentJobs = app.newAgentJobManager(cfg.AgentConcurrency)
 	app.paletteCtrl = NewPaletteController(DefaultCommands(app))
 	app.fetchIssuesPage = api.FetchIssuesPage
 	app.fetchIssueByID = api.FetchIssueByID
+	app.fetchIssueHistory = api.ListIssueHistory
 	app.queueUpdateDraw = func(f func()) {
 		app.app.QueueUpdateDraw(f)
 	}
@@ -182,13 +277,36 @@ func (a *App) Run() error {
 	// Load initial data asynchronously
 	a.loadInitialData()
 
+	// Retry edits queued while offline
+	if a.outbox != nil {
+		go a.runOutboxRetryLoop()
+	}
+
+	// Check the issues list for changes made elsewhere
+	a.startPolling(a.config.PollInterval)
+	defer a.startPolling(0)
+
+	// Keep the inbox count and alerts current, even with issue polling off
+	inboxCtx, stopInbox := context.WithCancel(context.Background())
+	defer stopInbox()
+	go a.runNotificationLoop(inboxCtx, notificationPollInterval)
+
+	// Apply changes pushed from Linear while the UI runs
+	liveCtx, stopLive := context.WithCancel(context.Background())
+	defer stopLive()
+	if a.liveSource != nil {
+		go a.runLiveUpdates(liveCtx)
+	}
+
 	// Start the application event loop
-	return a.app.Run()
-}
+	err := a.app.Run()
 
-// PendingExec returns the command to exec after the TUI exits, or nil.
-func (a *App) PendingExec() *PendingExecCommand {
-	return a.pendingExec
+	// Write store changes still waiting for a batched save
+	a.persistIssueStore()
+
+	// Stop agent processes still running when the UI exits
+	a.agentJobs.CancelAll()
+	return err
 }
 
 // loadInitialData fetches user, navigation, and issues in a background goroutine.
@@ -201,13 +319,25 @@ func (a *App) loadInitialData() {
 		if err == nil {
 			a.currentUser = &user
 			logger.Debug("tui.app: current user loaded user=%s", user.DisplayName)
+			if a.issueStore != nil {
+				a.issueStore.SetCurrentUser(user)
+			}
 		} else {
 			logger.Warning("tui.app: failed to load current user error=%v", err)
+			if a.issueStore != nil {
+				if stored, ok := a.issueStore.CurrentUser(); ok {
+					a.currentUser = &stored
+					logger.Debug("tui.app: using stored current user user=%s", stored.DisplayName)
+				}
+			}
 		}
 
 		// Fetch teams and build navigation
 		a.loadNavigationData(ctx)
 
+		// Fetch the inbox for the unread count on its navigation node
+		a.loadNotifications()
+
 		// Load issues for initial view
 		a.refreshIssues()
 	}()
@@ -217,6 +347,8 @@ func (a *App) loadInitialData() {
 func (a *App) applySettings(newCfg config.Config) {
 	a.config = newCfg
 	a.applyThemeAndDensity()
+	a.agentJobs.SetLimit(newCfg.AgentConcurrency)
+	a.startPolling(newCfg.PollInterval)
 
 	logLevel := parseLogLevel(newCfg.LogLevel)
 	if err := logger.Reinit(newCfg.LogFile, logLevel); err != nil {
@@ -236,6 +368,7 @@ func (a *App) applySettings(newCfg config.Config) {
 	a.cache = cache.NewTeamCache(a.api, newCfg.CacheTTL)
 	a.fetchIssuesPage = a.api.FetchIssuesPage
 	a.fetchIssueByID = a.api.FetchIssueByID
+	a.fetchIssueHistory = a.api.ListIssueHistory
 
 	logger.Debug("tui.app: resetting cached state after settings change")
 	a.resetCachedState()
@@ -280,11 +413,11 @@ func (a *App) applyThemeToComponents() {
 
 	if a.myIssuesTable != nil {
 		a.applyIssuesTableTheme(a.myIssuesTable)
-		renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, a.selectedIssueID(IssuesSectionMy), a.theme)
+		renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, a.selectedIssueID(IssuesSectionMy), a.theme, a.issueBadge)
 	}
 	if a.otherIssuesTable != nil {
 		a.applyIssuesTableTheme(a.otherIssuesTable)
-		renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, a.selectedIssueID(IssuesSectionOther), a.theme)
+		renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, a.selectedIssueID(IssuesSectionOther), a.theme, a.issueBadge)
 	}
 
 	if a.detailsDescriptionView != nil {
@@ -298,6 +431,12 @@ func (a *App) applyThemeToComponents() {
 			SetBackgroundColor(a.theme.Background)
 	}
 
+	if a.agentOutputView != nil {
+		a.agentOutputView.SetTitleColor(a.theme.Foreground).
+			SetBorderColor(a.theme.Border).
+			SetBackgroundColor(a.theme.Background)
+	}
+
 	if a.statusBar != nil {
 		a.statusBar.SetBackgroundColor(a.theme.HeaderBg)
 	}
@@ -331,10 +470,12 @@ func (a *App) rebuildModals() {
 	a.createIssueModal = NewCreateIssueModal(a)
 	a.createCommentModal = NewCreateCommentModal(a)
 	a.editTitleModal = NewEditTitleModal(a)
+	a.dueDateModal = NewDueDateModal(a)
 	a.editLabelsModal = NewEditLabelsModal(a)
 	a.settingsModal = NewSettingsModal(a)
 	a.promptTemplatesModal = NewAgentPromptTemplatesModal(a)
 	a.agentPromptModal = NewAgentPromptModal(a)
+	a.savedViewModal = NewSavedViewModal(a)
 }
 
 func (a *App) applyIssuesTableTheme(table *tview.Table) {
@@ -369,7 +510,7 @@ func (a *App) applyNavigationNodeColors(node *tview.TreeNode) {
 	if ref == nil {
 		node.SetColor(a.theme.Accent)
 	} else if navNode, ok := ref.(*NavigationNode); ok {
-		if navNode.IsProject || navNode.IsStatus {
+		if navNode.IsProject || navNode.IsStatus || navNode.IsCycle || navNode.View != nil || navNode.IsFavorite || navNode.CustomViewID != "" {
 			node.SetColor(a.theme.SecondaryText)
 		} else {
 			node.SetColor(a.theme.Foreground)
@@ -421,6 +562,15 @@ func (a *App) resetCachedState() {
 	a.workflowStates = nil
 	a.activeIssuesSection = IssuesSectionOther
 	a.expandedState = make(map[string]bool)
+	a.multiSelection = make(map[string]bool)
+	a.selectionAnchorID = ""
+	a.undoStack = nil
+	a.redoStack = nil
+	a.highlightedIssues = make(map[string]time.Time)
+	a.newIssuesCount = 0
+	a.notifications = nil
+	a.notificationsLoaded = false
+	a.seenNotifications = make(map[string]bool)
 
 	a.isLoading = false
 	a.pendingRefresh = false
@@ -429,6 +579,9 @@ func (a *App) resetCachedState() {
 	// Bump generation to prevent in-flight refreshes from updating UI.
 	a.refreshGeneration.Add(1)
 	a.fetchingIssueID = ""
+	a.issueHistory = make(map[string]issueHistoryCacheEntry)
+	a.fetchingHistoryID = ""
+	a.offline = false
 }
 
 // parseLogLevel converts a string log level to a logger.LogLevel.
@@ -452,16 +605,30 @@ func (a *App) loadNavigationData(ctx context.Context) {
 	teams, err := a.cache.GetTeams(ctx)
 	if err != nil {
 		logger.ErrorWithErr(err, "tui.app: failed to load teams")
-		a.app.QueueUpdateDraw(func() {
-			a.updateStatusBarWithError(err)
-		})
-		return
+		var storedTeams []linearapi.Team
+		if a.issueStore != nil {
+			storedTeams = a.issueStore.Teams()
+		}
+		if len(storedTeams) == 0 {
+			a.app.QueueUpdateDraw(func() {
+				a.updateStatusBarWithError(err)
+			})
+			return
+		}
+		logger.Debug("tui.app: using stored teams count=%d", len(storedTeams))
+		teams = storedTeams
+	} else if a.issueStore != nil {
+		a.issueStore.SetTeams(teams)
+		a.persistIssueStore()
 	}
 
 	logger.Debug("tui.app: loaded teams count=%d", len(teams))
 	a.app.QueueUpdateDraw(func() {
 		a.rebuildNavigationTree(teams)
 	})
+
+	// Custom views and favorites are optional extras; load them without delaying issues
+	go a.loadLinearViews(ctx)
 }
 
 // rebuildNavigationTree rebuilds the navigation tree with real data.
@@ -477,8 +644,19 @@ func (a *App) rebuildNavigationTree(teams []linearapi.Team) {
 		SetExpanded(true)
 	root.AddChild(allIssues)
 
+	// Add the notifications inbox below "All Issues"
+	root.AddChild(tview.NewTreeNode(a.inboxLabel()).
+		SetColor(a.theme.Foreground).
+		SetReference(&NavigationNode{ID: inboxNodeID, Text: "Inbox", IsInbox: true}))
+
+	// Add saved views, favorites and Linear views below the inbox
+	for _, group := range a.buildNavigationGroups() {
+		root.AddChild(group)
+	}
+
 	// Add teams
 	for _, team := range teams {
+		a.teamsByID[team.ID] = team
 		teamNode := tview.NewTreeNode(team.Name).
 			SetColor(a.theme.Foreground).
 			SetReference(&NavigationNode{
@@ -498,9 +676,10 @@ func (a *App) rebuildNavigationTree(teams []linearapi.Team) {
 	a.navigationTree.SetRoot(root)
 	a.navigationTree.SetCurrentNode(allIssues)
 	a.selectedNavigation = &NavigationNode{ID: "all", Text: "All Issues"}
+	a.groupBy = ""
 }
 
-// onTeamExpanded loads projects for a team when it's expanded.
+// onTeamExpanded loads projects, workflow states and cycles for a team when it's expanded.
 func (a *App) onTeamExpanded(teamID string, teamNode *tview.TreeNode) {
 	// If already has children (projects loaded), just toggle expand
 	if len(teamNode.GetChildren()) > 0 {
@@ -514,9 +693,10 @@ func (a *App) onTeamExpanded(teamID string, teamNode *tview.TreeNode) {
 		ctx := context.Background()
 		var projects []linearapi.Project
 		var states []linearapi.WorkflowState
-		var projectsErr, statesErr error
+		var cycles []linearapi.Cycle
+		var projectsErr, statesErr, cyclesErr error
 		var wg sync.WaitGroup
-		wg.Add(2)
+		wg.Add(3)
 		go func() {
 			defer wg.Done()
 			projects, projectsErr = a.cache.GetProjects(ctx, teamID)
@@ -525,7 +705,17 @@ func (a *App) onTeamExpanded(teamID string, teamNode *tview.TreeNode) {
 			defer wg.Done()
 			states, statesErr = a.cache.GetWorkflowStates(ctx, teamID)
 		}()
+		go func() {
+			defer wg.Done()
+			cycles, cyclesErr = a.cache.GetCycles(ctx, teamID)
+		}()
 		wg.Wait()
+		// Cycles are optional (teams may not use them), so a failure only hides the group
+		if cyclesErr != nil {
+			logger.Warning("tui.app: failed to load cycles team_id=%s error=%v", teamID, cyclesErr)
+		}
+		projects, projectsErr = a.withStoredProjects(teamID, projects, projectsErr)
+		states, statesErr = a.withStoredWorkflowStates(teamID, states, statesErr)
 		if projectsErr != nil {
 			logger.ErrorWithErr(projectsErr, "tui.app: failed to load projects team_id=%s", teamID)
 			a.app.QueueUpdateDraw(func() {
@@ -540,7 +730,8 @@ func (a *App) onTeamExpanded(teamID string, teamNode *tview.TreeNode) {
 			})
 			return
 		}
-		logger.Debug("tui.app: loaded navigation children team_id=%s projects=%d states=%d", teamID, len(projects), len(states))
+		logger.Debug("tui.app: loaded navigation children team_id=%s projects=%d states=%d cycles=%d",
+			teamID, len(projects), len(states), len(cycles))
 
 		a.app.QueueUpdateDraw(func() {
 			// Double-check children haven't been added by another goroutine
@@ -576,6 +767,12 @@ func (a *App) onTeamExpanded(teamID string, teamNode *tview.TreeNode) {
 				}
 				teamNode.AddChild(statusGroup)
 			}
+			if cyclesErr == nil {
+				a.teamCycles[teamID] = cycles
+				if cyclesGroup := a.buildCyclesGroup(teamID, cycles); cyclesGroup != nil {
+					teamNode.AddChild(cyclesGroup)
+				}
+			}
 			for _, proj := range projects {
 				projNode := tview.NewTreeNode("  " + proj.Name).
 					SetColor(a.theme.SecondaryText).
@@ -607,6 +804,7 @@ func (a *App) buildLayout() {
 	a.issuesTable = a.otherIssuesTable
 	a.detailsView = a.buildDetailsView()
 	a.statusBar = a.buildStatusBar()
+	a.agentOutputView = a.buildAgentOutputView()
 
 	// Create horizontal split: navigation (20%) | issues (50%) | details (30%)
 	contentFlex := tview.NewFlex().
@@ -614,10 +812,11 @@ func (a *App) buildLayout() {
 		AddItem(a.issuesColumn, 0, 5, false).
 		AddItem(a.detailsView, 0, 3, false)
 
-	// Create vertical layout: content + status bar
+	// Create vertical layout: content + agent output (hidden until a run starts) + status bar
 	a.mainLayout = tview.NewFlex().
 		SetDirection(tview.FlexRow).
 		AddItem(contentFlex, 0, 1, true).
+		AddItem(a.agentOutputView, 0, 0, false).
 		AddItem(a.statusBar, 1, 1, false)
 
 	// Build palette modal
@@ -628,10 +827,12 @@ func (a *App) buildLayout() {
 	a.createIssueModal = NewCreateIssueModal(a)
 	a.createCommentModal = NewCreateCommentModal(a)
 	a.editTitleModal = NewEditTitleModal(a)
+	a.dueDateModal = NewDueDateModal(a)
 	a.editLabelsModal = NewEditLabelsModal(a)
 	a.settingsModal = NewSettingsModal(a)
 	a.promptTemplatesModal = NewAgentPromptTemplatesModal(a)
 	a.agentPromptModal = NewAgentPromptModal(a)
+	a.savedViewModal = NewSavedViewModal(a)
 
 	// Add main layout to pages
 	a.pages.AddPage("main", a.mainLayout, true, true)
@@ -664,6 +865,11 @@ func (a *App) bindGlobalKeys() {
 			return a.editTitleModal.HandleKey(event)
 		}
 
+		// Check if due date modal is visible and handle its keys
+		if a.pages.HasPage("due_date") && a.dueDateModal != nil {
+			return a.dueDateModal.HandleKey(event)
+		}
+
 		// Check if edit labels modal is visible and handle its keys
 		if a.pages.HasPage("edit_labels") && a.editLabelsModal != nil {
 			return a.editLabelsModal.HandleKey(event)
@@ -684,6 +890,11 @@ func (a *App) bindGlobalKeys() {
 			return a.agentPromptModal.HandleKey(event)
 		}
 
+		// Check if saved view modal is visible and handle its keys
+		if a.pages.HasPage("saved_view") && a.savedViewModal != nil {
+			return a.savedViewModal.HandleKey(event)
+		}
+
 		// Handle palette first if it's open
 		if a.focusedPane == FocusPalette {
 			return a.handlePaletteKey(event)
@@ -692,7 +903,11 @@ func (a *App) bindGlobalKeys() {
 		// Global shortcuts (only when not in palette)
 		switch event.Key() {
 		case tcell.KeyEscape:
-			// Clear search if active (when not in modals/palette)
+			// Clear the multi-selection first, then search (when not in modals/palette)
+			if a.focusedPane == FocusIssues && len(a.multiSelection) > 0 {
+				a.ClearIssueSelection()
+				return nil
+			}
 			if a.searchQuery != "" {
 				a.setSearchQuery("")
 				return nil
@@ -700,6 +915,9 @@ func (a *App) bindGlobalKeys() {
 		case tcell.KeyCtrlC:
 			a.app.Stop()
 			return nil
+		case tcell.KeyCtrlR:
+			a.Redo()
+			return nil
 		case tcell.KeyTab, tcell.KeyBacktab:
 			// Tab cycles forward through panes (Navigation -> Issues -> Details)
 			// When in Details pane, first cycle between description and comments
@@ -834,6 +1052,15 @@ func (a *App) handleIssuesKey(event *tcell.EventKey) *tcell.EventKey {
 
 // handleDetailsKey handles keyboard input when details pane is focused.
 func (a *App) handleDetailsKey(event *tcell.EventKey) *tcell.EventKey {
+	if event.Key() == tcell.KeyRune && event.Rune() == 'a' {
+		a.ToggleActivityView()
+		return nil
+	}
+	if a.focusedDetailsView && a.detailsCommentsVisible && !a.detailsActivityMode {
+		if a.handleCommentsKey(event) == nil {
+			return nil
+		}
+	}
 	switch event.Key() {
 	case tcell.KeyLeft:
 		a.focusedPane = FocusIssues
@@ -863,8 +1090,11 @@ func (a *App) handlePaletteKey(event *tcell.EventKey) *tcell.EventKey {
 		return nil
 	case tcell.KeyEnter:
 		if a.paletteCtrl.IsSearchMode() {
-			// In search mode, submit the search query
+			// In search mode, submit the search query unless it has a syntax error
 			query := a.paletteCtrl.Query()
+			if !a.validateSearchQuery(query) {
+				return nil
+			}
 			a.closePaletteUI()      // Close UI without changing focus
 			a.setSearchQuery(query) // This will set focus to issues pane
 			return nil
@@ -893,7 +1123,9 @@ func (a *App) handlePaletteKey(event *tcell.EventKey) *tcell.EventKey {
 		if len(query) > 0 {
 			a.paletteCtrl.SetQuery(query[:len(query)-1])
 			a.paletteInput.SetText(a.paletteCtrl.Query())
-			if !a.paletteCtrl.IsSearchMode() {
+			if a.paletteCtrl.IsSearchMode() {
+				a.validateSearchQuery(a.paletteCtrl.Query())
+			} else {
 				a.updatePaletteList()
 			}
 		}
@@ -902,7 +1134,9 @@ func (a *App) handlePaletteKey(event *tcell.EventKey) *tcell.EventKey {
 		query := a.paletteCtrl.Query() + string(event.Rune())
 		a.paletteCtrl.SetQuery(query)
 		a.paletteInput.SetText(query)
-		if !a.paletteCtrl.IsSearchMode() {
+		if a.paletteCtrl.IsSearchMode() {
+			a.validateSearchQuery(query)
+		} else {
 			a.updatePaletteList()
 		}
 		return nil
@@ -1074,19 +1308,20 @@ func (a *App) updateAllPaneTitles() {
 	}
 
 	// Update Other Issues table title
+	otherTitle := a.issuesTableTitle()
 	if len(a.otherIssueRows) > 0 {
 		if isIssuesFocused && a.activeIssuesSection == IssuesSectionOther {
 			// Active section: add visual indicator and accent color
-			a.otherIssuesTable.SetTitle(" ▶ Other Issues ")
+			a.otherIssuesTable.SetTitle(" ▶ " + otherTitle + " ")
 			a.otherIssuesTable.SetTitleColor(a.theme.Accent)
 		} else {
 			// Inactive section: normal title
-			a.otherIssuesTable.SetTitle(" Other Issues ")
+			a.otherIssuesTable.SetTitle(" " + otherTitle + " ")
 			a.otherIssuesTable.SetTitleColor(a.theme.Foreground)
 		}
 	} else {
 		// No issues in this section
-		a.otherIssuesTable.SetTitle(" Other Issues ")
+		a.otherIssuesTable.SetTitle(" " + otherTitle + " ")
 		a.otherIssuesTable.SetTitleColor(a.theme.Foreground)
 	}
 
@@ -1099,14 +1334,14 @@ func (a *App) updateAllPaneTitles() {
 				// Comments view is active
 				a.detailsDescriptionView.SetTitle(" Details ")
 				a.detailsDescriptionView.SetTitleColor(a.theme.Foreground)
-				a.detailsCommentsView.SetTitle(" ▶ Comments ")
+				a.detailsCommentsView.SetTitle(fmt.Sprintf(" ▶ %s ", a.detailsBottomTitle()))
 				a.detailsCommentsView.SetTitleColor(a.theme.Accent)
 			} else {
 				// Description view is active
 				a.detailsDescriptionView.SetTitle(" ▶ Details ")
 				a.detailsDescriptionView.SetTitleColor(a.theme.Accent)
 				if a.detailsCommentsVisible && a.detailsCommentsView != nil {
-					a.detailsCommentsView.SetTitle(" Comments ")
+					a.detailsCommentsView.SetTitle(fmt.Sprintf(" %s ", a.detailsBottomTitle()))
 					a.detailsCommentsView.SetTitleColor(a.theme.Foreground)
 				}
 			}
@@ -1115,7 +1350,7 @@ func (a *App) updateAllPaneTitles() {
 			a.detailsDescriptionView.SetTitle(" Details ")
 			a.detailsDescriptionView.SetTitleColor(a.theme.Foreground)
 			if a.detailsCommentsView != nil {
-				a.detailsCommentsView.SetTitle(" Comments ")
+				a.detailsCommentsView.SetTitle(fmt.Sprintf(" %s ", a.detailsBottomTitle()))
 				a.detailsCommentsView.SetTitleColor(a.theme.Foreground)
 			}
 		}
@@ -1140,13 +1375,24 @@ func (a *App) openSearchPalette() {
 	a.paletteCtrl.SetQuery(a.searchQuery)
 	a.paletteInput.SetText(a.searchQuery)
 	a.paletteInput.SetLabel("/ ")
-	a.paletteList.Clear()
+	a.validateSearchQuery(a.searchQuery)
 	a.pages.ShowPage("palette")
 	a.pages.SendToFront("palette")
 	a.focusedPane = FocusPalette
 	a.updateFocus()
 }
 
+// validateSearchQuery shows a filter query syntax error below the search input
+// and reports whether the query is valid.
+func (a *App) validateSearchQuery(query string) bool {
+	a.paletteList.Clear()
+	if _, err := linearapi.ParseIssueQuery(query, time.Now()); err != nil {
+		a.paletteList.AddItem(fmt.Sprintf("%s%s[-]", a.themeTags.Error, tview.Escape(err.Error())), "", 0, nil)
+		return false
+	}
+	return true
+}
+
 // closePalette closes the command palette overlay.
 func (a *App) closePalette() {
 	a.paletteCtrl.SetSearchMode(false)
@@ -1222,41 +1468,33 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 	go func() {
 		ctx := context.Background()
 
-		params := linearapi.FetchIssuesParams{
-			First:   a.config.PageSize,
-			Search:  a.searchQuery,
-			OrderBy: string(a.sortField),
-		}
-
-		// Apply team/project/state filter based on navigation selection
-		if a.selectedNavigation != nil {
-			switch {
-			case a.selectedNavigation.IsStatus:
-				params.TeamID = a.selectedNavigation.TeamID
-				params.StateID = a.selectedNavigation.StateID
-			case a.selectedNavigation.IsTeam:
-				params.TeamID = a.selectedNavigation.TeamID
-			case a.selectedNavigation.IsProject:
-				params.TeamID = a.selectedNavigation.TeamID
-				params.ProjectID = a.selectedNavigation.ID
-			}
-			// If "All Issues", no team/project filter
-		}
+		params := a.currentFetchParams()
 
 		fetchPage := a.fetchIssuesPage
 		if fetchPage == nil {
 			fetchPage = a.api.FetchIssuesPage
 		}
 
+		// Render last-known issues immediately, then sync only what changed when possible.
+		showedStored := a.showStoredIssues(generation, params, targetIssueID, allowFocus)
+		if cursor, ok := a.incrementalSyncCursor(params); ok {
+			a.syncIssuesIncrementally(ctx, generation, fetchPage, params, cursor, targetIssueID, allowFocus, showedStored)
+			return
+		}
+
 		pageCount := 0
 		fetchedCount := 0
+		fetched := make([]linearapi.Issue, 0)
+		complete := true
 		logger.Debug("tui.app: refreshing issues team_id=%s project_id=%s state_id=%s search=%s", params.TeamID, params.ProjectID, params.StateID, params.Search)
 		page, err := fetchPage(ctx, params, nil)
 		if err != nil {
 			a.QueueUpdateDraw(func() {
 				a.isLoading = false
 				logger.ErrorWithErr(err, "tui.app: failed to fetch issues")
-				a.updateStatusBarWithError(err)
+				if !a.showOfflineIssues(params, targetIssueID, showedStored) {
+					a.updateStatusBarWithError(err)
+				}
 				a.runQueuedIssuesRefresh()
 			})
 			return
@@ -1271,8 +1509,10 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 
 		pageCount++
 		fetchedCount += len(page.Issues)
+		fetched = append(fetched, page.Issues...)
 		a.QueueUpdateDraw(func() {
 			logger.Debug("tui.app: fetched issues page=%d count=%d", pageCount, len(page.Issues))
+			a.offline = false
 			a.updateIssuesData(page.Issues, targetIssueID)
 			if allowFocus {
 				// Ensure focus is on issues table after initial load
@@ -1287,10 +1527,12 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 		after := page.EndCursor
 		for page.HasNext {
 			if generation != a.refreshGeneration.Load() {
+				complete = false
 				break
 			}
 			nextPage, err := fetchPage(ctx, params, after)
 			if err != nil {
+				complete = false
 				a.QueueUpdateDraw(func() {
 					logger.ErrorWithErr(err, "tui.app: failed to fetch more issues page=%d", pageCount+1)
 					a.updateStatusBarWithError(err)
@@ -1298,6 +1540,7 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 				break
 			}
 			if generation != a.refreshGeneration.Load() {
+				complete = false
 				break
 			}
 
@@ -1305,6 +1548,7 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 			after = page.EndCursor
 			pageCount++
 			fetchedCount += len(page.Issues)
+			fetched = append(fetched, page.Issues...)
 			a.QueueUpdateDraw(func() {
 				a.appendIssuesData(page.Issues)
 				if page.HasNext {
@@ -1313,11 +1557,14 @@ func (a *App) refreshIssuesWithFocusChange(allowFocusChange bool, issueID ...str
 			})
 		}
 
+		a.storeFetchedIssues(params, fetched, complete)
+
 		a.QueueUpdateDraw(func() {
 			a.isLoading = false
 			logger.Debug("tui.app: refresh completed pages=%d total_fetched=%d", pageCount, fetchedCount)
 			a.updateStatusBar()
 			a.runQueuedIssuesRefresh()
+			a.replayOutbox()
 		})
 	}()
 
@@ -1351,6 +1598,8 @@ func (a *App) updateIssuesData(issues []linearapi.Issue, issueID ...string) {
 	if a.sortField == SortByPriority {
 		sortIssuesByPriority(a.issues)
 	}
+	a.newIssuesCount = 0
+	a.polledUntil = time.Time{}
 
 	// Determine target issue ID
 	var targetIssueID string
@@ -1375,7 +1624,7 @@ func (a *App) updateIssuesData(issues []linearapi.Issue, issueID ...string) {
 
 // rebuildIssuesTables rebuilds issue rows and renders tables, returning the selected issue.
 func (a *App) rebuildIssuesTables(targetIssueID string) *linearapi.Issue {
-	// Split issues by assignee.
+	// Split issues by assignee, or group them for saved views.
 	a.issuesMu.RLock()
 	issues := a.issues
 	a.issuesMu.RUnlock()
@@ -1384,7 +1633,7 @@ func (a *App) rebuildIssuesTables(targetIssueID string) *linearapi.Issue {
 	if a.currentUser != nil {
 		currentUserID = a.currentUser.ID
 	}
-	myIssues, otherIssues := splitIssuesByAssignee(issues, currentUserID)
+	myIssues, otherIssues := a.splitIssuesForDisplay(issues, currentUserID)
 
 	// Build hierarchical tree rows for each section.
 	a.myIssueRows, a.myIDToIssue = BuildIssueRows(myIssues, a.expandedState)
@@ -1418,8 +1667,8 @@ func (a *App) rebuildIssuesTables(targetIssueID string) *linearapi.Issue {
 		}
 	}
 
-	renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme)
-	renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme)
+	renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme, a.issueBadge)
+	renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme, a.issueBadge)
 
 	// Select issue and update details.
 	var selectedIssue *linearapi.Issue
@@ -1449,6 +1698,21 @@ func (a *App) rebuildIssuesTables(targetIssueID string) *linearapi.Issue {
 	return selectedIssue
 }
 
+// issueBadge returns the markers shown before an issue title: selection, recent change, agent job,
+// queued edits, then blocked.
+func (a *App) issueBadge(issueID string) string {
+	return a.selectionBadge(issueID) + a.highlightBadge(issueID) + a.agentBadge(issueID) + a.outboxBadge(issueID) + a.blockedBadge(issueID)
+}
+
+// redrawIssuesTables re-renders both issue tables from existing rows, keeping the selection.
+func (a *App) redrawIssuesTables() {
+	if a.myIssuesTable == nil || a.otherIssuesTable == nil {
+		return
+	}
+	renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, a.selectedIssueID(IssuesSectionMy), a.theme, a.issueBadge)
+	renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, a.selectedIssueID(IssuesSectionOther), a.theme, a.issueBadge)
+}
+
 // appendIssuesData merges additional issues and updates rendered tables.
 func (a *App) appendIssuesData(newIssues []linearapi.Issue) {
 	if len(newIssues) == 0 {
@@ -1508,6 +1772,12 @@ func sortIssuesByPriority(issues []linearapi.Issue) {
 // onIssueSelected handles when an issue is selected.
 func (a *App) onIssueSelected(issue linearapi.Issue) {
 	logger.Debug("tui.app: issue selected issue=%s", issue.Identifier)
+	// Show stored comments right away; the full fetch below replaces them.
+	if issue.Comments == nil && a.issueStore != nil {
+		if stored, ok := a.issueStore.Issue(issue.ID); ok {
+			issue.Comments = stored.Comments
+		}
+	}
 	// Set selected issue immediately for quick UI feedback
 	a.issuesMu.Lock()
 	a.selectedIssue = &issue
@@ -1526,6 +1796,11 @@ func (a *App) onIssueSelected(issue linearapi.Issue) {
 			fetchIssue = a.api.FetchIssueByID
 		}
 		fullIssue, err := fetchIssue(ctx, issueID)
+		if err == nil && a.issueStore != nil {
+			a.issueStore.UpsertIssue(fullIssue)
+			// Moving through the list selects many issues; save them together
+			a.schedulePersistIssueStore()
+		}
 
 		a.QueueUpdateDraw(func() {
 			// Race-safety: only apply if this is still the issue we're fetching
@@ -1578,7 +1853,7 @@ func (a *App) toggleIssueExpanded(issueID string) {
 	a.issuesMu.RLock()
 	issues := a.issues
 	a.issuesMu.RUnlock()
-	myIssues, otherIssues := splitIssuesByAssignee(issues, currentUserID)
+	myIssues, otherIssues := a.splitIssuesForDisplay(issues, currentUserID)
 	a.myIssueRows, a.myIDToIssue = BuildIssueRows(myIssues, a.expandedState)
 	a.otherIssueRows, a.otherIDToIssue = BuildIssueRows(otherIssues, a.expandedState)
 
@@ -1607,8 +1882,8 @@ func (a *App) toggleIssueExpanded(issueID string) {
 		a.activeIssuesSection = IssuesSectionOther
 	}
 
-	renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme)
-	renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme)
+	renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme, a.issueBadge)
+	renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme, a.issueBadge)
 }
 
 // onNavigationSelected handles when a navigation item is selected.
@@ -1616,8 +1891,15 @@ func (a *App) onNavigationSelected(node *NavigationNode) {
 	logger.Debug("tui.app: navigation selected node_id=%s node_text=%s is_team=%v is_project=%v", node.ID, node.Text, node.IsTeam, node.IsProject)
 	a.selectedNavigation = node
 
+	// Saved views carry their own sort and grouping; other nodes use the default grouping
+	if node.View != nil {
+		a.applySavedViewDisplay(*node.View)
+	} else {
+		a.groupBy = ""
+	}
+
 	// Update selected team/project
-	if node.IsTeam {
+	if node.IsTeam || (node.View != nil && node.TeamID != "") {
 		// Load team metadata (users, workflow states) in background
 		go func() {
 			logger.Debug("tui.app: preloading team metadata team_id=%s", node.TeamID)
@@ -1670,7 +1952,7 @@ func (a *App) updateStatusBar() {
 	case FocusNavigation:
 		helpText = fmt.Sprintf("%s↑↓: navigate | Enter: select | Tab/→/l: next pane | Shift+Tab/←/h: prev pane | :: palette | /: search | q: quit[-]", keyColor)
 	case FocusIssues:
-		helpText = fmt.Sprintf("%sj/k: navigate | Enter: select | a: agent | Tab/→/l: next pane | Shift+Tab/←/h: prev pane | :: palette | /: search | q: quit[-]", keyColor)
+		helpText = fmt.Sprintf("%sj/k: navigate | Enter: select | Space/V/A: multi-select | u/Ctrl-R: undo/redo | a: agent | Tab/→/l: next pane | Shift+Tab/←/h: prev pane | :: palette | /: search | q: quit[-]", keyColor)
 	case FocusDetails:
 		helpText = fmt.Sprintf("%sj/k: scroll | Tab: switch description/comments | →/l: next pane | Shift+Tab/←/h: prev pane | :: palette | /: search | q: quit[-]", keyColor)
 	case FocusPalette:
@@ -1682,7 +1964,15 @@ func (a *App) updateStatusBar() {
 	navText := ""
 	if a.selectedNavigation != nil {
 		label := a.selectedNavigation.Text
-		if a.selectedNavigation.IsStatus {
+		if a.selectedNavigation.View != nil {
+			label = fmt.Sprintf("View: %s", a.selectedNavigation.Text)
+		} else if a.selectedNavigation.IsFavorite {
+			label = fmt.Sprintf("Favorite: %s", a.selectedNavigation.Text)
+		} else if a.selectedNavigation.CustomViewID != "" {
+			label = fmt.Sprintf("Linear view: %s", a.selectedNavigation.Text)
+		} else if a.selectedNavigation.IsCycle {
+			label = a.cycleStatusLabel(a.selectedNavigation)
+		} else if a.selectedNavigation.IsStatus {
 			if a.selectedNavigation.StateName != "" {
 				label = fmt.Sprintf("Status: %s", a.selectedNavigation.StateName)
 			} else {
@@ -1697,6 +1987,11 @@ func (a *App) updateStatusBar() {
 		searchText = fmt.Sprintf("%s🔍 %s[-]", a.themeTags.Warning, a.searchQuery)
 	}
 
+	offlineText := ""
+	if a.offline {
+		offlineText = fmt.Sprintf("%sOffline (cached)[-]", a.themeTags.Error)
+	}
+
 	a.issuesMu.RLock()
 	issuesLen := len(a.issues)
 	a.issuesMu.RUnlock()
@@ -1714,6 +2009,21 @@ func (a *App) updateStatusBar() {
 	if searchText != "" {
 		parts = append(parts, searchText)
 	}
+	if offlineText != "" {
+		parts = append(parts, offlineText)
+	}
+	if outboxText := a.outboxStatusText(); outboxText != "" {
+		parts = append(parts, outboxText)
+	}
+	if agentText := a.agentStatusText(); agentText != "" {
+		parts = append(parts, agentText)
+	}
+	if selectionText := a.selectionStatusText(); selectionText != "" {
+		parts = append(parts, selectionText)
+	}
+	if newIssuesText := a.newIssuesStatusText(); newIssuesText != "" {
+		parts = append(parts, newIssuesText)
+	}
 	parts = append(parts, statusText)
 
 	text := parts[0]
@@ -1987,6 +2297,9 @@ func (a *App) showCreateIssueModalWithParent(parentID string) {
 			issue, err := a.api.CreateIssue(ctx, input)
 			a.QueueUpdateDraw(func() {
 				if err != nil {
+					if a.queueOfflineMutation(err, outbox.NewCreateIssue(input)) {
+						return
+					}
 					logger.ErrorWithErr(err, "tui.app: failed to create issue title=%s", title)
 					a.updateStatusBarWithError(err)
 					return
@@ -2012,17 +2325,22 @@ func (a *App) ShowEditTitleModal() {
 	a.editTitleModal.Show(issue.ID, issue.Title, func(issueID, title string) {
 		go func() {
 			ctx := context.Background()
-			_, err := a.api.UpdateIssue(ctx, linearapi.UpdateIssueInput{
+			input := linearapi.UpdateIssueInput{
 				ID:    issueID,
 				Title: &title,
-			})
+			}
+			_, err := a.api.UpdateIssue(ctx, input)
 			a.QueueUpdateDraw(func() {
 				if err != nil {
+					if a.queueOfflineMutation(err, outbox.NewUpdateIssue(*issue, input)) {
+						return
+					}
 					logger.ErrorWithErr(err, "tui.app: failed to update issue title issue=%s", issue.Identifier)
 					a.updateStatusBarWithError(err)
 					return
 				}
 				logger.Info("tui.app: updated issue title issue=%s", issue.Identifier)
+				a.recordIssueUpdate("title edit", *issue, input)
 				go a.refreshIssues(issueID)
 			})
 		}()
@@ -2070,17 +2388,22 @@ func (a *App) ShowEditLabelsModal() {
 			a.editLabelsModal.Show(issue.ID, currentLabelIDs, availableLabels, func(issueID string, labelIDs []string) {
 				go func() {
 					ctx := context.Background()
-					_, err := a.api.UpdateIssue(ctx, linearapi.UpdateIssueInput{
+					input := linearapi.UpdateIssueInput{
 						ID:       issueID,
 						LabelIDs: &labelIDs,
-					})
+					}
+					_, err := a.api.UpdateIssue(ctx, input)
 					a.QueueUpdateDraw(func() {
 						if err != nil {
+							if a.queueOfflineMutation(err, outbox.NewUpdateIssue(*issue, input)) {
+								return
+							}
 							logger.ErrorWithErr(err, "tui.app: failed to update labels issue=%s", issue.Identifier)
 							a.updateStatusBarWithError(err)
 							return
 						}
 						logger.Info("tui.app: updated labels issue=%s", issue.Identifier)
+						a.recordIssueUpdate("label change", *issue, input)
 						go a.refreshIssues(issueID)
 					})
 				}()
@@ -28,6 +28,28 @@ func waitForCondition(t *testing.T, timeout time.Duration, check func() bool) {
 	t.Fatalf("condition not met within %s", timeout)
 }
 
+// onUI runs f holding the lock that serializes UI updates when queueUpdateDraw is
+// overridden, as if f ran on the UI goroutine.
+func onUI(app *App, f func()) {
+	app.uiUpdateMu.Lock()
+	defer app.uiUpdateMu.Unlock()
+	f()
+}
+
+// waitForRefresh waits until at least generation issue refreshes have started and the
+// last one has finished, so no refresh goroutine outlives the test.
+func waitForRefresh(t *testing.T, app *App, generation int64) {
+	t.Helper()
+	waitForCondition(t, time.Second, func() bool {
+		if app.refreshGeneration.Load() < generation {
+			return false
+		}
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return !app.isLoading
+	})
+}
+
 // TestRefreshIssues_LazyLoadsPages verifies first page renders before background pages.
 func TestRefreshIssues_LazyLoadsPages(t *testing.T) {
 	cfg := config.Config{
@@ -238,4 +260,5 @@ func TestRefreshIssues_IncludesStateID(t *testing.T) {
 	case <-time.After(time.Second):
 		t.Fatal("timed out waiting for fetchIssuesPage")
 	}
+	waitForRefresh(t, app, 1)
 }
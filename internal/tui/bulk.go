@@ -0,0 +1,584 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/outbox"
+)
+
+// bulkConcurrency is the maximum number of requests a bulk operation has in flight.
+const bulkConcurrency = 4
+
+// errBulkUnchanged marks an issue a bulk operation left alone because it already had the value.
+var errBulkUnchanged = errors.New("unchanged")
+
+// bulkResult is the outcome of a bulk operation on one issue.
+type bulkResult struct {
+	Issue  linearapi.Issue
+	Err    error
+	Queued bool       // The change was queued in the outbox while offline
+	Change undoChange // Reverts the change when it succeeded
+}
+
+// isIssueSelected reports whether an issue is part of the multi-selection.
+func (a *App) isIssueSelected(issueID string) bool {
+	return a.multiSelection[issueID]
+}
+
+// selectionBadge marks multi-selected issues in the issues tables.
+func (a *App) selectionBadge(issueID string) string {
+	if !a.isIssueSelected(issueID) {
+		return ""
+	}
+	return fmt.Sprintf("%s●[-] ", a.themeTags.Accent)
+}
+
+// sectionRows returns the displayed rows of an issues section.
+func (a *App) sectionRows(section IssuesSection) []IssueRow {
+	if section == IssuesSectionMy {
+		return a.myIssueRows
+	}
+	return a.otherIssueRows
+}
+
+// selectionChanged redraws the tables and status bar after the multi-selection changed.
+func (a *App) selectionChanged() {
+	a.redrawIssuesTables()
+	a.updateStatusBar()
+}
+
+// ToggleIssueSelection adds an issue to the multi-selection, or removes it when already selected.
+// The issue becomes the anchor for range selection.
+func (a *App) ToggleIssueSelection(issueID string) {
+	if issueID == "" {
+		return
+	}
+	if a.multiSelection[issueID] {
+		delete(a.multiSelection, issueID)
+	} else {
+		a.multiSelection[issueID] = true
+	}
+	a.selectionAnchorID = issueID
+	a.selectionChanged()
+}
+
+// SelectIssueRange selects every issue between the selection anchor and issueID in a section.
+// Without an anchor in that section only issueID is selected, and it becomes the anchor.
+func (a *App) SelectIssueRange(section IssuesSection, issueID string) {
+	rows := a.sectionRows(section)
+	anchor, target := -1, -1
+	for i, row := range rows {
+		if row.IssueID == a.selectionAnchorID {
+			anchor = i
+		}
+		if row.IssueID == issueID {
+			target = i
+		}
+	}
+	if target < 0 {
+		return
+	}
+	if anchor < 0 {
+		anchor = target
+		a.selectionAnchorID = issueID
+	}
+	for i := min(anchor, target); i <= max(anchor, target); i++ {
+		a.multiSelection[rows[i].IssueID] = true
+	}
+	a.selectionChanged()
+}
+
+// SelectAllVisible selects every issue shown in both issues tables, or clears the
+// selection when they are all selected already.
+func (a *App) SelectAllVisible() {
+	var visible []string
+	for _, row := range append(append([]IssueRow{}, a.myIssueRows...), a.otherIssueRows...) {
+		visible = append(visible, row.IssueID)
+	}
+	allSelected := len(visible) > 0
+	for _, id := range visible {
+		if !a.multiSelection[id] {
+			allSelected = false
+			break
+		}
+	}
+	if allSelected {
+		a.ClearIssueSelection()
+		return
+	}
+	for _, id := range visible {
+		a.multiSelection[id] = true
+	}
+	a.selectionChanged()
+}
+
+// ClearIssueSelection empties the multi-selection.
+func (a *App) ClearIssueSelection() {
+	if len(a.multiSelection) == 0 {
+		return
+	}
+	a.multiSelection = make(map[string]bool)
+	a.selectionAnchorID = ""
+	a.selectionChanged()
+}
+
+// selectedIssues returns the multi-selected issues that are still loaded, in display order.
+func (a *App) selectedIssues() []linearapi.Issue {
+	if len(a.multiSelection) == 0 {
+		return nil
+	}
+	var issues []linearapi.Issue
+	seen := make(map[string]bool, len(a.multiSelection))
+	for _, row := range append(append([]IssueRow{}, a.myIssueRows...), a.otherIssueRows...) {
+		if !a.multiSelection[row.IssueID] || seen[row.IssueID] {
+			continue
+		}
+		if issue := a.idToIssue[row.IssueID]; issue != nil {
+			seen[row.IssueID] = true
+			issues = append(issues, *issue)
+		}
+	}
+	// Selected issues hidden by a collapsed parent are still included
+	var hidden []linearapi.Issue
+	for id := range a.multiSelection {
+		if issue := a.idToIssue[id]; issue != nil && !seen[id] {
+			hidden = append(hidden, *issue)
+		}
+	}
+	sort.Slice(hidden, func(i, j int) bool { return hidden[i].Identifier < hidden[j].Identifier })
+	return append(issues, hidden...)
+}
+
+// selectionStatusText returns the status bar segment for the multi-selection.
+func (a *App) selectionStatusText() string {
+	if len(a.multiSelection) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s%d selected[-]", a.themeTags.Accent, len(a.multiSelection))
+}
+
+// runBulk runs op on every issue with at most bulkConcurrency requests in flight, then
+// reports a per-issue summary and records the successful changes as one undo step named
+// what. offline returns the outbox mutation to queue when Linear is unreachable; nil
+// disables queuing. Failed issues stay selected so they can be retried.
+func (a *App) runBulk(action, what string, issues []linearapi.Issue, op func(context.Context, linearapi.Issue) (undoChange, error), offline func(linearapi.Issue) outbox.Mutation) {
+	if len(issues) == 0 {
+		return
+	}
+	logger.Info("tui.bulk: starting bulk operation action=%q count=%d", action, len(issues))
+	a.statusBar.SetText(fmt.Sprintf("%s%s: %d issues...[-]", a.themeTags.Accent, action, len(issues)))
+
+	go func() {
+		results := make([]bulkResult, len(issues))
+		sem := make(chan struct{}, bulkConcurrency)
+		var wg sync.WaitGroup
+		for i, issue := range issues {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i int, issue linearapi.Issue) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				change, err := op(context.Background(), issue)
+				results[i] = bulkResult{Issue: issue, Err: err, Change: change}
+			}(i, issue)
+		}
+		wg.Wait()
+
+		a.QueueUpdateDraw(func() {
+			for i := range results {
+				result := &results[i]
+				if result.Err == nil || errors.Is(result.Err, errBulkUnchanged) || offline == nil {
+					continue
+				}
+				if a.queueOfflineMutation(result.Err, offline(result.Issue)) {
+					result.Queued = true
+				}
+			}
+			a.finishBulk(action, what, results)
+		})
+	}()
+}
+
+// finishBulk deselects the issues that succeeded, shows the per-issue summary and refreshes the issues.
+func (a *App) finishBulk(action, what string, results []bulkResult) {
+	var updated, unchanged, queued, failed int
+	var failedItems, items []PickerItem
+	var changes []undoChange
+	for _, result := range results {
+		issue := result.Issue
+		label := fmt.Sprintf("%s %s", issue.Identifier, issue.Title)
+		switch {
+		case result.Queued:
+			queued++
+			label = "⏸ " + label + " - queued offline"
+		case errors.Is(result.Err, errBulkUnchanged):
+			unchanged++
+			label = "= " + label + " - unchanged"
+		case result.Err != nil:
+			// Failed issues stay selected for a retry
+			failed++
+			logger.ErrorWithErr(result.Err, "tui.bulk: %s failed issue=%s", action, issue.Identifier)
+			failedItems = append(failedItems, PickerItem{ID: issue.ID, Label: "✗ " + label + " - " + result.Err.Error()})
+			continue
+		default:
+			updated++
+			label = "✓ " + label
+			changes = append(changes, result.Change)
+		}
+		delete(a.multiSelection, issue.ID)
+		items = append(items, PickerItem{ID: issue.ID, Label: label})
+	}
+	// List failures first so they are visible without scrolling
+	items = append(failedItems, items...)
+
+	parts := []string{fmt.Sprintf("%d updated", updated)}
+	if unchanged > 0 {
+		parts = append(parts, fmt.Sprintf("%d unchanged", unchanged))
+	}
+	if queued > 0 {
+		parts = append(parts, fmt.Sprintf("%d queued offline", queued))
+	}
+	if failed > 0 {
+		parts = append(parts, fmt.Sprintf("%d failed", failed))
+	}
+	summary := fmt.Sprintf("%s: %s", action, strings.Join(parts, ", "))
+	logger.Info("tui.bulk: finished bulk operation action=%q updated=%d unchanged=%d queued=%d failed=%d", action, updated, unchanged, queued, failed)
+
+	a.recordUndo(what, changes...)
+	a.redrawIssuesTables()
+	color := a.themeTags.Accent
+	if failed > 0 {
+		color = a.themeTags.Error
+	}
+	a.statusBar.SetText(fmt.Sprintf("%s%s[-]", color, summary))
+
+	if len(results) > 1 {
+		a.pickerActive = true
+		a.pickerModal.Show(summary, items, func(PickerItem) {
+			a.pickerActive = false
+		})
+	}
+	if updated > 0 {
+		go a.refreshIssues()
+	}
+}
+
+// bulkUpdate updates every issue with the input build returns. build returns errBulkUnchanged
+// for issues that already have the value, or another error to fail an issue without a request.
+func (a *App) bulkUpdate(action, what string, issues []linearapi.Issue, build func(linearapi.Issue) (linearapi.UpdateIssueInput, error)) {
+	updateIssue := a.updateIssue
+	if updateIssue == nil {
+		updateIssue = a.GetAPI().UpdateIssue
+	}
+	inputs := make(map[string]linearapi.UpdateIssueInput, len(issues))
+	var mu sync.Mutex
+	a.runBulk(action, what, issues, func(ctx context.Context, issue linearapi.Issue) (undoChange, error) {
+		input, err := build(issue)
+		if err != nil {
+			return undoChange{}, err
+		}
+		mu.Lock()
+		inputs[issue.ID] = input
+		mu.Unlock()
+		_, err = updateIssue(ctx, input)
+		return updateChange(issue, input), err
+	}, func(issue linearapi.Issue) outbox.Mutation {
+		return outbox.NewUpdateIssue(issue, inputs[issue.ID])
+	})
+}
+
+// BulkChangeStatus moves the selected issues to a workflow state of their team. Issues of
+// different teams are refused, as workflow states belong to one team.
+func (a *App) BulkChangeStatus(issues []linearapi.Issue) {
+	teamID, err := a.bulkSingleTeamID(issues)
+	if err != nil {
+		a.updateStatusBarWithError(err)
+		return
+	}
+	apply := func(stateID string) {
+		a.bulkUpdate("Change status", "status change", issues, func(issue linearapi.Issue) (linearapi.UpdateIssueInput, error) {
+			if issue.StateID == stateID {
+				return linearapi.UpdateIssueInput{}, errBulkUnchanged
+			}
+			return linearapi.UpdateIssueInput{ID: issue.ID, StateID: &stateID}, nil
+		})
+	}
+	if teamID == "" {
+		a.ShowStatusPicker(apply)
+		return
+	}
+	go func() {
+		states, err := a.cache.GetWorkflowStates(context.Background(), teamID)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.bulk: failed to load workflow states team_id=%s", teamID)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			a.showStatusPickerWithStates(states, apply)
+		})
+	}()
+}
+
+// BulkAssign assigns the selected issues to userID; an empty userID unassigns them.
+func (a *App) BulkAssign(issues []linearapi.Issue, userID string) {
+	action := "Assign"
+	if userID == "" {
+		action = "Unassign"
+	}
+	a.bulkUpdate(action, "assignee change", issues, func(issue linearapi.Issue) (linearapi.UpdateIssueInput, error) {
+		if issue.AssigneeID == userID {
+			return linearapi.UpdateIssueInput{}, errBulkUnchanged
+		}
+		return linearapi.UpdateIssueInput{ID: issue.ID, AssigneeID: &userID}, nil
+	})
+}
+
+// BulkAssignUser asks for a team member and assigns the selected issues to them.
+func (a *App) BulkAssignUser(issues []linearapi.Issue) {
+	a.ShowUserPicker(func(userID string) {
+		a.BulkAssign(issues, userID)
+	})
+}
+
+// ShowPriorityPicker asks for a priority and sets it on issues.
+func (a *App) ShowPriorityPicker(issues []linearapi.Issue) {
+	if len(issues) == 0 {
+		return
+	}
+	items := make([]PickerItem, 0, 5)
+	for _, priority := range []int{1, 2, 3, 4, 0} {
+		label := linearapi.PriorityLabel(priority)
+		if len(issues) == 1 && issues[0].Priority == priority {
+			label += " - current"
+		}
+		items = append(items, PickerItem{ID: fmt.Sprint(priority), Label: label})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show("Set Priority", items, func(item PickerItem) {
+		a.pickerActive = false
+		var priority int
+		_, _ = fmt.Sscan(item.ID, &priority)
+		if len(issues) == 1 {
+			if issues[0].Priority != priority {
+				a.updateIssueField(issues[0], linearapi.UpdateIssueInput{ID: issues[0].ID, Priority: &priority}, "priority")
+			}
+			return
+		}
+		a.bulkUpdate("Set priority", "priority change", issues, func(issue linearapi.Issue) (linearapi.UpdateIssueInput, error) {
+			if issue.Priority == priority {
+				return linearapi.UpdateIssueInput{}, errBulkUnchanged
+			}
+			return linearapi.UpdateIssueInput{ID: issue.ID, Priority: &priority}, nil
+		})
+	})
+}
+
+// issueLabelIDs returns the label IDs of an issue.
+func issueLabelIDs(issue linearapi.Issue) []string {
+	ids := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		ids = append(ids, label.ID)
+	}
+	return ids
+}
+
+// withLabel returns the issue's label IDs with labelID added, and false when it already has it.
+func withLabel(issue linearapi.Issue, labelID string) ([]string, bool) {
+	ids := issueLabelIDs(issue)
+	for _, id := range ids {
+		if id == labelID {
+			return ids, false
+		}
+	}
+	return append(ids, labelID), true
+}
+
+// withoutLabelNamed returns the issue's label IDs without labels called name, and false when
+// it has none.
+func withoutLabelNamed(issue linearapi.Issue, name string) ([]string, bool) {
+	ids := make([]string, 0, len(issue.Labels))
+	removed := false
+	for _, label := range issue.Labels {
+		if label.Name == name {
+			removed = true
+			continue
+		}
+		ids = append(ids, label.ID)
+	}
+	return ids, removed
+}
+
+// bulkTeamID returns the team of the first issue, falling back to the selected team.
+func (a *App) bulkTeamID(issues []linearapi.Issue) string {
+	if len(issues) > 0 && issues[0].TeamID != "" {
+		return issues[0].TeamID
+	}
+	return a.GetSelectedTeamID()
+}
+
+// bulkSingleTeamID returns the team of issues like bulkTeamID, or an error when they belong
+// to different teams.
+func (a *App) bulkSingleTeamID(issues []linearapi.Issue) (string, error) {
+	teamID := a.bulkTeamID(issues)
+	for _, issue := range issues {
+		if issue.TeamID != "" && issue.TeamID != teamID {
+			return "", fmt.Errorf("selected issues belong to different teams")
+		}
+	}
+	return teamID, nil
+}
+
+// ShowAddLabelPicker asks for a team label and adds it to issues, keeping their other labels.
+// Issues of different teams are refused, as team labels belong to one team.
+func (a *App) ShowAddLabelPicker(issues []linearapi.Issue) {
+	teamID, err := a.bulkSingleTeamID(issues)
+	if err != nil {
+		a.updateStatusBarWithError(err)
+		return
+	}
+	if len(issues) == 0 || teamID == "" {
+		return
+	}
+	go func() {
+		labels, err := a.cache.GetIssueLabels(context.Background(), teamID)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.bulk: failed to load labels team_id=%s", teamID)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			items := make([]PickerItem, 0, len(labels))
+			for _, label := range labels {
+				items = append(items, PickerItem{ID: label.ID, Label: label.Name})
+			}
+			a.pickerActive = true
+			a.pickerModal.Show("Add Label", items, func(item PickerItem) {
+				a.pickerActive = false
+				a.bulkUpdate("Add label "+item.Label, "label change", issues, func(issue linearapi.Issue) (linearapi.UpdateIssueInput, error) {
+					ids, changed := withLabel(issue, item.ID)
+					if !changed {
+						return linearapi.UpdateIssueInput{}, errBulkUnchanged
+					}
+					return linearapi.UpdateIssueInput{ID: issue.ID, LabelIDs: &ids}, nil
+				})
+			})
+		})
+	}()
+}
+
+// ShowRemoveLabelPicker asks for one of the issues' labels and removes it from all of them.
+// Labels are matched by name, so a team label is removed from issues of every team.
+func (a *App) ShowRemoveLabelPicker(issues []linearapi.Issue) {
+	var items []PickerItem
+	seen := make(map[string]bool)
+	for _, issue := range issues {
+		for _, label := range issue.Labels {
+			if !seen[label.Name] {
+				seen[label.Name] = true
+				items = append(items, PickerItem{ID: label.Name, Label: label.Name})
+			}
+		}
+	}
+	if len(items) == 0 {
+		a.updateStatusBarWithError(fmt.Errorf("no labels to remove"))
+		return
+	}
+	a.pickerActive = true
+	a.pickerModal.Show("Remove Label", items, func(item PickerItem) {
+		a.pickerActive = false
+		a.bulkUpdate("Remove label "+item.Label, "label change", issues, func(issue linearapi.Issue) (linearapi.UpdateIssueInput, error) {
+			ids, changed := withoutLabelNamed(issue, item.Label)
+			if !changed {
+				return linearapi.UpdateIssueInput{}, errBulkUnchanged
+			}
+			return linearapi.UpdateIssueInput{ID: issue.ID, LabelIDs: &ids}, nil
+		})
+	})
+}
+
+// BulkChangeProject moves the selected issues to a project of the first issue's team.
+func (a *App) BulkChangeProject(issues []linearapi.Issue) {
+	teamID := a.bulkTeamID(issues)
+	go func() {
+		projects, err := a.cache.GetProjects(context.Background(), teamID)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.bulk: failed to load projects team_id=%s", teamID)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			items := []PickerItem{{ID: "", Label: "No project"}}
+			for _, project := range projects {
+				items = append(items, PickerItem{ID: project.ID, Label: project.Name})
+			}
+			a.pickerActive = true
+			a.pickerModal.Show("Change Project", items, func(item PickerItem) {
+				a.pickerActive = false
+				projectID := item.ID
+				a.bulkUpdate("Change project", "project change", issues, func(issue linearapi.Issue) (linearapi.UpdateIssueInput, error) {
+					if issue.ProjectID == projectID {
+						return linearapi.UpdateIssueInput{}, errBulkUnchanged
+					}
+					return linearapi.UpdateIssueInput{ID: issue.ID, ProjectID: &projectID}, nil
+				})
+			})
+		})
+	}()
+}
+
+// BulkSetParent makes the selected issues sub-issues of a picked issue. Issues that have
+// sub-issues of their own, or are the picked parent, fail without a request.
+func (a *App) BulkSetParent(issues []linearapi.Issue) {
+	a.ShowParentIssuePicker(func(parentID string) {
+		a.bulkUpdate("Set parent", "parent change", issues, func(issue linearapi.Issue) (linearapi.UpdateIssueInput, error) {
+			switch {
+			case issue.ID == parentID:
+				return linearapi.UpdateIssueInput{}, fmt.Errorf("an issue cannot be its own parent")
+			case len(issue.Children) > 0:
+				return linearapi.UpdateIssueInput{}, fmt.Errorf("issue has sub-issues")
+			case issue.Parent != nil && issue.Parent.ID == parentID:
+				return linearapi.UpdateIssueInput{}, errBulkUnchanged
+			}
+			return linearapi.UpdateIssueInput{ID: issue.ID, ParentID: &parentID}, nil
+		})
+	})
+}
+
+// BulkArchive archives the selected issues after confirmation.
+func (a *App) BulkArchive(issues []linearapi.Issue) {
+	items := []PickerItem{
+		{ID: "archive", Label: fmt.Sprintf("Archive %d issues", len(issues))},
+		{ID: "cancel", Label: "Cancel"},
+	}
+	a.pickerActive = true
+	a.pickerModal.Show(fmt.Sprintf("Archive %d issues?", len(issues)), items, func(item PickerItem) {
+		a.pickerActive = false
+		if item.ID != "archive" {
+			return
+		}
+		a.runBulk("Archive", "archive", issues, func(ctx context.Context, issue linearapi.Issue) (undoChange, error) {
+			return archiveChange(issue), a.GetAPI().ArchiveIssue(ctx, issue.ID)
+		}, outbox.NewArchiveIssue)
+	})
+}
+
+// commandTargets returns the issues a command acts on: the multi-selection when there is
+// one, otherwise the selected issue.
+func (a *App) commandTargets() []linearapi.Issue {
+	if issues := a.selectedIssues(); len(issues) > 0 {
+		return issues
+	}
+	if issue := a.GetSelectedIssue(); issue != nil {
+		return []linearapi.Issue{*issue}
+	}
+	return nil
+}
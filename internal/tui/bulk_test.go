@@ -0,0 +1,189 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// newBulkTestApp returns an app showing count issues ABC-1..ABC-count, with refreshes returning the same issues.
+func newBulkTestApp(t *testing.T, count int) (*App, []linearapi.Issue) {
+	t.Helper()
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+	app.queueUpdateDraw = func(f func()) { f() }
+	issues := make([]linearapi.Issue, 0, count)
+	for i := 1; i <= count; i++ {
+		issues = append(issues, linearapi.Issue{ID: fmt.Sprintf("issue-%d", i), Identifier: fmt.Sprintf("ABC-%d", i), Title: "Task"})
+	}
+	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
+		for _, issue := range issues {
+			if issue.ID == id {
+				return issue, nil
+			}
+		}
+		return linearapi.Issue{}, fmt.Errorf("not found")
+	}
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		return linearapi.IssuePage{Issues: issues}, nil
+	}
+	app.updateIssuesData(issues)
+	// Wait for the details fetch started by the initial selection to replace the selected issue
+	initial := app.selectedIssue
+	waitForCondition(t, time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return app.selectedIssue != initial
+	})
+	return app, issues
+}
+
+// selectedIdentifiers returns the identifiers of the multi-selected issues in order.
+func selectedIdentifiers(app *App) string {
+	var ids []string
+	for _, issue := range app.selectedIssues() {
+		ids = append(ids, issue.Identifier)
+	}
+	return strings.Join(ids, ",")
+}
+
+// TestIssueSelection_ToggleRangeAndSelectAll verifies Space, V and select-all semantics.
+func TestIssueSelection_ToggleRangeAndSelectAll(t *testing.T) {
+	app, _ := newBulkTestApp(t, 5)
+
+	app.ToggleIssueSelection("issue-4")
+	app.SelectIssueRange(IssuesSectionOther, "issue-2")
+	if got := selectedIdentifiers(app); got != "ABC-2,ABC-3,ABC-4" {
+		t.Fatalf("selection after range = %s", got)
+	}
+	if !strings.Contains(app.statusBar.GetText(true), "3 selected") {
+		t.Fatalf("status = %q, want selection count", app.statusBar.GetText(true))
+	}
+	if title := app.otherIssuesTable.GetCell(2, 5).Text; !strings.Contains(title, "●") {
+		t.Fatalf("selected row title = %q, want selection marker", title)
+	}
+
+	app.ToggleIssueSelection("issue-3")
+	if got := selectedIdentifiers(app); got != "ABC-2,ABC-4" {
+		t.Fatalf("selection after toggle = %s", got)
+	}
+
+	app.SelectAllVisible()
+	if got := len(app.selectedIssues()); got != 5 {
+		t.Fatalf("select all selected %d issues, want 5", got)
+	}
+	app.SelectAllVisible()
+	if got := len(app.selectedIssues()); got != 0 {
+		t.Fatalf("second select all left %d issues selected, want 0", got)
+	}
+}
+
+// TestBulkAssign_BoundedConcurrencyAndSummary verifies requests stay within bulkConcurrency
+// and that only failed issues stay selected.
+func TestBulkAssign_BoundedConcurrencyAndSummary(t *testing.T) {
+	app, issues := newBulkTestApp(t, 10)
+	for _, issue := range issues {
+		app.multiSelection[issue.ID] = true
+	}
+	app.idToIssue["issue-1"].AssigneeID = "user-1"
+
+	var mu sync.Mutex
+	inFlight, maxInFlight, requests := 0, 0, 0
+	app.updateIssue = func(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+		mu.Lock()
+		inFlight++
+		requests++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		if input.ID == "issue-2" {
+			return linearapi.Issue{}, errors.New("permission denied")
+		}
+		return linearapi.Issue{ID: input.ID}, nil
+	}
+
+	onUI(app, func() {
+		app.BulkAssign(app.selectedIssues(), "user-1")
+	})
+	// The summary picker is shown when the bulk run completes
+	waitForCondition(t, 2*time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return app.pages.HasPage("picker")
+	})
+	// Finishing the run refreshes the issue list
+	waitForRefresh(t, app, 1)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if requests != 9 || maxInFlight > bulkConcurrency {
+		t.Fatalf("requests = %d, max in flight = %d; want 9 requests, at most %d in flight", requests, maxInFlight, bulkConcurrency)
+	}
+	onUI(app, func() {
+		if title := app.pickerModal.titleView.GetText(true); !strings.Contains(title, "Assign: 8 updated, 1 unchanged, 1 failed") {
+			t.Fatalf("summary title = %q", title)
+		}
+		if len(app.multiSelection) != 1 || !app.multiSelection["issue-2"] {
+			t.Fatalf("selection = %v, want only the failed issue", app.multiSelection)
+		}
+		if first := app.pickerModal.items[0].Label; !strings.HasPrefix(first, "✗ ABC-2") || !strings.Contains(first, "permission denied") {
+			t.Fatalf("first summary item = %q, want the failure", first)
+		}
+	})
+}
+
+// TestBulkTeamActions_MixedTeams verifies status and label changes refuse issues of different
+// teams, while labels are removed by name from issues of every team.
+func TestBulkTeamActions_MixedTeams(t *testing.T) {
+	app, issues := newBulkTestApp(t, 2)
+	issues[0].TeamID, issues[1].TeamID = "team-1", "team-2"
+	issues[0].Labels = []linearapi.IssueLabel{{ID: "label-1", Name: "Bug"}, {ID: "label-2", Name: "UI"}}
+	issues[1].Labels = []linearapi.IssueLabel{{ID: "label-3", Name: "Bug"}}
+
+	var mu sync.Mutex
+	labels := make(map[string][]string)
+	app.updateIssue = func(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		labels[input.ID] = *input.LabelIDs
+		return linearapi.Issue{ID: input.ID}, nil
+	}
+
+	onUI(app, func() {
+		app.BulkChangeStatus(issues)
+		if app.pickerActive || !strings.Contains(app.statusBar.GetText(true), "different teams") {
+			t.Fatalf("status change on mixed teams: picker = %t status = %q", app.pickerActive, app.statusBar.GetText(true))
+		}
+		app.ShowAddLabelPicker(issues)
+		if app.pickerActive {
+			t.Fatal("add label picker shown for mixed teams")
+		}
+
+		app.ShowRemoveLabelPicker(issues)
+		if len(app.pickerModal.items) != 2 || app.pickerModal.items[0].Label != "Bug" {
+			t.Fatalf("remove label items = %+v, want Bug and UI once each", app.pickerModal.items)
+		}
+		app.pickerModal.onSelect(app.pickerModal.items[0])
+	})
+	waitForCondition(t, 2*time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return app.pages.HasPage("picker") && strings.Contains(app.pickerModal.titleView.GetText(true), "2 updated")
+	})
+	waitForRefresh(t, app, 1)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got := fmt.Sprint(labels); got != "map[issue-1:[label-2] issue-2:[]]" {
+		t.Fatalf("label updates = %s, want Bug removed from both teams", got)
+	}
+}
@@ -8,8 +8,10 @@ import (
 	"strings"
 
 	"github.com/roeyazroel/linear-tui/internal/agents"
+	"github.com/roeyazroel/linear-tui/internal/config"
 	"github.com/roeyazroel/linear-tui/internal/linearapi"
 	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/outbox"
 )
 
 // FormatShortcut returns a human-readable string for a shortcut.
@@ -36,8 +38,8 @@ type CommandContext struct {
 }
 
 // handleAskAgent handles the ask agent command.
-// It collects the prompt, resolves the command binary, stores a PendingExecCommand,
-// and stops the TUI so that main.go can exec the agent interactively.
+// It collects the prompt, builds a provider from the chosen command template,
+// and streams the run into the agent output pane while the TUI stays usable.
 func handleAskAgent(a *App) {
 	issue := a.GetSelectedIssue()
 	if issue == nil {
@@ -50,7 +52,7 @@ func handleAskAgent(a *App) {
 	}
 
 	issueID := issue.ID
-	a.agentPromptModal.Show(func(prompt string, workspace string, command string) {
+	a.agentPromptModal.Show(func(prompt string, workspace string, command string, useWorktree bool) {
 		prompt = strings.TrimSpace(prompt)
 		if prompt == "" {
 			return
@@ -78,59 +80,48 @@ func handleAskAgent(a *App) {
 				return
 			}
 
-			// Auto-set issue to "In Progress" and assign to current user
-			user := a.GetCurrentUser()
-			if user != nil {
-				states, err := a.cache.GetWorkflowStates(ctx, fullIssue.TeamID)
-				if err == nil {
-					var startedStateID *string
-					for _, s := range states {
-						if s.Type == "started" {
-							id := s.ID
-							startedStateID = &id
-							break
-						}
-					}
-					updateInput := linearapi.UpdateIssueInput{
-						ID:         fullIssue.ID,
-						AssigneeID: &user.ID,
-					}
-					if startedStateID != nil {
-						updateInput.StateID = startedStateID
-					}
-					if _, err := a.GetAPI().UpdateIssue(ctx, updateInput); err != nil {
-						logger.ErrorWithErr(err, "tui.commands: failed to update issue status/assignee issue=%s", fullIssue.Identifier)
-					}
-				} else {
-					logger.ErrorWithErr(err, "tui.commands: failed to fetch workflow states for team=%s", fullIssue.TeamID)
+			// Render template variables before any hook touches the issue
+			templateData := a.agentTemplateData(ctx, fullIssue)
+			prompt, err = agents.RenderTemplate(prompt, templateData)
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.commands: failed to render agent prompt issue=%s", fullIssue.Identifier)
+				a.QueueUpdateDraw(func() {
+					a.updateStatusBarWithError(fmt.Errorf("agent prompt: %w", err))
+				})
+				return
+			}
+
+			if useWorktree {
+				worktree, err := a.ensureAgentWorktree(ctx, workspace, fullIssue)
+				if err != nil {
+					logger.ErrorWithErr(err, "tui.commands: failed to prepare worktree issue=%s branch=%s", fullIssue.Identifier, fullIssue.BranchName)
+					a.QueueUpdateDraw(func() {
+						a.updateStatusBarWithError(err)
+					})
+					return
 				}
+				workspace = worktree
 			}
 
 			issueContext := agents.BuildIssueContext(fullIssue)
-			fullPrompt := agents.BuildAgentPrompt(prompt, issueContext)
 
-			parseCommand := a.parseCommand
-			if parseCommand == nil {
-				parseCommand = agents.ParseCommand
+			newProvider := a.newAgentProvider
+			if newProvider == nil {
+				newProvider = func(commandTemplate string, data agents.TemplateData) (agents.Provider, error) {
+					return agents.NewCommandProvider(commandTemplate, data, nil)
+				}
 			}
 
-			binary, args, err := parseCommand(command, fullPrompt, fullIssue.BranchName)
+			provider, err := newProvider(command, templateData)
+			if err == nil {
+				err = a.startAgentRun(fullIssue, command, provider, prompt, issueContext, workspace)
+			}
 			if err != nil {
-				logger.ErrorWithErr(err, "tui.commands: failed to parse agent command")
+				logger.ErrorWithErr(err, "tui.commands: failed to start agent issue=%s", fullIssue.Identifier)
 				a.QueueUpdateDraw(func() {
 					a.updateStatusBarWithError(err)
 				})
-				return
 			}
-
-			a.QueueUpdateDraw(func() {
-				a.pendingExec = &PendingExecCommand{
-					Binary: binary,
-					Args:   args,
-					Dir:    workspace,
-				}
-				a.app.Stop()
-			})
 		}()
 	})
 }
@@ -208,6 +199,42 @@ func DefaultCommands(app *App) []Command {
 				a.setSortField(SortByPriority)
 			},
 		},
+		{
+			ID:       "save_view",
+			Title:    "Save current view",
+			Keywords: []string{"view", "save", "filter", "saved"},
+			Run: func(a *App) {
+				a.savedViewModal.Show(a.currentViewDraft(), "")
+			},
+		},
+		{
+			ID:       "edit_view",
+			Title:    "Edit saved view",
+			Keywords: []string{"view", "edit", "filter", "saved"},
+			Run: func(a *App) {
+				a.showSavedViewPicker("Edit View", func(view config.SavedView) {
+					a.savedViewModal.Show(view, view.Name)
+				})
+			},
+		},
+		{
+			ID:       "delete_view",
+			Title:    "Delete saved view",
+			Keywords: []string{"view", "delete", "remove", "filter", "saved"},
+			Run: func(a *App) {
+				a.showSavedViewPicker("Delete View", func(view config.SavedView) {
+					a.confirmDeleteSavedView(view.Name)
+				})
+			},
+		},
+		{
+			ID:       "reload_linear_views",
+			Title:    "Reload Linear views and favorites",
+			Keywords: []string{"view", "views", "favorite", "favorites", "reload", "linear"},
+			Run: func(a *App) {
+				go a.loadLinearViews(context.Background())
+			},
+		},
 		{
 			ID:           "open_browser",
 			Title:        "Open in browser",
@@ -254,12 +281,83 @@ func DefaultCommands(app *App) []Command {
 			ShortcutRune: 'a',
 			Run:          handleAskAgent,
 		},
+		{
+			ID:       "agent_output",
+			Title:    "Toggle agent output",
+			Keywords: []string{"agent", "output", "stream", "log", "pane"},
+			Run: func(a *App) {
+				a.toggleAgentOutput()
+			},
+		},
+		{
+			ID:       "agent_jobs",
+			Title:    "Agent jobs",
+			Keywords: []string{"agent", "jobs", "runs", "running", "queue", "resume"},
+			Run: func(a *App) {
+				a.ShowAgentJobs()
+			},
+		},
+		{
+			ID:       "agent_history",
+			Title:    "Agent run history",
+			Keywords: []string{"agent", "history", "runs", "past", "transcript", "resume"},
+			Run: func(a *App) {
+				a.ShowAgentHistory()
+			},
+		},
+		{
+			ID:       "agent_worktrees",
+			Title:    "Agent worktrees",
+			Keywords: []string{"agent", "worktree", "worktrees", "git", "branch", "remove"},
+			Run: func(a *App) {
+				a.ShowAgentWorktrees()
+			},
+		},
+		{
+			ID:       "cleanup_worktrees",
+			Title:    "Clean up stale agent worktrees",
+			Keywords: []string{"agent", "worktree", "worktrees", "git", "clean", "prune", "stale", "merged"},
+			Run: func(a *App) {
+				a.cleanupAgentWorktrees()
+			},
+		},
+		{
+			ID:       "cancel_agent",
+			Title:    "Cancel agent run",
+			Keywords: []string{"agent", "cancel", "stop", "kill", "abort"},
+			Run: func(a *App) {
+				a.cancelAgentRun()
+			},
+		},
+		{
+			ID:           "undo",
+			Title:        "Undo last change",
+			Keywords:     []string{"undo", "revert", "back"},
+			ShortcutRune: 'u',
+			Run: func(a *App) {
+				a.Undo()
+			},
+		},
+		{
+			ID:       "redo",
+			Title:    "Redo change",
+			Keywords: []string{"redo", "again", "ctrl-r"},
+			Run: func(a *App) {
+				a.Redo()
+			},
+		},
 		{
 			ID:           "assign_me",
 			Title:        "Assign to me",
 			Keywords:     []string{"assign", "me", "self", "take"},
 			ShortcutRune: 'm',
 			Run: func(a *App) {
+				if issues := a.selectedIssues(); len(issues) > 0 {
+					if user := a.GetCurrentUser(); user != nil {
+						a.BulkAssign(issues, user.ID)
+					}
+					return
+				}
 				issue := a.GetSelectedIssue()
 				user := a.GetCurrentUser()
 				if issue == nil || user == nil {
@@ -267,17 +365,22 @@ func DefaultCommands(app *App) []Command {
 				}
 				go func() {
 					ctx := context.Background()
-					_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+					input := linearapi.UpdateIssueInput{
 						ID:         issue.ID,
 						AssigneeID: &user.ID,
-					})
+					}
+					_, err := a.GetAPI().UpdateIssue(ctx, input)
 					a.QueueUpdateDraw(func() {
 						if err != nil {
+							if a.queueOfflineMutation(err, outbox.NewUpdateIssue(*issue, input)) {
+								return
+							}
 							logger.ErrorWithErr(err, "tui.commands: failed to assign issue issue=%s user=%s", issue.Identifier, user.DisplayName)
 							a.updateStatusBarWithError(err)
 							return
 						}
 						logger.Info("tui.commands: assigned issue issue=%s user=%s", issue.Identifier, user.DisplayName)
+						a.recordIssueUpdate("assignee change", *issue, input)
 						go a.refreshIssues(issue.ID)
 					})
 				}()
@@ -287,8 +390,12 @@ func DefaultCommands(app *App) []Command {
 			ID:           "unassign",
 			Title:        "Unassign issue",
 			Keywords:     []string{"unassign", "remove", "clear assignee"},
-			ShortcutRune: 'u',
+			ShortcutRune: 'U',
 			Run: func(a *App) {
+				if issues := a.selectedIssues(); len(issues) > 0 {
+					a.BulkAssign(issues, "")
+					return
+				}
 				issue := a.GetSelectedIssue()
 				if issue == nil {
 					return
@@ -296,17 +403,22 @@ func DefaultCommands(app *App) []Command {
 				emptyAssignee := ""
 				go func() {
 					ctx := context.Background()
-					_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+					input := linearapi.UpdateIssueInput{
 						ID:         issue.ID,
 						AssigneeID: &emptyAssignee,
-					})
+					}
+					_, err := a.GetAPI().UpdateIssue(ctx, input)
 					a.QueueUpdateDraw(func() {
 						if err != nil {
+							if a.queueOfflineMutation(err, outbox.NewUpdateIssue(*issue, input)) {
+								return
+							}
 							logger.ErrorWithErr(err, "tui.commands: failed to unassign issue issue=%s", issue.Identifier)
 							a.updateStatusBarWithError(err)
 							return
 						}
 						logger.Info("tui.commands: unassigned issue issue=%s", issue.Identifier)
+						a.recordIssueUpdate("assignee change", *issue, input)
 						go a.refreshIssues(issue.ID)
 					})
 				}()
@@ -318,6 +430,10 @@ func DefaultCommands(app *App) []Command {
 			Keywords:     []string{"archive", "delete", "remove"},
 			ShortcutRune: 'x',
 			Run: func(a *App) {
+				if issues := a.selectedIssues(); len(issues) > 0 {
+					a.BulkArchive(issues)
+					return
+				}
 				issue := a.GetSelectedIssue()
 				if issue == nil {
 					return
@@ -327,11 +443,15 @@ func DefaultCommands(app *App) []Command {
 					err := a.GetAPI().ArchiveIssue(ctx, issue.ID)
 					a.QueueUpdateDraw(func() {
 						if err != nil {
+							if a.queueOfflineMutation(err, outbox.NewArchiveIssue(*issue)) {
+								return
+							}
 							logger.ErrorWithErr(err, "tui.commands: failed to archive issue issue=%s", issue.Identifier)
 							a.updateStatusBarWithError(err)
 							return
 						}
 						logger.Info("tui.commands: archived issue issue=%s", issue.Identifier)
+						a.recordUndo("archive", archiveChange(*issue))
 						// After archiving, the issue won't be in the list, so just refresh without ID
 						go a.refreshIssues()
 					})
@@ -344,6 +464,10 @@ func DefaultCommands(app *App) []Command {
 			Keywords:     []string{"status", "state", "workflow", "todo", "progress", "done"},
 			ShortcutRune: 's',
 			Run: func(a *App) {
+				if issues := a.selectedIssues(); len(issues) > 0 {
+					a.BulkChangeStatus(issues)
+					return
+				}
 				issue := a.GetSelectedIssue()
 				if issue == nil {
 					return
@@ -351,17 +475,22 @@ func DefaultCommands(app *App) []Command {
 				a.ShowStatusPicker(func(stateID string) {
 					go func() {
 						ctx := context.Background()
-						_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+						input := linearapi.UpdateIssueInput{
 							ID:      issue.ID,
 							StateID: &stateID,
-						})
+						}
+						_, err := a.GetAPI().UpdateIssue(ctx, input)
 						a.QueueUpdateDraw(func() {
 							if err != nil {
+								if a.queueOfflineMutation(err, outbox.NewUpdateIssue(*issue, input)) {
+									return
+								}
 								logger.ErrorWithErr(err, "tui.commands: failed to change status issue=%s", issue.Identifier)
 								a.updateStatusBarWithError(err)
 								return
 							}
 							logger.Info("tui.commands: changed status issue=%s", issue.Identifier)
+							a.recordIssueUpdate("status change", *issue, input)
 							go a.refreshIssues(issue.ID)
 						})
 					}()
@@ -373,6 +502,10 @@ func DefaultCommands(app *App) []Command {
 			Title:    "Assign to user",
 			Keywords: []string{"assign", "user", "team", "member"},
 			Run: func(a *App) {
+				if issues := a.selectedIssues(); len(issues) > 0 {
+					a.BulkAssignUser(issues)
+					return
+				}
 				issue := a.GetSelectedIssue()
 				if issue == nil {
 					return
@@ -380,23 +513,124 @@ func DefaultCommands(app *App) []Command {
 				a.ShowUserPicker(func(userID string) {
 					go func() {
 						ctx := context.Background()
-						_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+						input := linearapi.UpdateIssueInput{
 							ID:         issue.ID,
 							AssigneeID: &userID,
-						})
+						}
+						_, err := a.GetAPI().UpdateIssue(ctx, input)
 						a.QueueUpdateDraw(func() {
 							if err != nil {
+								if a.queueOfflineMutation(err, outbox.NewUpdateIssue(*issue, input)) {
+									return
+								}
 								logger.ErrorWithErr(err, "tui.commands: failed to assign issue to user issue=%s", issue.Identifier)
 								a.updateStatusBarWithError(err)
 								return
 							}
 							logger.Info("tui.commands: assigned issue to user issue=%s", issue.Identifier)
+							a.recordIssueUpdate("assignee change", *issue, input)
 							go a.refreshIssues(issue.ID)
 						})
 					}()
 				})
 			},
 		},
+		{
+			ID:           "move_to_cycle",
+			Title:        "Move to cycle",
+			Keywords:     []string{"cycle", "sprint", "iteration", "move"},
+			ShortcutRune: 'c',
+			Run: func(a *App) {
+				issue := a.GetSelectedIssue()
+				if issue == nil {
+					return
+				}
+				a.ShowCyclePicker(issue.TeamID, issue.CycleID, func(cycleID string) {
+					a.moveIssueToCycle(*issue, cycleID)
+				})
+			},
+		},
+		{
+			ID:       "set_estimate",
+			Title:    "Set estimate",
+			Keywords: []string{"estimate", "points", "size", "effort"},
+			Run: func(a *App) {
+				issue := a.GetSelectedIssue()
+				if issue == nil {
+					return
+				}
+				a.ShowEstimatePicker(*issue)
+			},
+		},
+		{
+			ID:       "set_due_date",
+			Title:    "Set due date",
+			Keywords: []string{"due", "date", "deadline", "schedule"},
+			Run: func(a *App) {
+				issue := a.GetSelectedIssue()
+				if issue == nil {
+					return
+				}
+				a.ShowDueDateModal(*issue)
+			},
+		},
+		{
+			ID:       "change_project",
+			Title:    "Change project",
+			Keywords: []string{"project", "move"},
+			Run: func(a *App) {
+				if issues := a.selectedIssues(); len(issues) > 0 {
+					a.BulkChangeProject(issues)
+					return
+				}
+				issue := a.GetSelectedIssue()
+				if issue == nil {
+					return
+				}
+				a.ShowProjectPicker(*issue)
+			},
+		},
+		{
+			ID:       "set_priority",
+			Title:    "Set priority",
+			Keywords: []string{"priority", "urgent", "high", "normal", "low"},
+			Run: func(a *App) {
+				a.ShowPriorityPicker(a.commandTargets())
+			},
+		},
+		{
+			ID:       "add_label",
+			Title:    "Add label",
+			Keywords: []string{"label", "labels", "tag", "add", "bulk"},
+			Run: func(a *App) {
+				a.ShowAddLabelPicker(a.commandTargets())
+			},
+		},
+		{
+			ID:       "remove_label",
+			Title:    "Remove label",
+			Keywords: []string{"label", "labels", "tag", "remove", "bulk"},
+			Run: func(a *App) {
+				a.ShowRemoveLabelPicker(a.commandTargets())
+			},
+		},
+		{
+			ID:           "select_all",
+			Title:        "Select all visible issues",
+			Keywords:     []string{"select", "all", "multi", "bulk", "selection"},
+			ShortcutRune: 'A',
+			Run: func(a *App) {
+				a.SelectAllVisible()
+			},
+		},
+		{
+			ID:       "clear_selection",
+			Title:    "Clear selection",
+			Keywords: []string{"select", "clear", "deselect", "multi", "bulk", "selection"},
+			Run: func(a *App) {
+				a.ClearIssueSelection()
+			},
+		},
 		{
 			ID:           "create_issue",
 			Title:        "Create new issue",
@@ -424,6 +658,14 @@ func DefaultCommands(app *App) []Command {
 				a.ShowEditTitleModal()
 			},
 		},
+		{
+			ID:       "edit_description",
+			Title:    "Edit description in $EDITOR",
+			Keywords: []string{"edit", "description", "body", "editor", "vim", "markdown"},
+			Run: func(a *App) {
+				a.EditDescriptionInEditor()
+			},
+		},
 		{
 			ID:           "edit_labels",
 			Title:        "Edit issue labels",
@@ -441,7 +683,7 @@ func DefaultCommands(app *App) []Command {
 			ID:       "toggle_sub_issues",
 			Title:    "Toggle sub-issues",
 			Keywords: []string{"toggle", "expand", "collapse", "sub", "children"},
-			// No shortcut - ⌘+T conflicts with new tab. Use Space key in table instead.
+			// No shortcut - ⌘+T conflicts with new tab. Use Enter, l or h in the table instead.
 			Run: func(a *App) {
 				issue := a.GetSelectedIssue()
 				if issue == nil {
@@ -485,7 +727,7 @@ func DefaultCommands(app *App) []Command {
 				if a.currentUser != nil {
 					currentUserID = a.currentUser.ID
 				}
-				myIssues, otherIssues := splitIssuesByAssignee(issues, currentUserID)
+				myIssues, otherIssues := a.splitIssuesForDisplay(issues, currentUserID)
 				a.myIssueRows, a.myIDToIssue = BuildIssueRows(myIssues, a.expandedState)
 				a.otherIssueRows, a.otherIDToIssue = BuildIssueRows(otherIssues, a.expandedState)
 
@@ -519,8 +761,8 @@ func DefaultCommands(app *App) []Command {
 					}
 				}
 
-				renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme)
-				renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme)
+				renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme, a.issueBadge)
+				renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme, a.issueBadge)
 			},
 		},
 		{
@@ -538,7 +780,7 @@ func DefaultCommands(app *App) []Command {
 				a.issuesMu.RLock()
 				issues := a.issues
 				a.issuesMu.RUnlock()
-				myIssues, otherIssues := splitIssuesByAssignee(issues, currentUserID)
+				myIssues, otherIssues := a.splitIssuesForDisplay(issues, currentUserID)
 				a.myIssueRows, a.myIDToIssue = BuildIssueRows(myIssues, a.expandedState)
 				a.otherIssueRows, a.otherIDToIssue = BuildIssueRows(otherIssues, a.expandedState)
 
@@ -572,8 +814,8 @@ func DefaultCommands(app *App) []Command {
 					}
 				}
 
-				renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme)
-				renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme)
+				renderIssuesTableModel(a.myIssuesTable, a.myIssueRows, a.myIDToIssue, selectedMyIssueID, a.theme, a.issueBadge)
+				renderIssuesTableModel(a.otherIssuesTable, a.otherIssueRows, a.otherIDToIssue, selectedOtherIssueID, a.theme, a.issueBadge)
 			},
 		},
 		{
@@ -596,6 +838,10 @@ func DefaultCommands(app *App) []Command {
 			Keywords:     []string{"set", "parent", "link"},
 			ShortcutRune: 'i',
 			Run: func(a *App) {
+				if issues := a.selectedIssues(); len(issues) > 0 {
+					a.BulkSetParent(issues)
+					return
+				}
 				issue := a.GetSelectedIssue()
 				if issue == nil {
 					return
@@ -608,17 +854,22 @@ func DefaultCommands(app *App) []Command {
 				a.ShowParentIssuePicker(func(parentID string) {
 					go func() {
 						ctx := context.Background()
-						_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+						input := linearapi.UpdateIssueInput{
 							ID:       issue.ID,
 							ParentID: &parentID,
-						})
+						}
+						_, err := a.GetAPI().UpdateIssue(ctx, input)
 						a.QueueUpdateDraw(func() {
 							if err != nil {
+								if a.queueOfflineMutation(err, outbox.NewUpdateIssue(*issue, input)) {
+									return
+								}
 								logger.ErrorWithErr(err, "tui.commands: failed to set parent issue=%s", issue.Identifier)
 								a.updateStatusBarWithError(err)
 								return
 							}
 							logger.Info("tui.commands: set parent issue=%s", issue.Identifier)
+							a.recordIssueUpdate("parent change", *issue, input)
 							go a.refreshIssues(issue.ID)
 						})
 					}()
@@ -638,22 +889,80 @@ func DefaultCommands(app *App) []Command {
 				emptyParent := ""
 				go func() {
 					ctx := context.Background()
-					_, err := a.GetAPI().UpdateIssue(ctx, linearapi.UpdateIssueInput{
+					input := linearapi.UpdateIssueInput{
 						ID:       issue.ID,
 						ParentID: &emptyParent,
-					})
+					}
+					_, err := a.GetAPI().UpdateIssue(ctx, input)
 					a.QueueUpdateDraw(func() {
 						if err != nil {
+							if a.queueOfflineMutation(err, outbox.NewUpdateIssue(*issue, input)) {
+								return
+							}
 							logger.ErrorWithErr(err, "tui.commands: failed to remove parent issue=%s", issue.Identifier)
 							a.updateStatusBarWithError(err)
 							return
 						}
 						logger.Info("tui.commands: removed parent issue=%s", issue.Identifier)
+						a.recordIssueUpdate("parent change", *issue, input)
 						go a.refreshIssues(issue.ID)
 					})
 				}()
 			},
 		},
+		{
+			ID:       "add_relation",
+			Title:    "Add relation",
+			Keywords: []string{"relation", "blocks", "blocked", "blocking", "duplicate", "related", "link"},
+			Run: func(a *App) {
+				issue := a.GetSelectedIssue()
+				if issue == nil {
+					return
+				}
+				a.ShowAddRelationPicker(*issue)
+			},
+		},
+		{
+			ID:       "remove_relation",
+			Title:    "Remove relation",
+			Keywords: []string{"relation", "remove", "unlink", "unblock", "blocks", "duplicate", "related"},
+			Run: func(a *App) {
+				issue := a.GetSelectedIssue()
+				if issue == nil {
+					return
+				}
+				a.ShowRemoveRelationPicker(*issue)
+			},
+		},
+		{
+			ID:       "go_to_related",
+			Title:    "Go to related issue",
+			Keywords: []string{"relation", "related", "jump", "blocker", "blocked", "duplicate", "open"},
+			Run: func(a *App) {
+				issue := a.GetSelectedIssue()
+				if issue == nil {
+					return
+				}
+				a.ShowJumpToRelatedPicker(*issue)
+			},
+		},
+		{
+			ID:           "show_inbox",
+			Title:        "Inbox",
+			Keywords:     []string{"inbox", "notifications", "mentions", "assigned", "unread", "snooze"},
+			ShortcutRune: 'I',
+			Run: func(a *App) {
+				a.ShowInbox()
+			},
+		},
+		{
+			ID:       "pending_changes",
+			Title:    "Pending changes",
+			Keywords: []string{"pending", "offline", "queue", "outbox", "sync", "conflict", "retry"},
+			Run: func(a *App) {
+				a.ShowPendingChanges()
+			},
+		},
 		{
 			ID:           "add_comment",
 			Title:        "Add comment",
@@ -667,11 +976,72 @@ func DefaultCommands(app *App) []Command {
 				a.createCommentModal.Show(issue.ID, a.handleCreateComment)
 			},
 		},
+		{
+			ID:       "reply_comment",
+			Title:    "Reply to comment",
+			Keywords: []string{"reply", "comment", "thread", "respond"},
+			Run: func(a *App) {
+				a.ReplyToSelectedComment()
+			},
+		},
+		{
+			ID:       "edit_comment",
+			Title:    "Edit my comment",
+			Keywords: []string{"edit", "comment", "update", "fix"},
+			Run: func(a *App) {
+				a.EditSelectedComment()
+			},
+		},
+		{
+			ID:       "delete_comment",
+			Title:    "Delete my comment",
+			Keywords: []string{"delete", "remove", "comment"},
+			Run: func(a *App) {
+				a.DeleteSelectedComment()
+			},
+		},
+		{
+			ID:       "react_comment",
+			Title:    "React to comment",
+			Keywords: []string{"react", "reaction", "emoji", "comment", "like"},
+			Run: func(a *App) {
+				a.ShowCommentReactionPicker()
+			},
+		},
+		{
+			ID:       "react_issue",
+			Title:    "React to issue",
+			Keywords: []string{"react", "reaction", "emoji", "issue", "like"},
+			Run: func(a *App) {
+				issue := a.GetSelectedIssue()
+				if issue == nil {
+					return
+				}
+				a.ShowIssueReactionPicker(*issue)
+			},
+		},
+		{
+			ID:       "toggle_activity",
+			Title:    "Toggle activity timeline",
+			Keywords: []string{"activity", "history", "timeline", "changes", "log"},
+			Run: func(a *App) {
+				a.ToggleActivityView()
+			},
+		},
+		{
+			ID:       "compose_comment",
+			Title:    "Add comment in $EDITOR",
+			Keywords: []string{"add", "comment", "reply", "editor", "vim", "markdown"},
+			Run: func(a *App) {
+				a.ComposeCommentInEditor()
+			},
+		},
 	}
 	if len(app.config.AgentCommands) == 0 {
 		filtered := make([]Command, 0, len(commands))
 		for _, command := range commands {
-			if command.ID == "ask_agent" {
+			switch command.ID {
+			case "ask_agent", "agent_output", "agent_jobs", "agent_history", "agent_worktrees", "cleanup_worktrees", "cancel_agent":
 				continue
 			}
 			filtered = append(filtered, command)
@@ -0,0 +1,244 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// commentIndent is the indentation per reply level in the comments view.
+const commentIndent = "    "
+
+// commentEntry is one comment in the threaded comments view.
+type commentEntry struct {
+	Comment linearapi.Comment
+	Depth   int // 0 for top-level comments, 1 for replies, and so on
+}
+
+// threadComments orders comments as threads: top-level comments oldest first, each
+// followed by its replies. Replies whose parent is missing are shown as top-level comments.
+func threadComments(comments []linearapi.Comment) []commentEntry {
+	byID := make(map[string]bool, len(comments))
+	for _, comment := range comments {
+		byID[comment.ID] = true
+	}
+	children := make(map[string][]linearapi.Comment)
+	var roots []linearapi.Comment
+	for _, comment := range comments {
+		if comment.ParentID != "" && byID[comment.ParentID] && comment.ParentID != comment.ID {
+			children[comment.ParentID] = append(children[comment.ParentID], comment)
+		} else {
+			roots = append(roots, comment)
+		}
+	}
+
+	entries := make([]commentEntry, 0, len(comments))
+	visited := make(map[string]bool, len(comments))
+	var walk func(list []linearapi.Comment, depth int)
+	walk = func(list []linearapi.Comment, depth int) {
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].CreatedAt.Before(list[j].CreatedAt)
+		})
+		for _, comment := range list {
+			if visited[comment.ID] {
+				continue
+			}
+			visited[comment.ID] = true
+			entries = append(entries, commentEntry{Comment: comment, Depth: depth})
+			walk(children[comment.ID], depth+1)
+		}
+	}
+	walk(roots, 0)
+	return entries
+}
+
+// commentAuthorName returns the display name of a comment's author.
+func commentAuthorName(comment linearapi.Comment) string {
+	if comment.Author.DisplayName != "" {
+		return comment.Author.DisplayName
+	}
+	return comment.Author.Name
+}
+
+// commentRegionID returns the comments view region of the comment at index.
+func commentRegionID(index int) string {
+	return fmt.Sprintf("comment-%d", index)
+}
+
+// indentLines prefixes every line of text with indent.
+func indentLines(text, indent string) string {
+	if indent == "" {
+		return text
+	}
+	return indent + strings.ReplaceAll(text, "\n", "\n"+indent)
+}
+
+// selectedCommentIndex returns the index of the comment under the cursor, or -1 when there are no comments.
+func (a *App) selectedCommentIndex() int {
+	if len(a.commentEntries) == 0 {
+		return -1
+	}
+	for i, entry := range a.commentEntries {
+		if entry.Comment.ID == a.selectedCommentID {
+			return i
+		}
+	}
+	return 0
+}
+
+// selectedComment returns the comment under the comments cursor.
+func (a *App) selectedComment() (linearapi.Comment, bool) {
+	index := a.selectedCommentIndex()
+	if index < 0 {
+		return linearapi.Comment{}, false
+	}
+	return a.commentEntries[index].Comment, true
+}
+
+// highlightSelectedComment moves the comments view highlight to the cursor.
+func (a *App) highlightSelectedComment() {
+	index := a.selectedCommentIndex()
+	if index < 0 {
+		a.detailsCommentsView.Highlight()
+		return
+	}
+	a.selectedCommentID = a.commentEntries[index].Comment.ID
+	a.detailsCommentsView.Highlight(commentRegionID(index)).ScrollToHighlight()
+}
+
+// moveCommentCursor moves the comments cursor by delta, clamped to the list.
+func (a *App) moveCommentCursor(delta int) {
+	index := a.selectedCommentIndex()
+	if index < 0 {
+		return
+	}
+	index = max(0, min(len(a.commentEntries)-1, index+delta))
+	a.selectedCommentID = a.commentEntries[index].Comment.ID
+	a.highlightSelectedComment()
+}
+
+// handleCommentsKey handles keys while the comments view is focused: j/k move between
+// comments, r replies, e edits, d deletes and + reacts to the focused comment.
+func (a *App) handleCommentsKey(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyDown:
+		a.moveCommentCursor(1)
+		return nil
+	case tcell.KeyUp:
+		a.moveCommentCursor(-1)
+		return nil
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'j':
+			a.moveCommentCursor(1)
+			return nil
+		case 'k':
+			a.moveCommentCursor(-1)
+			return nil
+		case 'r':
+			a.ReplyToSelectedComment()
+			return nil
+		case 'e':
+			a.EditSelectedComment()
+			return nil
+		case 'd':
+			a.DeleteSelectedComment()
+			return nil
+		case '+':
+			a.ShowCommentReactionPicker()
+			return nil
+		}
+	}
+	return event
+}
+
+// ReplyToSelectedComment opens the comment form to reply in the focused comment's thread.
+func (a *App) ReplyToSelectedComment() {
+	issue := a.GetSelectedIssue()
+	comment, ok := a.selectedComment()
+	if issue == nil || !ok {
+		a.updateStatusBarWithError(fmt.Errorf("no comment selected"))
+		return
+	}
+	a.createCommentModal.ShowReply(issue.ID, comment, func(issueID, body string) {
+		a.submitComment(linearapi.CreateCommentInput{IssueID: issueID, Body: body, ParentID: comment.ID})
+	})
+}
+
+// ownSelectedComment returns the focused comment when the current user wrote it.
+func (a *App) ownSelectedComment() (linearapi.Comment, bool) {
+	comment, ok := a.selectedComment()
+	if !ok {
+		a.updateStatusBarWithError(fmt.Errorf("no comment selected"))
+		return linearapi.Comment{}, false
+	}
+	if !comment.Author.IsMe {
+		a.updateStatusBarWithError(fmt.Errorf("you can only change your own comments"))
+		return linearapi.Comment{}, false
+	}
+	return comment, true
+}
+
+// EditSelectedComment opens the focused comment for editing when the current user wrote it.
+func (a *App) EditSelectedComment() {
+	issue := a.GetSelectedIssue()
+	comment, ok := a.ownSelectedComment()
+	if issue == nil || !ok {
+		return
+	}
+	a.createCommentModal.ShowEdit(issue.ID, comment, func(issueID, body string) {
+		if body == comment.Body {
+			return
+		}
+		go func() {
+			_, err := a.GetAPI().UpdateComment(context.Background(), comment.ID, body)
+			a.QueueUpdateDraw(func() {
+				if err != nil {
+					logger.ErrorWithErr(err, "tui.comments: failed to update comment comment_id=%s", comment.ID)
+					a.updateStatusBarWithError(err)
+					return
+				}
+				logger.Info("tui.comments: updated comment issue=%s comment_id=%s", issue.Identifier, comment.ID)
+				a.refreshSelectedIssueDetails(issueID)
+			})
+		}()
+	})
+}
+
+// DeleteSelectedComment asks before deleting the focused comment when the current user wrote it.
+func (a *App) DeleteSelectedComment() {
+	issue := a.GetSelectedIssue()
+	comment, ok := a.ownSelectedComment()
+	if issue == nil || !ok {
+		return
+	}
+
+	items := []PickerItem{
+		{ID: "delete", Label: "Delete comment"},
+		{ID: "cancel", Label: "Cancel"},
+	}
+	a.pickerActive = true
+	a.pickerModal.Show("Delete comment?", items, func(item PickerItem) {
+		a.pickerActive = false
+		if item.ID != "delete" {
+			return
+		}
+		go func() {
+			err := a.GetAPI().DeleteComment(context.Background(), comment.ID)
+			a.QueueUpdateDraw(func() {
+				if err != nil {
+					logger.ErrorWithErr(err, "tui.comments: failed to delete comment comment_id=%s", comment.ID)
+					a.updateStatusBarWithError(err)
+					return
+				}
+				logger.Info("tui.comments: deleted comment issue=%s comment_id=%s", issue.Identifier, comment.ID)
+				a.refreshSelectedIssueDetails(issue.ID)
+			})
+		}()
+	})
+}
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestThreadComments verifies replies follow their parent and orphans become top-level.
+func TestThreadComments(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	comments := []linearapi.Comment{
+		{ID: "c-3", ParentID: "c-1", CreatedAt: base.Add(3 * time.Minute)},
+		{ID: "c-1", CreatedAt: base},
+		{ID: "c-2", CreatedAt: base.Add(2 * time.Minute)},
+		{ID: "c-4", ParentID: "c-3", CreatedAt: base.Add(4 * time.Minute)},
+		{ID: "c-5", ParentID: "gone", CreatedAt: base.Add(time.Minute)},
+	}
+
+	var got []string
+	for _, entry := range threadComments(comments) {
+		got = append(got, strings.Repeat(">", entry.Depth)+entry.Comment.ID)
+	}
+	want := "c-1,>c-3,>>c-4,c-5,c-2"
+	if strings.Join(got, ",") != want {
+		t.Fatalf("threadComments() = %v, want %s", got, want)
+	}
+}
+
+// TestCommentsView_CursorAndOwnership verifies the comments cursor and that only my comments can be edited.
+func TestCommentsView_CursorAndOwnership(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	app.selectedIssue = &linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", Comments: []linearapi.Comment{
+		{ID: "c-1", Body: "Question", CreatedAt: base, UpdatedAt: base, Author: linearapi.User{Name: "Bob"}},
+		{ID: "c-2", Body: "Answer", ParentID: "c-1", CreatedAt: base.Add(time.Minute), UpdatedAt: base.Add(time.Minute),
+			Author: linearapi.User{Name: "Ada", IsMe: true}},
+	}}
+	app.updateDetailsView()
+
+	if comment, ok := app.selectedComment(); !ok || comment.ID != "c-1" {
+		t.Fatalf("initial cursor = %+v, want c-1", comment)
+	}
+	if text := app.detailsCommentsView.GetText(true); !strings.Contains(text, commentIndent+"↳ Ada (me)") {
+		t.Fatalf("reply not rendered as a thread:\n%s", text)
+	}
+
+	app.EditSelectedComment()
+	if app.pages.HasPage("create_comment") {
+		t.Fatal("edit form opened for someone else's comment")
+	}
+	if text := app.statusBar.GetText(true); !strings.Contains(text, "only change your own comments") {
+		t.Fatalf("status = %q", text)
+	}
+
+	app.moveCommentCursor(1)
+	app.moveCommentCursor(1)
+	if comment, _ := app.selectedComment(); comment.ID != "c-2" {
+		t.Fatalf("cursor after moving down = %s, want c-2", comment.ID)
+	}
+	app.EditSelectedComment()
+	if !app.pages.HasPage("create_comment") || app.createCommentModal.bodyField.GetText() != "Answer" {
+		t.Fatal("edit form not opened with my comment's body")
+	}
+
+	// A refresh keeps the cursor on the same comment
+	app.updateDetailsView()
+	if comment, _ := app.selectedComment(); comment.ID != "c-2" {
+		t.Fatalf("cursor after refresh = %s, want c-2", comment.ID)
+	}
+}
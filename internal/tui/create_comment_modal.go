@@ -7,6 +7,7 @@ import (
 	"github.com/rivo/tview"
 	"github.com/roeyazroel/linear-tui/internal/linearapi"
 	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/outbox"
 )
 
 // CreateCommentModal manages the create comment form overlay.
@@ -14,6 +15,8 @@ type CreateCommentModal struct {
 	app       *App
 	modal     *tview.Flex
 	form      *tview.Form
+	content   *tview.Flex
+	header    *tview.TextView
 	bodyField *tview.TextArea
 	issueID   string
 	onCreate  func(issueID, body string)
@@ -50,19 +53,22 @@ func NewCreateCommentModal(app *App) *CreateCommentModal {
 			ccm.onCreate(ccm.issueID, body)
 		}
 	})
+	ccm.form.AddButton("Editor", func() {
+		ccm.app.editTextAreaInEditor(ccm.bodyField, ccm.app.issueIdentifier(ccm.issueID)+"-comment")
+	})
 	ccm.form.AddButton("Cancel", func() {
 		ccm.Hide()
 	})
 
 	// Create header with instructions
-	headerView := tview.NewTextView()
-	headerView.SetText("Add Comment")
-	headerView.SetTextColor(app.theme.Accent)
-	headerView.SetBackgroundColor(app.theme.HeaderBg)
+	ccm.header = tview.NewTextView()
+	ccm.header.SetText("Add Comment")
+	ccm.header.SetTextColor(app.theme.Accent)
+	ccm.header.SetBackgroundColor(app.theme.HeaderBg)
 
 	// Create help text
 	helpView := tview.NewTextView()
-	helpView.SetText("Esc: cancel • Ctrl+Enter / Cmd+Enter: submit")
+	helpView.SetText("Esc: cancel • Ctrl+Enter / Cmd+Enter: submit • Editor: write in $EDITOR")
 	helpView.SetTextColor(app.theme.SecondaryText)
 	helpView.SetBackgroundColor(app.theme.HeaderBg)
 	helpView.SetTextAlign(tview.AlignCenter)
@@ -70,7 +76,7 @@ func NewCreateCommentModal(app *App) *CreateCommentModal {
 	// Build modal content
 	modalContent := tview.NewFlex().
 		SetDirection(tview.FlexRow).
-		AddItem(headerView, 1, 0, false).
+		AddItem(ccm.header, 1, 0, false).
 		AddItem(ccm.form, 0, 1, true).
 		AddItem(helpView, 1, 0, false)
 	modalContent.Box = tview.NewBox().SetBackgroundColor(app.theme.HeaderBg)
@@ -81,6 +87,7 @@ func NewCreateCommentModal(app *App) *CreateCommentModal {
 		SetTitleColor(app.theme.Foreground)
 	padding := app.density.ModalPadding
 	modalContent.SetBorderPadding(padding.Top, padding.Bottom, padding.Left, padding.Right)
+	ccm.content = modalContent
 
 	// Center the modal on screen
 	ccm.modal = tview.NewFlex().
@@ -98,11 +105,29 @@ func NewCreateCommentModal(app *App) *CreateCommentModal {
 
 // Show displays the create comment modal.
 func (ccm *CreateCommentModal) Show(issueID string, onCreate func(issueID, body string)) {
+	ccm.show(" New Comment ", "Add Comment", "Comment", issueID, "", onCreate)
+}
+
+// ShowReply displays the modal for a threaded reply to parent.
+func (ccm *CreateCommentModal) ShowReply(issueID string, parent linearapi.Comment, onCreate func(issueID, body string)) {
+	ccm.show(" Reply ", "Reply to "+commentAuthorName(parent), "Reply", issueID, "", onCreate)
+}
+
+// ShowEdit displays the modal prefilled with a comment's body for editing.
+func (ccm *CreateCommentModal) ShowEdit(issueID string, comment linearapi.Comment, onSave func(issueID, body string)) {
+	ccm.show(" Edit Comment ", "Edit Comment", "Save", issueID, comment.Body, onSave)
+}
+
+// show displays the modal with the given titles, submit label and initial body.
+func (ccm *CreateCommentModal) show(title, header, submitLabel, issueID, body string, onSubmit func(issueID, body string)) {
 	ccm.issueID = issueID
-	ccm.onCreate = onCreate
+	ccm.onCreate = onSubmit
+	ccm.content.SetTitle(title)
+	ccm.header.SetText(header)
+	ccm.form.GetButton(0).SetLabel(submitLabel)
 
 	// Reset form field
-	ccm.bodyField.SetText("", true)
+	ccm.bodyField.SetText(body, true)
 
 	// Show modal
 	ccm.app.pages.AddPage("create_comment", ccm.modal, true, true)
@@ -147,44 +172,55 @@ func (ccm *CreateCommentModal) GetModal() *tview.Flex {
 
 // handleCreateComment handles comment creation.
 func (a *App) handleCreateComment(issueID, body string) {
+	a.submitComment(linearapi.CreateCommentInput{IssueID: issueID, Body: body})
+}
+
+// submitComment creates a comment or threaded reply and refreshes the issue details.
+func (a *App) submitComment(input linearapi.CreateCommentInput) {
 	go func() {
-		ctx := context.Background()
-		_, err := a.GetAPI().CreateComment(ctx, linearapi.CreateCommentInput{
-			IssueID: issueID,
-			Body:    body,
-		})
+		_, err := a.GetAPI().CreateComment(context.Background(), input)
 
 		a.app.QueueUpdateDraw(func() {
 			if err != nil {
-				logger.ErrorWithErr(err, "tui.app: failed to create comment issue=%s", issueID)
+				if a.queueOfflineMutation(err, outbox.NewCreateComment(a.issueIdentifier(input.IssueID), input)) {
+					return
+				}
+				logger.ErrorWithErr(err, "tui.app: failed to create comment issue=%s", input.IssueID)
 				a.updateStatusBarWithError(err)
 				return
 			}
 
-			logger.Info("tui.app: created comment issue=%s", issueID)
-
-			// Refresh the selected issue to show the new comment
-			a.issuesMu.RLock()
-			selectedIssue := a.selectedIssue
-			a.issuesMu.RUnlock()
-			if selectedIssue != nil && selectedIssue.ID == issueID {
-				a.fetchingIssueID = issueID
-				go func() {
-					fullIssue, fetchErr := a.api.FetchIssueByID(ctx, issueID)
-					a.app.QueueUpdateDraw(func() {
-						if a.fetchingIssueID == issueID {
-							if fetchErr != nil {
-								logger.ErrorWithErr(fetchErr, "tui.app: failed to refresh issue after comment creation issue=%s", issueID)
-								return
-							}
-							a.issuesMu.Lock()
-							a.selectedIssue = &fullIssue
-							a.issuesMu.Unlock()
-							a.updateDetailsView()
-						}
-					})
-				}()
+			logger.Info("tui.app: created comment issue=%s parent_id=%s", input.IssueID, input.ParentID)
+			a.refreshSelectedIssueDetails(input.IssueID)
+		})
+	}()
+}
+
+// refreshSelectedIssueDetails refetches the selected issue to show comment changes.
+// Must be called on the UI goroutine.
+func (a *App) refreshSelectedIssueDetails(issueID string) {
+	a.issuesMu.RLock()
+	selectedIssue := a.selectedIssue
+	a.issuesMu.RUnlock()
+	if selectedIssue == nil || selectedIssue.ID != issueID {
+		return
+	}
+
+	a.fetchingIssueID = issueID
+	go func() {
+		fullIssue, fetchErr := a.fetchIssueByID(context.Background(), issueID)
+		a.QueueUpdateDraw(func() {
+			if a.fetchingIssueID != issueID {
+				return
+			}
+			if fetchErr != nil {
+				logger.ErrorWithErr(fetchErr, "tui.app: failed to refresh issue after comment change issue=%s", issueID)
+				return
 			}
+			a.issuesMu.Lock()
+			a.selectedIssue = &fullIssue
+			a.issuesMu.Unlock()
+			a.updateDetailsView()
 		})
 	}()
 }
@@ -107,6 +107,13 @@ func NewCreateIssueModal(app *App) *CreateIssueModal {
 			cm.onCreate(title, desc, cm.teamID, cm.projectID, cm.assigneeID, cm.priority)
 		}
 	})
+	cm.form.AddButton("Editor", func() {
+		if descItem := cm.form.GetFormItemByLabel("Description"); descItem != nil {
+			if textArea, ok := descItem.(*tview.TextArea); ok {
+				cm.app.editTextAreaInEditor(textArea, "new-issue")
+			}
+		}
+	})
 	cm.form.AddButton("Cancel", func() {
 		cm.Hide()
 	})
@@ -119,7 +126,7 @@ func NewCreateIssueModal(app *App) *CreateIssueModal {
 
 	// Create help text
 	helpView := tview.NewTextView()
-	helpView.SetText("Tab: next field • Enter: open dropdown • Esc: cancel")
+	helpView.SetText("Tab: next field • Enter: open dropdown • Editor: write description in $EDITOR • Esc: cancel")
 	helpView.SetTextColor(app.theme.SecondaryText)
 	helpView.SetBackgroundColor(app.theme.HeaderBg)
 	helpView.SetTextAlign(tview.AlignCenter)
@@ -0,0 +1,232 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/outbox"
+)
+
+// maxPastCyclesInNavigation limits how many completed cycles each team lists.
+const maxPastCyclesInNavigation = 5
+
+// burnUpWidth is the width of the cycle progress bar and sparklines.
+const burnUpWidth = 20
+
+// sparkBlocks are the glyphs used for burn-up sparklines, lowest to highest.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// buildCyclesGroup builds a team's "Cycles" navigation group, or returns nil when the team has none.
+// Cycles arrive sorted active, upcoming, then most recent past.
+func (a *App) buildCyclesGroup(teamID string, cycles []linearapi.Cycle) *tview.TreeNode {
+	if len(cycles) == 0 {
+		return nil
+	}
+	group := tview.NewTreeNode("  Cycles").
+		SetColor(a.theme.SecondaryText).
+		SetSelectable(false).
+		SetReference(&NavigationNode{
+			ID:      fmt.Sprintf("%s-cycles", teamID),
+			Text:    "Cycles",
+			TeamID:  teamID,
+			IsCycle: true,
+		})
+	past := 0
+	for _, cycle := range cycles {
+		if cycle.Status() == "past" {
+			if past == maxPastCyclesInNavigation {
+				break
+			}
+			past++
+		}
+		group.AddChild(tview.NewTreeNode("    " + cycleNavigationLabel(cycle)).
+			SetColor(a.theme.SecondaryText).
+			SetReference(&NavigationNode{
+				ID:      "cycle:" + cycle.ID,
+				Text:    cycle.DisplayName(),
+				TeamID:  teamID,
+				IsCycle: true,
+				CycleID: cycle.ID,
+			}))
+	}
+	return group
+}
+
+// cycleNavigationLabel formats a cycle as "Cycle 12 · Jan 2–Jan 16 · 45%", marking the active cycle.
+func cycleNavigationLabel(cycle linearapi.Cycle) string {
+	label := fmt.Sprintf("%s · %s · %d%%", cycle.DisplayName(), cycleDateRange(cycle), int(math.Round(cycle.Progress*100)))
+	if cycle.IsActive {
+		label = "● " + label
+	}
+	return label
+}
+
+// cycleDateRange formats a cycle's start and end dates.
+func cycleDateRange(cycle linearapi.Cycle) string {
+	return fmt.Sprintf("%s–%s", cycle.StartsAt.Local().Format("Jan 2"), cycle.EndsAt.Local().Format("Jan 2"))
+}
+
+// findCycle returns a team's loaded cycle by ID, or nil when cycles have not been loaded.
+func (a *App) findCycle(teamID, cycleID string) *linearapi.Cycle {
+	for i := range a.teamCycles[teamID] {
+		if a.teamCycles[teamID][i].ID == cycleID {
+			return &a.teamCycles[teamID][i]
+		}
+	}
+	return nil
+}
+
+// ensureTeamCycles loads a team's cycles in the background when they have not been
+// loaded yet, then redraws the details view so it can show cycle progress.
+func (a *App) ensureTeamCycles(teamID string) {
+	if _, loaded := a.teamCycles[teamID]; loaded || teamID == "" || a.cache == nil {
+		return
+	}
+	// Mark the team as loading so repeated renders do not start more fetches
+	a.teamCycles[teamID] = nil
+	go func() {
+		cycles, err := a.cache.GetCycles(context.Background(), teamID)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.Warning("tui.cycles: failed to load cycles team_id=%s error=%v", teamID, err)
+				delete(a.teamCycles, teamID)
+				return
+			}
+			a.teamCycles[teamID] = cycles
+			a.updateDetailsView()
+		})
+	}()
+}
+
+// cycleStatusLabel returns the status bar label for a selected cycle node.
+func (a *App) cycleStatusLabel(navNode *NavigationNode) string {
+	cycle := a.findCycle(navNode.TeamID, navNode.CycleID)
+	if cycle == nil {
+		return fmt.Sprintf("Cycle: %s", navNode.Text)
+	}
+	return fmt.Sprintf("Cycle: %s (%s, %d%%)", navNode.Text, cycle.Status(), int(math.Round(cycle.Progress*100)))
+}
+
+// cycleBurnUp summarises an active cycle as a progress bar with days remaining,
+// followed by scope and completed sparklines built from Linear's daily history.
+func cycleBurnUp(cycle linearapi.Cycle, now time.Time) []string {
+	filled := int(math.Round(cycle.Progress * burnUpWidth))
+	filled = max(0, min(burnUpWidth, filled))
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", burnUpWidth-filled)
+
+	remaining := "ends today"
+	if days := int(math.Ceil(cycle.EndsAt.Sub(now).Hours() / 24)); days > 1 {
+		remaining = fmt.Sprintf("%d days left", days)
+	} else if days == 1 {
+		remaining = "1 day left"
+	} else if days < 0 {
+		remaining = "ended"
+	}
+
+	lines := []string{fmt.Sprintf("%s %d%% · %s", bar, int(math.Round(cycle.Progress*100)), remaining)}
+	if len(cycle.ScopeHistory) == 0 {
+		return lines
+	}
+
+	scope := cycle.ScopeHistory[len(cycle.ScopeHistory)-1]
+	completed := 0.0
+	if n := len(cycle.CompletedScopeHistory); n > 0 {
+		completed = cycle.CompletedScopeHistory[n-1]
+	}
+	peak := 0.0
+	for _, v := range cycle.ScopeHistory {
+		peak = math.Max(peak, v)
+	}
+	lines = append(lines,
+		fmt.Sprintf("Scope     %s %g", sparkline(cycle.ScopeHistory, peak), scope),
+		fmt.Sprintf("Completed %s %g", sparkline(cycle.CompletedScopeHistory, peak), completed),
+	)
+	return lines
+}
+
+// sparkline renders the last burnUpWidth values scaled against peak.
+func sparkline(values []float64, peak float64) string {
+	if len(values) > burnUpWidth {
+		values = values[len(values)-burnUpWidth:]
+	}
+	var b strings.Builder
+	for _, v := range values {
+		idx := 0
+		if peak > 0 {
+			idx = int(math.Round(v / peak * float64(len(sparkBlocks)-1)))
+		}
+		idx = max(0, min(len(sparkBlocks)-1, idx))
+		b.WriteRune(sparkBlocks[idx])
+	}
+	return b.String()
+}
+
+// ShowCyclePicker shows a team's active and upcoming cycles, plus "No cycle" to remove the issue from its cycle.
+func (a *App) ShowCyclePicker(teamID, currentCycleID string, onSelect func(cycleID string)) {
+	logger.Debug("tui.cycles: showing cycle picker team_id=%s", teamID)
+	go func() {
+		cycles, err := a.cache.GetCycles(context.Background(), teamID)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.cycles: failed to load cycles team_id=%s", teamID)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			a.teamCycles[teamID] = cycles
+
+			items := []PickerItem{{ID: "", Label: "No cycle"}}
+			for _, cycle := range cycles {
+				if cycle.Status() == "past" {
+					continue
+				}
+				label := fmt.Sprintf("%s (%s, %s)", cycle.DisplayName(), cycle.Status(), cycleDateRange(cycle))
+				if cycle.ID == currentCycleID {
+					label += " - current"
+				}
+				items = append(items, PickerItem{ID: cycle.ID, Label: label})
+			}
+
+			a.pickerActive = true
+			a.pickerModal.Show("Move to Cycle", items, func(item PickerItem) {
+				a.pickerActive = false
+				onSelect(item.ID)
+			})
+		})
+	}()
+}
+
+// moveIssueToCycle moves an issue into a cycle, or out of its cycle when cycleID is empty.
+func (a *App) moveIssueToCycle(issue linearapi.Issue, cycleID string) {
+	if cycleID == issue.CycleID {
+		return
+	}
+	go func() {
+		input := linearapi.UpdateIssueInput{
+			ID:      issue.ID,
+			CycleID: &cycleID,
+		}
+		_, err := a.GetAPI().UpdateIssue(context.Background(), input)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				if a.queueOfflineMutation(err, outbox.NewUpdateIssue(issue, input)) {
+					return
+				}
+				logger.ErrorWithErr(err, "tui.cycles: failed to move issue to cycle issue=%s", issue.Identifier)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			logger.Info("tui.cycles: moved issue to cycle issue=%s cycle_id=%s", issue.Identifier, cycleID)
+			a.recordIssueUpdate("cycle change", issue, input)
+			// Cycle progress now includes (or excludes) this issue
+			a.cache.InvalidateCycles(issue.TeamID)
+			delete(a.teamCycles, issue.TeamID)
+			go a.refreshIssues(issue.ID)
+		})
+	}()
+}
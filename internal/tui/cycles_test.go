@@ -0,0 +1,75 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestBuildCyclesGroup_LimitsPastCyclesAndFetchesByCycle verifies the Cycles group and cycle fetch params.
+func TestBuildCyclesGroup_LimitsPastCyclesAndFetchesByCycle(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+
+	if app.buildCyclesGroup("team-1", nil) != nil {
+		t.Fatal("buildCyclesGroup() with no cycles should return nil")
+	}
+
+	cycles := []linearapi.Cycle{{ID: "active", Number: 12, IsActive: true, Progress: 0.456}}
+	for i := 0; i < maxPastCyclesInNavigation+2; i++ {
+		cycles = append(cycles, linearapi.Cycle{ID: "past", Number: 11 - i, IsPast: true, Progress: 1})
+	}
+	group := app.buildCyclesGroup("team-1", cycles)
+	if got := len(group.GetChildren()); got != maxPastCyclesInNavigation+1 {
+		t.Fatalf("cycle nodes = %d, want %d", got, maxPastCyclesInNavigation+1)
+	}
+
+	first := group.GetChildren()[0]
+	if text := first.GetText(); !strings.HasPrefix(text, "    ● Cycle 12") || !strings.HasSuffix(text, "46%") {
+		t.Fatalf("active cycle label = %q", text)
+	}
+
+	app.selectedNavigation = first.GetReference().(*NavigationNode)
+	params := app.currentFetchParams()
+	if params.TeamID != "team-1" || params.Filter["cycle"] == nil {
+		t.Fatalf("cycle fetch params = %+v", params)
+	}
+
+	app.searchQuery = "assignee:me"
+	params = app.currentFetchParams()
+	if and, ok := params.Filter["and"].([]map[string]interface{}); !ok || len(and) != 2 {
+		t.Fatalf("cycle fetch with query filter = %+v", params.Filter)
+	}
+}
+
+// TestCycleBurnUp verifies the progress bar, remaining days and sparklines.
+func TestCycleBurnUp(t *testing.T) {
+	now := time.Date(2025, 2, 5, 12, 0, 0, 0, time.UTC)
+	cycle := linearapi.Cycle{
+		IsActive:              true,
+		EndsAt:                now.Add(3*24*time.Hour - time.Hour),
+		Progress:              0.5,
+		ScopeHistory:          []float64{4, 8, 8},
+		CompletedScopeHistory: []float64{0, 2, 4},
+	}
+
+	lines := cycleBurnUp(cycle, now)
+	if len(lines) != 3 {
+		t.Fatalf("cycleBurnUp() lines = %q", lines)
+	}
+	wantBar := strings.Repeat("█", burnUpWidth/2) + strings.Repeat("░", burnUpWidth/2) + " 50% · 3 days left"
+	if lines[0] != wantBar {
+		t.Fatalf("progress line = %q, want %q", lines[0], wantBar)
+	}
+	if lines[1] != "Scope     ▅██ 8" || lines[2] != "Completed ▁▃▅ 4" {
+		t.Fatalf("sparklines = %q", lines[1:])
+	}
+
+	cycle.ScopeHistory = nil
+	cycle.EndsAt = now.Add(-48 * time.Hour)
+	if lines := cycleBurnUp(cycle, now); len(lines) != 1 || !strings.HasSuffix(lines[0], "ended") {
+		t.Fatalf("cycleBurnUp() without history = %q", lines)
+	}
+}
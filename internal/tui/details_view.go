@@ -3,10 +3,12 @@ package tui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/glamour"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
 )
 
 // markdownRenderer is a shared glamour renderer for markdown content.
@@ -70,6 +72,7 @@ func (a *App) buildDetailsView() *tview.Flex {
 		SetTitleColor(a.theme.Foreground).
 		SetBorderColor(a.theme.Border).
 		SetBackgroundColor(tcell.ColorDefault)
+	a.detailsCommentsView.SetRegions(true)
 	a.detailsCommentsView.SetBorderPadding(padding.Top, padding.Bottom, padding.Left, padding.Right)
 
 	// Create flex layout; comments are added conditionally after issue selection.
@@ -106,7 +109,7 @@ func (a *App) updateDetailsView() {
 	a.issuesMu.RLock()
 	selectedIssue := a.selectedIssue
 	a.issuesMu.RUnlock()
-	hasComments := selectedIssue != nil && len(selectedIssue.Comments) > 0
+	hasComments := selectedIssue != nil && (len(selectedIssue.Comments) > 0 || a.detailsActivityMode)
 	a.setDetailsCommentsVisibility(hasComments)
 	if selectedIssue == nil {
 		a.detailsDescriptionView.SetText(fmt.Sprintf("%sNo issue selected. Select an issue from the list to view details.[-]", a.themeTags.SecondaryText))
@@ -145,7 +148,7 @@ func (a *App) updateDetailsView() {
 	}
 	headerLines = append(headerLines, fmt.Sprintf("%sAssignee:[-]   %s%s[-]", keyColor, valColor, assignee))
 
-	headerLines = append(headerLines, fmt.Sprintf("%sPriority:[-]   %s%d[-]", keyColor, valColor, issue.Priority))
+	headerLines = append(headerLines, fmt.Sprintf("%sPriority:[-]   %s%s[-]", keyColor, valColor, linearapi.PriorityLabel(issue.Priority)))
 
 	// Labels
 	labelsText := "No labels"
@@ -158,6 +161,26 @@ func (a *App) updateDetailsView() {
 	}
 	headerLines = append(headerLines, fmt.Sprintf("%sLabels:[-]     %s%s[-]", keyColor, valColor, labelsText))
 
+	// Cycle, with a burn-up summary while the cycle is active
+	if issue.CycleID != "" {
+		cycleText := issue.CycleName
+		cycle := a.findCycle(issue.TeamID, issue.CycleID)
+		if cycle != nil {
+			cycleText = fmt.Sprintf("%s (%s, %s)", cycleText, cycle.Status(), cycleDateRange(*cycle))
+		} else {
+			a.ensureTeamCycles(issue.TeamID)
+		}
+		headerLines = append(headerLines, fmt.Sprintf("%sCycle:[-]      %s%s[-]", keyColor, valColor, cycleText))
+		if cycle != nil && cycle.IsActive {
+			for _, line := range cycleBurnUp(*cycle, time.Now()) {
+				headerLines = append(headerLines, fmt.Sprintf("            %s%s[-]", keyColor, line))
+			}
+		}
+	}
+
+	// Estimate, due date, project, people and timestamps
+	headerLines = append(headerLines, a.issueFieldLines(*issue, time.Now())...)
+
 	// Parent issue (if this is a sub-issue)
 	if issue.Parent != nil {
 		parentText := fmt.Sprintf("%s - %s", issue.Parent.Identifier, issue.Parent.Title)
@@ -181,6 +204,25 @@ func (a *App) updateDetailsView() {
 		}
 	}
 
+	// Relations (blocks, blocked by, duplicates, related)
+	if len(issue.Relations) > 0 {
+		for i := 0; i < sectionGap; i++ {
+			headerLines = append(headerLines, "")
+		}
+		headerLines = append(headerLines, fmt.Sprintf("%sRelations:[-]  %s%d items[-]", keyColor, valColor, len(issue.Relations)))
+		for _, rel := range sortedRelations(issue.Relations) {
+			labelColor := keyColor
+			if rel.Type == linearapi.RelationBlockedBy && !rel.IsResolved() {
+				labelColor = a.themeTags.Error
+			}
+			headerLines = append(headerLines, fmt.Sprintf("  %s%s[-] %s%s[-] %s[%s][-] %s%s[-]",
+				labelColor, linearapi.RelationLabel(rel.Type),
+				accentColor, rel.Issue.Identifier,
+				keyColor, rel.State,
+				valColor, rel.Issue.Title))
+		}
+	}
+
 	for i := 0; i < sectionGap; i++ {
 		headerLines = append(headerLines, "")
 	}
@@ -212,18 +254,31 @@ func (a *App) updateDetailsView() {
 	a.detailsCommentsView.Clear()
 	commentsWriter := tview.ANSIWriter(a.detailsCommentsView)
 
-	if len(issue.Comments) > 0 {
+	a.commentEntries = threadComments(issue.Comments)
+	if a.detailsActivityMode {
+		// Activity replaces the comments; newest activity is at the bottom
+		a.renderActivity(commentsWriter, issue)
+		a.detailsCommentsView.Highlight().ScrollToEnd()
+		if a.focusedPane == FocusDetails && !a.detailsCommentsVisible {
+			a.updateFocus()
+		}
+		return
+	}
+	if len(a.commentEntries) > 0 {
 		_, _ = fmt.Fprintf(commentsWriter, "%sComments:[-] (%d)\n\n", keyColor, len(issue.Comments))
 
-		for i, comment := range issue.Comments {
-			// Comment header: author and timestamp
-			authorDisplay := comment.Author.DisplayName
-			if authorDisplay == "" {
-				authorDisplay = comment.Author.Name
-			}
+		for i, entry := range a.commentEntries {
+			comment := entry.Comment
+			indent := strings.Repeat(commentIndent, entry.Depth)
+
+			// Comment header: author and timestamp, as a region for the comments cursor
+			authorDisplay := commentAuthorName(comment)
 			if comment.Author.IsMe {
 				authorDisplay = fmt.Sprintf("%s (me)", authorDisplay)
 			}
+			if entry.Depth > 0 {
+				authorDisplay = "↳ " + authorDisplay
+			}
 
 			// Format timestamp
 			timeStr := comment.CreatedAt.Format("Jan 2, 2006 3:04 PM")
@@ -231,17 +286,22 @@ func (a *App) updateDetailsView() {
 				timeStr += " (edited)"
 			}
 
-			_, _ = fmt.Fprintf(commentsWriter, "%s%s[-] %s%s[-]\n", accentColor, authorDisplay, keyColor, timeStr)
+			_, _ = fmt.Fprintf(commentsWriter, "%s[\"%s\"]%s%s[-] %s%s[-][\"\"]\n", indent, commentRegionID(i), accentColor, authorDisplay, keyColor, timeStr)
 			_, _ = fmt.Fprint(commentsWriter, "\n")
 
-			// Render comment body as markdown
+			// Render comment body as markdown, indented under its thread
 			renderedComment := renderMarkdown(comment.Body)
-			_, _ = fmt.Fprint(commentsWriter, renderedComment)
+			_, _ = fmt.Fprint(commentsWriter, indentLines(renderedComment, indent))
+			if len(comment.Reactions) > 0 {
+				_, _ = fmt.Fprintf(commentsWriter, "\n\n%s%s", indent, a.reactionLine(comment.Reactions))
+			}
 
-			// Add separator between comments (but not after the last one)
-			if i < len(issue.Comments)-1 {
+			// Add separator between threads, and a gap between replies
+			if i < len(a.commentEntries)-1 {
 				_, _ = fmt.Fprint(commentsWriter, "\n\n")
-				_, _ = fmt.Fprintf(commentsWriter, "%s────────────────────────────────────────[-]\n\n", dividerColor)
+				if a.commentEntries[i+1].Depth == 0 {
+					_, _ = fmt.Fprintf(commentsWriter, "%s────────────────────────────────────────[-]\n\n", dividerColor)
+				}
 			}
 		}
 	} else {
@@ -249,7 +309,9 @@ func (a *App) updateDetailsView() {
 		_, _ = fmt.Fprintf(commentsWriter, "%sNo comments yet.[-]", keyColor)
 	}
 
+	// Keep the cursor on the same comment across refreshes; a new issue starts on its first comment
 	a.detailsCommentsView.ScrollToBeginning()
+	a.highlightSelectedComment()
 	if a.focusedPane == FocusDetails && !a.detailsCommentsVisible {
 		a.updateFocus()
 	}
@@ -0,0 +1,161 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// DueDateModal manages the set due date form overlay.
+type DueDateModal struct {
+	app       *App
+	modal     *tview.Flex
+	form      *tview.Form
+	dateField *tview.InputField
+	preview   *tview.TextView
+	issueID   string
+	onSet     func(issueID, dueDate string)
+}
+
+// NewDueDateModal creates a new due date modal.
+func NewDueDateModal(app *App) *DueDateModal {
+	ddm := &DueDateModal{
+		app: app,
+	}
+
+	// Create form
+	ddm.form = tview.NewForm()
+	ddm.form.SetBackgroundColor(app.theme.HeaderBg)
+	ddm.form.SetFieldBackgroundColor(app.theme.InputBg)
+	ddm.form.SetFieldTextColor(app.theme.Foreground)
+	ddm.form.SetButtonBackgroundColor(app.theme.Accent)
+	ddm.form.SetButtonTextColor(app.theme.SelectionText)
+	ddm.form.SetLabelColor(app.theme.Foreground)
+	ddm.form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			ddm.Hide()
+			return nil
+		}
+		return event
+	})
+
+	// Add date field with a live preview of the parsed date
+	ddm.dateField = tview.NewInputField()
+	ddm.dateField.SetLabel("Due: ")
+	ddm.dateField.SetFieldWidth(40)
+	ddm.dateField.SetPlaceholder("tomorrow, friday, in 3 days, Mar 7")
+	ddm.dateField.SetChangedFunc(func(text string) {
+		ddm.updatePreview(text)
+	})
+	ddm.form.AddFormItem(ddm.dateField)
+
+	// Add buttons
+	ddm.form.AddButton("Set", func() {
+		ddm.submit(ddm.dateField.GetText())
+	})
+	ddm.form.AddButton("Clear", func() {
+		ddm.submit("none")
+	})
+	ddm.form.AddButton("Cancel", func() {
+		ddm.Hide()
+	})
+
+	// Create title and parsed date preview
+	titleView := tview.NewTextView()
+	titleView.SetText("Set Due Date")
+	titleView.SetTextColor(app.theme.Accent)
+	titleView.SetBackgroundColor(app.theme.HeaderBg)
+
+	ddm.preview = tview.NewTextView()
+	ddm.preview.SetDynamicColors(true)
+	ddm.preview.SetBackgroundColor(app.theme.HeaderBg)
+
+	// Build modal content
+	modalContent := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(titleView, 1, 0, false).
+		AddItem(ddm.form, 0, 1, true).
+		AddItem(ddm.preview, 1, 0, false)
+	modalContent.Box = tview.NewBox().SetBackgroundColor(app.theme.HeaderBg)
+	modalContent.SetBackgroundColor(app.theme.HeaderBg).
+		SetBorder(true).
+		SetBorderColor(app.theme.Accent).
+		SetTitle(" Due Date ").
+		SetTitleColor(app.theme.Foreground)
+	padding := app.density.ModalPadding
+	modalContent.SetBorderPadding(padding.Top, padding.Bottom, padding.Left, padding.Right)
+
+	// Center the modal on screen
+	ddm.modal = tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().
+			SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(modalContent, 9, 0, true).
+			AddItem(nil, 0, 1, false), 60, 0, true).
+		AddItem(nil, 0, 1, false)
+	ddm.modal.SetBackgroundColor(app.theme.Background)
+
+	return ddm
+}
+
+// Show displays the due date modal, prefilled with the current due date.
+func (ddm *DueDateModal) Show(issueID, currentDueDate string, onSet func(issueID, dueDate string)) {
+	ddm.issueID = issueID
+	ddm.onSet = onSet
+
+	ddm.dateField.SetText(currentDueDate)
+	ddm.updatePreview(currentDueDate)
+
+	ddm.app.pages.AddPage("due_date", ddm.modal, true, true)
+	ddm.app.pages.SendToFront("due_date")
+	ddm.app.app.SetFocus(ddm.form)
+}
+
+// Hide hides the due date modal.
+func (ddm *DueDateModal) Hide() {
+	ddm.app.pages.RemovePage("due_date")
+	ddm.app.updateFocus()
+}
+
+// updatePreview shows how the current input will be interpreted.
+func (ddm *DueDateModal) updatePreview(text string) {
+	dueDate, err := linearapi.ParseDueDate(text, time.Now())
+	switch {
+	case err != nil:
+		ddm.preview.SetText(ddm.app.themeTags.Error + "Unrecognized date[-]")
+	case dueDate == "":
+		ddm.preview.SetText(ddm.app.themeTags.SecondaryText + "No due date[-]")
+	default:
+		ddm.preview.SetText(ddm.app.themeTags.SecondaryText + "→ " + formatDueDate(dueDate) + "[-]")
+	}
+}
+
+// submit parses the input and reports the due date, keeping the modal open on invalid input.
+func (ddm *DueDateModal) submit(text string) {
+	dueDate, err := linearapi.ParseDueDate(text, time.Now())
+	if err != nil {
+		ddm.updatePreview(text)
+		return
+	}
+	ddm.Hide()
+	if ddm.onSet != nil && ddm.issueID != "" {
+		ddm.onSet(ddm.issueID, dueDate)
+	}
+}
+
+// HandleKey handles keyboard input for the due date modal.
+func (ddm *DueDateModal) HandleKey(event *tcell.EventKey) *tcell.EventKey {
+	if event.Key() == tcell.KeyEscape {
+		ddm.Hide()
+		return nil
+	}
+	return event
+}
+
+// GetModal returns the modal flex for adding to pages.
+func (ddm *DueDateModal) GetModal() *tview.Flex {
+	return ddm.modal
+}
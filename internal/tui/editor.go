@@ -0,0 +1,177 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// defaultEditor is used when neither $VISUAL nor $EDITOR is set.
+const defaultEditor = "vi"
+
+// editorCommand returns the user's editor command and arguments from $VISUAL or $EDITOR.
+func editorCommand() []string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(name)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{defaultEditor}
+}
+
+// runEditorSuspended suspends the terminal UI while the external editor edits path.
+func (a *App) runEditorSuspended(path string) error {
+	args := append(editorCommand(), path)
+	var runErr error
+	suspended := a.app.Suspend(func() {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		runErr = cmd.Run()
+	})
+	if !suspended {
+		return fmt.Errorf("cannot suspend the terminal to run %s", args[0])
+	}
+	if runErr != nil {
+		return fmt.Errorf("run editor %s: %w", args[0], runErr)
+	}
+	return nil
+}
+
+// editInEditor opens text in the external editor as a temporary markdown file and
+// returns the saved markdown. name becomes part of the file name, e.g. "ABC-12-description".
+func (a *App) editInEditor(name, text string) (string, error) {
+	file, err := os.CreateTemp("", "linear-tui-"+name+"-*.md")
+	if err != nil {
+		return "", fmt.Errorf("create editor file: %w", err)
+	}
+	path := file.Name()
+	defer func() { _ = os.Remove(path) }()
+
+	if text != "" {
+		text += "\n"
+	}
+	if _, err := file.WriteString(text); err != nil {
+		_ = file.Close()
+		return "", fmt.Errorf("write editor file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("write editor file: %w", err)
+	}
+
+	runEditor := a.runEditor
+	if runEditor == nil {
+		runEditor = a.runEditorSuspended
+	}
+	logger.Debug("tui.editor: opening editor path=%s", path)
+	if err := runEditor(path); err != nil {
+		return "", err
+	}
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read editor file: %w", err)
+	}
+	return normalizeEditedMarkdown(string(edited)), nil
+}
+
+// normalizeEditedMarkdown converts line endings and drops the trailing newlines editors add,
+// so an unchanged file round-trips to the original markdown.
+func normalizeEditedMarkdown(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.TrimRight(text, "\n")
+}
+
+// lineDiffStats counts the lines added and removed between two texts.
+func lineDiffStats(before, after string) (added, removed int) {
+	if before == after {
+		return 0, 0
+	}
+	var a, b []string
+	if before != "" {
+		a = strings.Split(before, "\n")
+	}
+	if after != "" {
+		b = strings.Split(after, "\n")
+	}
+
+	// Longest common subsequence of lines; unmatched lines are additions or removals
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	common := lcs[0][0]
+	return len(b) - common, len(a) - common
+}
+
+// EditDescriptionInEditor edits the selected issue's description in the external editor
+// and submits it when it changed.
+func (a *App) EditDescriptionInEditor() {
+	issue := a.GetSelectedIssue()
+	if issue == nil {
+		return
+	}
+
+	description, err := a.editInEditor(issue.Identifier+"-description", issue.Description)
+	if err != nil {
+		logger.ErrorWithErr(err, "tui.editor: failed to edit description issue=%s", issue.Identifier)
+		a.updateStatusBarWithError(err)
+		return
+	}
+
+	added, removed := lineDiffStats(normalizeEditedMarkdown(issue.Description), description)
+	if added == 0 && removed == 0 {
+		a.statusBar.SetText(fmt.Sprintf("%sDescription of %s unchanged[-]", a.themeTags.SecondaryText, issue.Identifier))
+		return
+	}
+	logger.Debug("tui.editor: description edited issue=%s added=%d removed=%d", issue.Identifier, added, removed)
+	a.statusBar.SetText(fmt.Sprintf("%sUpdating description of %s (+%d −%d lines)...[-]", a.themeTags.Accent, issue.Identifier, added, removed))
+	a.updateIssueField(*issue, linearapi.UpdateIssueInput{ID: issue.ID, Description: &description}, "description")
+}
+
+// ComposeCommentInEditor writes a new comment on the selected issue in the external editor.
+func (a *App) ComposeCommentInEditor() {
+	issue := a.GetSelectedIssue()
+	if issue == nil {
+		return
+	}
+
+	body, err := a.editInEditor(issue.Identifier+"-comment", "")
+	if err != nil {
+		logger.ErrorWithErr(err, "tui.editor: failed to compose comment issue=%s", issue.Identifier)
+		a.updateStatusBarWithError(err)
+		return
+	}
+	if strings.TrimSpace(body) == "" {
+		a.statusBar.SetText(fmt.Sprintf("%sEmpty comment discarded[-]", a.themeTags.SecondaryText))
+		return
+	}
+	a.handleCreateComment(issue.ID, body)
+}
+
+// editTextAreaInEditor replaces a modal text area's content with the result of editing it
+// in the external editor.
+func (a *App) editTextAreaInEditor(area *tview.TextArea, name string) {
+	text, err := a.editInEditor(name, area.GetText())
+	if err != nil {
+		logger.ErrorWithErr(err, "tui.editor: failed to edit %s", name)
+		a.updateStatusBarWithError(err)
+		return
+	}
+	area.SetText(text, true)
+}
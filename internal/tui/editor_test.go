@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestEditDescriptionInEditor_SubmitsOnlyChanges verifies the markdown round-trip and diffed submit.
+func TestEditDescriptionInEditor_SubmitsOnlyChanges(t *testing.T) {
+	app, _ := newStoreTestApp(t)
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		return linearapi.IssuePage{}, nil
+	}
+	inputs := make(chan linearapi.UpdateIssueInput, 2)
+	app.updateIssue = func(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+		inputs <- input
+		return linearapi.Issue{ID: input.ID}, nil
+	}
+	original := "# Plan\n\n- [ ] first\n- [ ] second"
+	app.selectedIssue = &linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", Description: original}
+
+	var opened string
+	app.runEditor = func(path string) error {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		opened = string(content)
+		return nil
+	}
+	app.EditDescriptionInEditor()
+	if opened != original+"\n" {
+		t.Fatalf("editor file = %q, want description with trailing newline", opened)
+	}
+	if text := app.statusBar.GetText(true); !strings.Contains(text, "unchanged") {
+		t.Fatalf("status = %q, want unchanged", text)
+	}
+
+	edited := "# Plan\r\n\r\n- [x] first\r\n- [ ] second\r\n- [ ] third\r\n\r\n"
+	app.runEditor = func(path string) error {
+		return os.WriteFile(path, []byte(edited), 0o600)
+	}
+	app.EditDescriptionInEditor()
+	select {
+	case input := <-inputs:
+		want := "# Plan\n\n- [x] first\n- [ ] second\n- [ ] third"
+		if input.Description == nil || *input.Description != want {
+			t.Fatalf("description = %v, want %q", input.Description, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("description update not submitted")
+	}
+	// A successful update refreshes the issue list
+	waitForRefresh(t, app, 1)
+}
+
+// TestLineDiffStats verifies added and removed line counts.
+func TestLineDiffStats(t *testing.T) {
+	tests := []struct {
+		before, after  string
+		added, removed int
+	}{
+		{"a\nb", "a\nb", 0, 0},
+		{"", "a\nb", 2, 0},
+		{"a\nb\nc", "a\nc", 0, 1},
+		{"a\nb\nc", "a\nB\nc\nd", 2, 1},
+	}
+	for _, tt := range tests {
+		added, removed := lineDiffStats(tt.before, tt.after)
+		if added != tt.added || removed != tt.removed {
+			t.Fatalf("lineDiffStats(%q, %q) = +%d -%d, want +%d -%d", tt.before, tt.after, added, removed, tt.added, tt.removed)
+		}
+	}
+}
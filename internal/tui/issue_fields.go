@@ -0,0 +1,219 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/outbox"
+)
+
+// formatDueDate formats a YYYY-MM-DD due date as "Mon, Mar 7 2025".
+func formatDueDate(dueDate string) string {
+	date, err := time.Parse(linearapi.DueDateLayout, dueDate)
+	if err != nil {
+		return dueDate
+	}
+	return date.Format("Mon, Jan 2 2006")
+}
+
+// isOverdue reports whether a YYYY-MM-DD due date is before today.
+func isOverdue(dueDate string, now time.Time) bool {
+	date, err := time.ParseInLocation(linearapi.DueDateLayout, dueDate, now.Location())
+	if err != nil {
+		return false
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return date.Before(today)
+}
+
+// estimateLabel formats an issue's estimate using its team's estimation scale when known.
+func (a *App) estimateLabel(issue linearapi.Issue) string {
+	if issue.Estimate == nil {
+		return "No estimate"
+	}
+	return linearapi.FormatEstimate(*issue.Estimate, a.teamsByID[issue.TeamID].EstimationType)
+}
+
+// formatTimestamp formats an issue timestamp for the details pane.
+func formatTimestamp(t time.Time) string {
+	return t.Local().Format("Jan 2, 2006 3:04 PM")
+}
+
+// issueFieldLines returns the details pane lines for estimate, due date, project,
+// creator, timestamps, SLA, reactions and subscribers.
+func (a *App) issueFieldLines(issue linearapi.Issue, now time.Time) []string {
+	keyColor := a.themeTags.SecondaryText
+	valColor := a.themeTags.Foreground
+	var lines []string
+	add := func(key, color, value string) {
+		lines = append(lines, fmt.Sprintf("%s%-11s[-] %s%s[-]", keyColor, key+":", color, value))
+	}
+
+	add("Estimate", valColor, a.estimateLabel(issue))
+
+	if issue.DueDate != "" {
+		dueColor := valColor
+		dueText := formatDueDate(issue.DueDate)
+		if isOverdue(issue.DueDate, now) && issue.CompletedAt.IsZero() && issue.CanceledAt.IsZero() {
+			dueColor = a.themeTags.Error
+			dueText += " (overdue)"
+		}
+		add("Due", dueColor, dueText)
+	}
+	if issue.ProjectName != "" {
+		add("Project", valColor, issue.ProjectName)
+	}
+	if issue.Creator != "" {
+		add("Creator", valColor, issue.Creator)
+	}
+	if !issue.CreatedAt.IsZero() {
+		add("Created", valColor, formatTimestamp(issue.CreatedAt))
+	}
+	if !issue.UpdatedAt.IsZero() {
+		add("Updated", valColor, formatTimestamp(issue.UpdatedAt))
+	}
+	if !issue.StartedAt.IsZero() {
+		add("Started", valColor, formatTimestamp(issue.StartedAt))
+	}
+	if !issue.CompletedAt.IsZero() {
+		add("Completed", valColor, formatTimestamp(issue.CompletedAt))
+	}
+	if !issue.CanceledAt.IsZero() {
+		add("Canceled", valColor, formatTimestamp(issue.CanceledAt))
+	}
+	if !issue.SLABreachesAt.IsZero() && issue.CompletedAt.IsZero() && issue.CanceledAt.IsZero() {
+		slaColor := valColor
+		if issue.SLABreachesAt.Before(now) {
+			slaColor = a.themeTags.Error
+		}
+		add("SLA", slaColor, "breaches "+formatTimestamp(issue.SLABreachesAt))
+	}
+	if len(issue.Reactions) > 0 {
+		add("Reactions", valColor, a.reactionLine(issue.Reactions))
+	}
+	if len(issue.Subscribers) > 0 {
+		names := make([]string, 0, len(issue.Subscribers))
+		for _, user := range issue.Subscribers {
+			names = append(names, user.Name)
+		}
+		add("Subscribers", valColor, strings.Join(names, ", "))
+	}
+	return lines
+}
+
+// ShowEstimatePicker shows the estimate scale of the issue's team, plus "No estimate".
+func (a *App) ShowEstimatePicker(issue linearapi.Issue) {
+	go func() {
+		teams, err := a.cache.GetTeams(context.Background())
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.issue_fields: failed to load teams for estimate picker")
+				a.updateStatusBarWithError(err)
+				return
+			}
+			var team linearapi.Team
+			for _, t := range teams {
+				if t.ID == issue.TeamID {
+					team = t
+					break
+				}
+			}
+			scale := linearapi.EstimateScale(team)
+			if len(scale) == 0 {
+				a.updateStatusBarWithError(fmt.Errorf("%s: team does not use estimates", issue.Identifier))
+				return
+			}
+
+			items := []PickerItem{{ID: "", Label: "No estimate"}}
+			for _, option := range scale {
+				label := option.Label
+				if issue.Estimate != nil && *issue.Estimate == option.Value {
+					label += " - current"
+				}
+				items = append(items, PickerItem{ID: fmt.Sprint(option.Value), Label: label})
+			}
+
+			a.pickerActive = true
+			a.pickerModal.Show("Set Estimate", items, func(item PickerItem) {
+				a.pickerActive = false
+				estimate := linearapi.NoEstimate
+				if value, err := strconv.Atoi(item.ID); err == nil {
+					estimate = value
+				}
+				a.updateIssueField(issue, linearapi.UpdateIssueInput{ID: issue.ID, Estimate: &estimate}, "estimate")
+			})
+		})
+	}()
+}
+
+// ShowDueDateModal asks for a natural-language due date for the issue.
+func (a *App) ShowDueDateModal(issue linearapi.Issue) {
+	a.dueDateModal.Show(issue.ID, issue.DueDate, func(issueID, dueDate string) {
+		if dueDate == issue.DueDate {
+			return
+		}
+		a.updateIssueField(issue, linearapi.UpdateIssueInput{ID: issueID, DueDate: &dueDate}, "due date")
+	})
+}
+
+// ShowProjectPicker shows the projects of the issue's team, plus "No project".
+func (a *App) ShowProjectPicker(issue linearapi.Issue) {
+	go func() {
+		projects, err := a.cache.GetProjects(context.Background(), issue.TeamID)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.issue_fields: failed to load projects team_id=%s", issue.TeamID)
+				a.updateStatusBarWithError(err)
+				return
+			}
+
+			items := []PickerItem{{ID: "", Label: "No project"}}
+			for _, project := range projects {
+				label := project.Name
+				if project.ID == issue.ProjectID {
+					label += " - current"
+				}
+				items = append(items, PickerItem{ID: project.ID, Label: label})
+			}
+
+			a.pickerActive = true
+			a.pickerModal.Show("Change Project", items, func(item PickerItem) {
+				a.pickerActive = false
+				if item.ID == issue.ProjectID {
+					return
+				}
+				projectID := item.ID
+				a.updateIssueField(issue, linearapi.UpdateIssueInput{ID: issue.ID, ProjectID: &projectID}, "project")
+			})
+		})
+	}()
+}
+
+// updateIssueField applies a single-field update, queueing it while offline.
+func (a *App) updateIssueField(issue linearapi.Issue, input linearapi.UpdateIssueInput, field string) {
+	updateIssue := a.updateIssue
+	if updateIssue == nil {
+		updateIssue = a.GetAPI().UpdateIssue
+	}
+	go func() {
+		_, err := updateIssue(context.Background(), input)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				if a.queueOfflineMutation(err, outbox.NewUpdateIssue(issue, input)) {
+					return
+				}
+				logger.ErrorWithErr(err, "tui.issue_fields: failed to update %s issue=%s", field, issue.Identifier)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			logger.Info("tui.issue_fields: updated %s issue=%s", field, issue.Identifier)
+			a.recordIssueUpdate(field+" change", issue, input)
+			go a.refreshIssues(issue.ID)
+		})
+	}()
+}
@@ -0,0 +1,37 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestIssueFieldLines_FormatsEstimateAndOverdueDueDate verifies team-scaled estimates and overdue highlighting.
+func TestIssueFieldLines_FormatsEstimateAndOverdueDueDate(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+	app.teamsByID["team-1"] = linearapi.Team{ID: "team-1", EstimationType: linearapi.EstimationTShirt}
+	now := time.Date(2025, 3, 5, 9, 0, 0, 0, time.Local)
+
+	estimate := 3
+	issue := linearapi.Issue{TeamID: "team-1", Estimate: &estimate, DueDate: "2025-03-04", Creator: "Ada"}
+	text := strings.Join(app.issueFieldLines(issue, now), "\n")
+	if !strings.Contains(text, "M[-]") {
+		t.Fatalf("estimate not shown as t-shirt size:\n%s", text)
+	}
+	if !strings.Contains(text, app.themeTags.Error+"Tue, Mar 4 2025 (overdue)") {
+		t.Fatalf("due date not highlighted as overdue:\n%s", text)
+	}
+	if !strings.Contains(text, "Ada") {
+		t.Fatalf("creator missing:\n%s", text)
+	}
+
+	issue.DueDate = "2025-03-05"
+	issue.Estimate = nil
+	text = strings.Join(app.issueFieldLines(issue, now), "\n")
+	if strings.Contains(text, "overdue") || !strings.Contains(text, "No estimate") {
+		t.Fatalf("due today or missing estimate rendered wrong:\n%s", text)
+	}
+}
@@ -0,0 +1,297 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// startPolling (re)starts the background check for issue changes every interval; zero stops it.
+func (a *App) startPolling(interval time.Duration) {
+	if a.stopPolling != nil {
+		a.stopPolling()
+		a.stopPolling = nil
+	}
+	if interval <= 0 {
+		return
+	}
+	logger.Debug("tui.poll: polling for issue changes interval=%s", interval)
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopPolling = cancel
+	go a.runPollLoop(ctx, interval)
+}
+
+// runPollLoop polls for issue changes every interval until ctx is cancelled.
+func (a *App) runPollLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.QueueUpdateDraw(a.pollIssues)
+		}
+	}
+}
+
+// pollCursor returns the newest updatedAt known for the current issue list: the newest
+// shown issue, the store's sync cursor for the scope or the last poll, whichever is newer.
+func (a *App) pollCursor(params linearapi.FetchIssuesParams) time.Time {
+	var cursor time.Time
+	a.issuesMu.RLock()
+	for _, issue := range a.issues {
+		if issue.UpdatedAt.After(cursor) {
+			cursor = issue.UpdatedAt
+		}
+	}
+	a.issuesMu.RUnlock()
+	if a.issueStore != nil && !isQueryFetch(params) {
+		if sync, ok := a.issueStore.ScopeSyncState(issueStoreScope(params)); ok && sync.Cursor.After(cursor) {
+			cursor = sync.Cursor
+		}
+	}
+	if !cursor.IsZero() && a.polledUntil.After(cursor) {
+		cursor = a.polledUntil
+	}
+	return cursor
+}
+
+// pollIDChunk is how many shown issues one by-ID poll request checks.
+const pollIDChunk = 50
+
+// pollDeltaParams returns the fetch for issues of the current list's scope updated after
+// cursor, archived ones included. Plain scopes fetch the whole team, like the incremental
+// sync, and are matched locally so issues leaving the view are seen too; searches, filters
+// and custom views are fetched with their own query so new matches are found.
+func pollDeltaParams(params linearapi.FetchIssuesParams, cursor time.Time) linearapi.FetchIssuesParams {
+	if isQueryFetch(params) {
+		params.UpdatedAfter = cursor
+		params.IncludeArchived = true
+		params.OnProgress = nil
+		return params
+	}
+	return linearapi.FetchIssuesParams{
+		TeamID:          params.TeamID,
+		First:           params.First,
+		UpdatedAfter:    cursor,
+		IncludeArchived: true,
+	}
+}
+
+// fetchAllIssuePages fetches every page of params.
+func fetchAllIssuePages(ctx context.Context, fetchPage fetchIssuesPageFunc, params linearapi.FetchIssuesParams) ([]linearapi.Issue, error) {
+	issues := make([]linearapi.Issue, 0)
+	var after *string
+	for {
+		page, err := fetchPage(ctx, params, after)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, page.Issues...)
+		if !page.HasNext {
+			return issues, nil
+		}
+		after = page.EndCursor
+	}
+}
+
+// pollIssues fetches issues of the current list's scope updated since the last sync and
+// merges them into the shown rows. For searches, filters and custom views, shown rows are
+// also checked by ID, so rows that changed and no longer match are dropped. It is skipped
+// while a refresh or another poll is running. Must be called on the UI goroutine.
+func (a *App) pollIssues() {
+	if a.isLoading || a.polling {
+		return
+	}
+	params := a.currentFetchParams()
+	cursor := a.pollCursor(params)
+	if cursor.IsZero() {
+		// Nothing loaded yet; the next refresh fetches the whole list
+		return
+	}
+	delta := pollDeltaParams(params, cursor)
+	var shownIDs []string
+	if isQueryFetch(params) {
+		a.issuesMu.RLock()
+		for _, issue := range a.issues {
+			shownIDs = append(shownIDs, issue.ID)
+		}
+		a.issuesMu.RUnlock()
+	}
+	generation := a.refreshGeneration.Load()
+	fetchPage := a.fetchIssuesPage
+	if fetchPage == nil {
+		fetchPage = a.api.FetchIssuesPage
+	}
+	a.polling = true
+
+	go func() {
+		ctx := context.Background()
+		changed, err := fetchAllIssuePages(ctx, fetchPage, delta)
+		left := make(map[string]bool)
+		for start := 0; err == nil && start < len(shownIDs); start += pollIDChunk {
+			ids := shownIDs[start:min(start+pollIDChunk, len(shownIDs))]
+			var shown []linearapi.Issue
+			shown, err = fetchAllIssuePages(ctx, fetchPage, linearapi.FetchIssuesParams{
+				First:           pollIDChunk,
+				Filter:          linearapi.IssueFilter{"id": map[string]interface{}{"in": ids}},
+				UpdatedAfter:    cursor,
+				IncludeArchived: true,
+			})
+			for _, issue := range shown {
+				if !slices.ContainsFunc(changed, func(match linearapi.Issue) bool { return match.ID == issue.ID }) {
+					// Changed, but no longer returned by the view's query
+					left[issue.ID] = true
+					changed = append(changed, issue)
+				}
+			}
+		}
+
+		a.QueueUpdateDraw(func() {
+			a.polling = false
+			if err != nil {
+				logger.Warning("tui.poll: failed to poll issues error=%v", err)
+				return
+			}
+			if generation != a.refreshGeneration.Load() {
+				// A refresh replaced the list while polling
+				return
+			}
+			for _, issue := range changed {
+				if issue.UpdatedAt.After(a.polledUntil) {
+					a.polledUntil = issue.UpdatedAt
+				}
+			}
+			a.mergePolledIssues(params, changed, left)
+		})
+	}()
+}
+
+// withIssueDetails returns issue with the comments, reactions and subscribers of detailed,
+// which list fetches leave out.
+func withIssueDetails(issue, detailed linearapi.Issue) linearapi.Issue {
+	issue.Comments = detailed.Comments
+	issue.Reactions = detailed.Reactions
+	issue.Subscribers = detailed.Subscribers
+	return issue
+}
+
+// mergePolledIssues merges changed issues into the shown list, keeping the selection and
+// expanded rows. Updated issues keep their place, new ones in the view are shown first and
+// archived issues or issues that left the view are dropped; changed rows are marked. left
+// holds query-view rows that no longer match the query. Must be called on the UI goroutine.
+func (a *App) mergePolledIssues(params linearapi.FetchIssuesParams, changed []linearapi.Issue, left map[string]bool) {
+	if len(changed) == 0 {
+		return
+	}
+	if a.issueStore != nil {
+		if isQueryFetch(params) {
+			a.issueStore.UpsertIssues(changed)
+		} else {
+			a.issueStore.CompleteIncrementalSync(issueStoreScope(params), changed)
+		}
+		a.schedulePersistIssueStore()
+	}
+
+	a.issuesMu.Lock()
+	issues := slices.Clone(a.issues)
+	var added []linearapi.Issue
+	var changedIDs, removedIDs []string
+	for _, issue := range changed {
+		index := slices.IndexFunc(issues, func(shown linearapi.Issue) bool {
+			return shown.ID == issue.ID
+		})
+		inView, known := a.liveIssueInView(issue)
+		if isQueryFetch(params) {
+			// The delta came from the view's own query
+			inView, known = !issue.Archived && !left[issue.ID], true
+		}
+		switch {
+		case index >= 0 && (issue.Archived || (known && !inView)):
+			issues = slices.Delete(issues, index, index+1)
+			removedIDs = append(removedIDs, issue.ID)
+			continue
+		case index >= 0 && !issue.UpdatedAt.After(issues[index].UpdatedAt):
+			// Already shown, e.g. from a live update
+			continue
+		case index >= 0:
+			issues[index] = withIssueDetails(issue, issues[index])
+		case inView:
+			added = append(added, issue)
+		default:
+			// Not shown and not part of the view
+			continue
+		}
+		changedIDs = append(changedIDs, issue.ID)
+	}
+	if len(changedIDs) == 0 && len(removedIDs) == 0 {
+		a.issuesMu.Unlock()
+		return
+	}
+	issues = append(added, issues...)
+	if a.sortField == SortByPriority {
+		sortIssuesByPriority(issues)
+	}
+	a.issues = issues
+	selected := a.selectedIssue
+	a.issuesMu.Unlock()
+
+	logger.Debug("tui.poll: merged polled issues changed=%d new=%d removed=%d", len(changedIDs), len(added), len(removedIDs))
+	a.newIssuesCount += len(added)
+	for _, id := range removedIDs {
+		delete(a.multiSelection, id)
+	}
+	if len(changedIDs) > 0 {
+		a.highlightIssues(changedIDs...)
+	}
+
+	targetIssueID := ""
+	if selected != nil {
+		targetIssueID = selected.ID
+	}
+	next := a.rebuildIssuesTables(targetIssueID)
+	switch {
+	case selected == nil:
+		// Nothing to update in the details view
+	case slices.Contains(removedIDs, selected.ID):
+		if next != nil {
+			a.onIssueSelected(*next)
+		} else {
+			a.issuesMu.Lock()
+			a.selectedIssue = nil
+			a.issuesMu.Unlock()
+			a.updateDetailsView()
+		}
+	case slices.Contains(changedIDs, selected.ID):
+		for _, issue := range changed {
+			if issue.ID == selected.ID {
+				merged := withIssueDetails(issue, *selected)
+				// List fetches carry blockers only; keep every relation the details show
+				merged.Relations = selected.Relations
+				a.issuesMu.Lock()
+				a.selectedIssue = &merged
+				a.issuesMu.Unlock()
+				a.updateDetailsView()
+				break
+			}
+		}
+	}
+	a.updateStatusBar()
+}
+
+// newIssuesStatusText returns the status bar segment counting issues added since the list was loaded.
+func (a *App) newIssuesStatusText() string {
+	switch a.newIssuesCount {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("%s1 new issue[-]", a.themeTags.Accent)
+	default:
+		return fmt.Sprintf("%s%d new issues[-]", a.themeTags.Accent, a.newIssuesCount)
+	}
+}
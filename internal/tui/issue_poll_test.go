@@ -0,0 +1,183 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestPollIssues_MergesChangesKeepingSelectionAndExpansion verifies a poll fetches the team's
+// changes since the newest shown issue and merges those in the view without resetting it.
+func TestPollIssues_MergesChangesKeepingSelectionAndExpansion(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+	app.queueUpdateDraw = func(f func()) { f() }
+	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
+		return linearapi.Issue{}, errors.New("offline")
+	}
+	clearHighlightsAtCleanup(t, app)
+
+	base := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	parent := &linearapi.IssueRef{ID: "issue-1", Identifier: "ABC-1", Title: "Epic"}
+	todo := func(issue linearapi.Issue) linearapi.Issue {
+		issue.TeamID, issue.StateID = "team-1", "state-todo"
+		return issue
+	}
+	onUI(app, func() {
+		app.selectedNavigation = &NavigationNode{IsStatus: true, TeamID: "team-1", StateID: "state-todo"}
+		app.updateIssuesData([]linearapi.Issue{
+			todo(linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", Title: "Epic", UpdatedAt: base,
+				Children: []linearapi.IssueChildRef{{ID: "issue-3", Identifier: "ABC-3"}}}),
+			todo(linearapi.Issue{ID: "issue-2", Identifier: "ABC-2", Title: "Task", UpdatedAt: base.Add(time.Minute)}),
+			todo(linearapi.Issue{ID: "issue-3", Identifier: "ABC-3", Title: "Child", UpdatedAt: base, Parent: parent}),
+			todo(linearapi.Issue{ID: "issue-5", Identifier: "ABC-5", Title: "Moving", UpdatedAt: base}),
+			todo(linearapi.Issue{ID: "issue-6", Identifier: "ABC-6", Title: "Archiving", UpdatedAt: base}),
+		}, "issue-2")
+		app.expandedState["issue-1"] = true
+		app.multiSelection["issue-5"] = true
+		app.fetchingIssueID = "" // Ignore the details fetch started by the initial selection
+		app.selectedIssue.Comments = []linearapi.Comment{{ID: "c-1", Body: "kept"}}
+	})
+
+	var polled linearapi.FetchIssuesParams
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		polled = params
+		done := todo(linearapi.Issue{ID: "issue-5", Identifier: "ABC-5", Title: "Moving", UpdatedAt: base.Add(time.Hour)})
+		done.StateID = "state-done"
+		archived := todo(linearapi.Issue{ID: "issue-6", Identifier: "ABC-6", Title: "Archiving", UpdatedAt: base.Add(time.Hour), Archived: true})
+		elsewhere := todo(linearapi.Issue{ID: "issue-7", Identifier: "ABC-7", Title: "Other team", UpdatedAt: base.Add(time.Hour)})
+		elsewhere.TeamID = "team-2"
+		return linearapi.IssuePage{Issues: []linearapi.Issue{
+			todo(linearapi.Issue{ID: "issue-2", Identifier: "ABC-2", Title: "Task (renamed)", UpdatedAt: base.Add(time.Hour)}),
+			todo(linearapi.Issue{ID: "issue-4", Identifier: "ABC-4", Title: "Fresh", UpdatedAt: base.Add(time.Hour)}),
+			done, archived, elsewhere,
+		}}, nil
+	}
+
+	onUI(app, app.pollIssues)
+	waitForCondition(t, time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return !app.polling
+	})
+
+	onUI(app, func() {
+		if !polled.UpdatedAfter.Equal(base.Add(time.Minute)) || !polled.IncludeArchived {
+			t.Fatalf("polled = %+v, want archived changes since the newest shown issue", polled)
+		}
+		if polled.TeamID != "team-1" || polled.StateID != "" {
+			t.Fatalf("polled = %+v, want the whole team", polled)
+		}
+		if got := issueIDs(app); got != "issue-4,issue-1,issue-2,issue-3" {
+			t.Fatalf("issues = %s", got)
+		}
+		if app.multiSelection["issue-5"] {
+			t.Fatal("issue that left the view is still selected")
+		}
+		selected := app.GetSelectedIssue()
+		if selected == nil || selected.Title != "Task (renamed)" || len(selected.Comments) != 1 {
+			t.Fatalf("selected = %+v, want renamed ABC-2 with its comments", selected)
+		}
+		if id := app.selectedIssueID(IssuesSectionOther); id != "issue-2" {
+			t.Fatalf("table selection = %s, want issue-2", id)
+		}
+		if !app.expandedState["issue-1"] || len(app.otherIssueRows) != 4 {
+			t.Fatalf("expanded = %t rows = %d, want the child row kept", app.expandedState["issue-1"], len(app.otherIssueRows))
+		}
+		if title := app.otherIssuesTable.GetCell(1, 5).Text; !strings.Contains(title, Icons.Changed) {
+			t.Fatalf("new row title = %q, want change marker", title)
+		}
+		if status := app.statusBar.GetText(true); !strings.Contains(status, "1 new issue") {
+			t.Fatalf("status = %q, want new issue count", status)
+		}
+
+		app.updateIssuesData(app.issues)
+		if status := app.statusBar.GetText(true); strings.Contains(status, "new issue") {
+			t.Fatalf("status after reload = %q, want the count cleared", status)
+		}
+	})
+}
+
+// TestPollIssues_QueryViewUsesItsQuery verifies a filtered view polls with its own filter,
+// gaining new matches, and drops shown rows that changed and no longer match.
+func TestPollIssues_QueryViewUsesItsQuery(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+	app.queueUpdateDraw = func(f func()) { f() }
+	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
+		return linearapi.Issue{}, errors.New("offline")
+	}
+	clearHighlightsAtCleanup(t, app)
+
+	base := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	mine := linearapi.IssueFilter{"assignee": map[string]interface{}{"isMe": map[string]interface{}{"eq": true}}}
+	onUI(app, func() {
+		app.selectedNavigation = &NavigationNode{Filter: mine}
+		app.updateIssuesData([]linearapi.Issue{
+			{ID: "issue-1", Identifier: "ABC-1", Title: "Mine", UpdatedAt: base},
+			{ID: "issue-2", Identifier: "ABC-2", Title: "Reassigned", UpdatedAt: base},
+		}, "issue-1")
+		app.fetchingIssueID = ""
+	})
+
+	var mu sync.Mutex
+	var polled []linearapi.FetchIssuesParams
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		mu.Lock()
+		polled = append(polled, params)
+		mu.Unlock()
+		if _, byID := params.Filter["id"]; byID {
+			return linearapi.IssuePage{Issues: []linearapi.Issue{
+				{ID: "issue-2", Identifier: "ABC-2", Title: "Reassigned", UpdatedAt: base.Add(time.Hour)},
+			}}, nil
+		}
+		return linearapi.IssuePage{Issues: []linearapi.Issue{
+			{ID: "issue-3", Identifier: "ABC-3", Title: "New match", UpdatedAt: base.Add(time.Hour)},
+		}}, nil
+	}
+
+	onUI(app, app.pollIssues)
+	waitForCondition(t, time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return !app.polling
+	})
+
+	onUI(app, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(polled) != 2 {
+			t.Fatalf("polled %d times, want the query and one by-ID check", len(polled))
+		}
+		if polled[0].Filter["assignee"] == nil || !polled[0].UpdatedAfter.Equal(base) {
+			t.Fatalf("query poll = %+v, want the view filter since the newest shown issue", polled[0])
+		}
+		ids, _ := polled[1].Filter["id"].(map[string]interface{})["in"].([]string)
+		if strings.Join(ids, ",") != "issue-1,issue-2" || !polled[1].IncludeArchived {
+			t.Fatalf("by-ID poll = %+v, want the shown issues", polled[1])
+		}
+		if got := issueIDs(app); got != "issue-3,issue-1" {
+			t.Fatalf("issues = %s, want the new match added and the reassigned issue dropped", got)
+		}
+	})
+}
+
+// TestPollIssues_SkipsWhileLoading verifies polling leaves running refreshes alone.
+func TestPollIssues_SkipsWhileLoading(t *testing.T) {
+	app, _ := newBulkTestApp(t, 1)
+	app.issues[0].UpdatedAt = time.Now()
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		t.Error("poll fetched issues during a refresh")
+		return linearapi.IssuePage{}, nil
+	}
+
+	app.isLoading = true
+	app.pollIssues()
+	if app.polling {
+		t.Fatal("poll started during a refresh")
+	}
+}
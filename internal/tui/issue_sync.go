@@ -0,0 +1,307 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/store"
+)
+
+// issueStoreSaveDelay is how long store changes from frequent updates, such as issue
+// details fetched while moving through the list, wait to be written together.
+const issueStoreSaveDelay = 2 * time.Second
+
+// fetchIssuesPageFunc fetches a single page of issues.
+type fetchIssuesPageFunc func(context.Context, linearapi.FetchIssuesParams, *string) (linearapi.IssuePage, error)
+
+// SetIssueStore attaches the on-disk issue store used for offline browsing and incremental sync.
+func (a *App) SetIssueStore(issueStore *store.IssueStore) {
+	a.issueStore = issueStore
+}
+
+// currentFetchParams maps the navigation selection, search and sort onto fetch parameters.
+func (a *App) currentFetchParams() linearapi.FetchIssuesParams {
+	query := a.searchQuery
+	if a.selectedNavigation != nil && a.selectedNavigation.View != nil {
+		// A search within a saved view narrows the view's own filter
+		query = combineQueries(savedViewQuery(*a.selectedNavigation.View), a.searchQuery)
+	}
+	params := linearapi.FetchIssuesParams{
+		First:   a.config.PageSize,
+		Search:  query,
+		OrderBy: string(a.sortField),
+	}
+
+	// Filter queries are compiled here so relative dates stay current; invalid
+	// queries are rejected in the search palette, so errors fall back to search
+	if filter, err := linearapi.ParseIssueQuery(query, time.Now()); err == nil && filter != nil {
+		params.Filter = filter
+		params.Search = ""
+	}
+
+	// Apply team/project/state/cycle filter based on navigation selection
+	if a.selectedNavigation != nil {
+		switch {
+		case a.selectedNavigation.IsStatus:
+			params.TeamID = a.selectedNavigation.TeamID
+			params.StateID = a.selectedNavigation.StateID
+		case a.selectedNavigation.IsTeam:
+			params.TeamID = a.selectedNavigation.TeamID
+		case a.selectedNavigation.IsProject:
+			params.TeamID = a.selectedNavigation.TeamID
+			params.ProjectID = a.selectedNavigation.ID
+		case a.selectedNavigation.IsCycle:
+			params.TeamID = a.selectedNavigation.TeamID
+			params.Filter = andIssueFilters(linearapi.Cycle{ID: a.selectedNavigation.CycleID}.IssueFilter(), params.Filter)
+		case a.selectedNavigation.View != nil:
+			params.TeamID = a.selectedNavigation.View.TeamID
+			params.ProjectID = a.selectedNavigation.View.ProjectID
+		case a.selectedNavigation.CustomViewID != "":
+			params.CustomViewID = a.selectedNavigation.CustomViewID
+		case a.selectedNavigation.Filter != nil:
+			params.Filter = andIssueFilters(a.selectedNavigation.Filter, params.Filter)
+		}
+		// If "All Issues", no team/project filter
+	}
+
+	return params
+}
+
+// isQueryFetch reports whether params search or filter issues, which the store cannot replay locally.
+func isQueryFetch(params linearapi.FetchIssuesParams) bool {
+	return params.Search != "" || params.Filter != nil || params.CustomViewID != ""
+}
+
+// issueStoreScope returns the store scope matching fetch parameters.
+func issueStoreScope(params linearapi.FetchIssuesParams) store.Scope {
+	return store.Scope{
+		TeamID:    params.TeamID,
+		ProjectID: params.ProjectID,
+		StateID:   params.StateID,
+	}
+}
+
+// storedIssues returns last-known issues for params, using local matching for search text.
+func (a *App) storedIssues(params linearapi.FetchIssuesParams) []linearapi.Issue {
+	if a.issueStore == nil {
+		return nil
+	}
+	if params.Filter != nil || params.CustomViewID != "" {
+		return nil
+	}
+	scope := issueStoreScope(params)
+	if params.Search != "" {
+		return a.issueStore.SearchIssues(scope, params.Search, params.OrderBy)
+	}
+	return a.issueStore.ScopeIssues(scope, params.OrderBy)
+}
+
+// showStoredIssues renders stored issues for a non-search refresh before the network fetch.
+// It returns true if anything was rendered.
+func (a *App) showStoredIssues(generation int64, params linearapi.FetchIssuesParams, targetIssueID string, allowFocus bool) bool {
+	if a.issueStore == nil || isQueryFetch(params) {
+		return false
+	}
+	issues := a.storedIssues(params)
+	if len(issues) == 0 {
+		return false
+	}
+
+	logger.Debug("tui.app: showing stored issues count=%d", len(issues))
+	a.QueueUpdateDraw(func() {
+		if generation != a.refreshGeneration.Load() {
+			return
+		}
+		a.updateIssuesData(issues, targetIssueID)
+		if allowFocus {
+			a.focusedPane = FocusIssues
+			a.updateFocus()
+		}
+		a.statusBar.SetText(fmt.Sprintf("%sSyncing...[-]", a.themeTags.Warning))
+	})
+	return true
+}
+
+// showOfflineIssues falls back to stored issues after a failed fetch and reports whether
+// any were available. Must be called on the UI goroutine.
+func (a *App) showOfflineIssues(params linearapi.FetchIssuesParams, targetIssueID string, alreadyShown bool) bool {
+	if a.issueStore == nil {
+		return false
+	}
+	if !alreadyShown {
+		issues := a.storedIssues(params)
+		if len(issues) == 0 {
+			return false
+		}
+		a.updateIssuesData(issues, targetIssueID)
+	}
+	logger.Warning("tui.app: offline, showing stored issues")
+	a.offline = true
+	a.updateStatusBar()
+	return true
+}
+
+// incrementalSyncCursor returns the updatedAt cursor for an incremental sync, if one applies.
+// Searches and filter queries always go to the API since they cannot be replayed locally.
+func (a *App) incrementalSyncCursor(params linearapi.FetchIssuesParams) (time.Time, bool) {
+	if a.issueStore == nil || isQueryFetch(params) {
+		return time.Time{}, false
+	}
+	return a.issueStore.IncrementalCursor(issueStoreScope(params), time.Now())
+}
+
+// syncIssuesIncrementally fetches only issues updated after cursor, merges them into the
+// store and re-renders the scope. Must be called from a background goroutine.
+func (a *App) syncIssuesIncrementally(
+	ctx context.Context,
+	generation int64,
+	fetchPage fetchIssuesPageFunc,
+	params linearapi.FetchIssuesParams,
+	cursor time.Time,
+	targetIssueID string,
+	allowFocus bool,
+	showedStored bool,
+) {
+	logger.Debug("tui.app: incremental sync team_id=%s project_id=%s state_id=%s updated_after=%s",
+		params.TeamID, params.ProjectID, params.StateID, cursor.Format(time.RFC3339))
+
+	// Fetch every change in the team rather than only in the scope, so issues that moved
+	// to another status or project, or were archived, are updated in the store as well.
+	// The scope is then read back from the store, which matches issues locally.
+	delta := linearapi.FetchIssuesParams{
+		TeamID:          params.TeamID,
+		First:           params.First,
+		UpdatedAfter:    cursor,
+		IncludeArchived: true,
+	}
+	changed := make([]linearapi.Issue, 0)
+	var after *string
+	for {
+		page, err := fetchPage(ctx, delta, after)
+		if err != nil {
+			a.QueueUpdateDraw(func() {
+				a.isLoading = false
+				logger.ErrorWithErr(err, "tui.app: incremental sync failed")
+				if !a.showOfflineIssues(params, targetIssueID, showedStored) {
+					a.updateStatusBarWithError(err)
+				}
+				a.runQueuedIssuesRefresh()
+			})
+			return
+		}
+		if generation != a.refreshGeneration.Load() {
+			a.QueueUpdateDraw(func() {
+				a.isLoading = false
+				a.runQueuedIssuesRefresh()
+			})
+			return
+		}
+		changed = append(changed, page.Issues...)
+		if !page.HasNext {
+			break
+		}
+		after = page.EndCursor
+	}
+
+	scope := issueStoreScope(params)
+	a.issueStore.CompleteIncrementalSync(scope, changed)
+	a.persistIssueStore()
+	issues := a.issueStore.ScopeIssues(scope, params.OrderBy)
+	logger.Debug("tui.app: incremental sync completed changed=%d total=%d", len(changed), len(issues))
+
+	a.QueueUpdateDraw(func() {
+		a.isLoading = false
+		a.offline = false
+		if len(changed) > 0 || !showedStored || targetIssueID != "" {
+			a.updateIssuesData(issues, targetIssueID)
+		}
+		if allowFocus && !showedStored {
+			a.focusedPane = FocusIssues
+			a.updateFocus()
+		}
+		a.updateStatusBar()
+		a.runQueuedIssuesRefresh()
+		a.replayOutbox()
+	})
+}
+
+// storeFetchedIssues records fetched issues in the store. A complete non-search fetch
+// also resets the scope's sync cursor and prunes issues that disappeared remotely.
+func (a *App) storeFetchedIssues(params linearapi.FetchIssuesParams, fetched []linearapi.Issue, complete bool) {
+	if a.issueStore == nil {
+		return
+	}
+	if complete && !isQueryFetch(params) {
+		a.issueStore.CompleteFullSync(issueStoreScope(params), fetched, time.Now())
+	} else {
+		a.issueStore.UpsertIssues(fetched)
+	}
+	a.persistIssueStore()
+}
+
+// persistIssueStore writes pending store changes to disk, logging failures.
+func (a *App) persistIssueStore() {
+	if a.issueStore == nil {
+		return
+	}
+	if err := a.issueStore.Save(); err != nil {
+		logger.ErrorWithErr(err, "tui.app: failed to save issue store")
+	}
+}
+
+// schedulePersistIssueStore saves the issue store after issueStoreSaveDelay, batching the
+// changes made until then into one write. Safe to call from any goroutine.
+func (a *App) schedulePersistIssueStore() {
+	if a.issueStore == nil {
+		return
+	}
+	a.storeSaveMu.Lock()
+	defer a.storeSaveMu.Unlock()
+	if a.storeSaveTimer != nil {
+		// The pending save picks up this change too
+		return
+	}
+	a.storeSaveTimer = time.AfterFunc(issueStoreSaveDelay, func() {
+		a.storeSaveMu.Lock()
+		a.storeSaveTimer = nil
+		a.storeSaveMu.Unlock()
+		a.persistIssueStore()
+	})
+}
+
+// withStoredProjects persists freshly loaded projects, or falls back to stored ones on error.
+func (a *App) withStoredProjects(teamID string, projects []linearapi.Project, err error) ([]linearapi.Project, error) {
+	if a.issueStore == nil {
+		return projects, err
+	}
+	if err == nil {
+		a.issueStore.SetProjects(teamID, projects)
+		a.persistIssueStore()
+		return projects, nil
+	}
+	if stored := a.issueStore.Projects(teamID); len(stored) > 0 {
+		logger.Debug("tui.app: using stored projects team_id=%s count=%d", teamID, len(stored))
+		return stored, nil
+	}
+	return projects, err
+}
+
+// withStoredWorkflowStates persists freshly loaded states, or falls back to stored ones on error.
+func (a *App) withStoredWorkflowStates(teamID string, states []linearapi.WorkflowState, err error) ([]linearapi.WorkflowState, error) {
+	if a.issueStore == nil {
+		return states, err
+	}
+	if err == nil {
+		a.issueStore.SetWorkflowStates(teamID, states)
+		a.persistIssueStore()
+		return states, nil
+	}
+	if stored := a.issueStore.WorkflowStates(teamID); len(stored) > 0 {
+		logger.Debug("tui.app: using stored workflow states team_id=%s count=%d", teamID, len(stored))
+		return stored, nil
+	}
+	return states, err
+}
@@ -0,0 +1,253 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/store"
+)
+
+// newStoreTestApp builds an app wired to a temporary issue store.
+func newStoreTestApp(t *testing.T) (*App, *store.IssueStore) {
+	t.Helper()
+	issueStore, err := store.OpenIssueStore(filepath.Join(t.TempDir(), "issues.json"))
+	if err != nil {
+		t.Fatalf("OpenIssueStore() error = %v", err)
+	}
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+	app.queueUpdateDraw = func(f func()) { f() }
+	app.SetIssueStore(issueStore)
+	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
+		issue, _ := issueStore.Issue(id)
+		return issue, nil
+	}
+	t.Cleanup(func() {
+		// Drop batched saves so they do not write into the removed temp dir
+		app.storeSaveMu.Lock()
+		defer app.storeSaveMu.Unlock()
+		if app.storeSaveTimer != nil {
+			app.storeSaveTimer.Stop()
+		}
+	})
+	return app, issueStore
+}
+
+// TestOnIssueSelected_BatchesStoreSaves verifies fetched details are not written to disk on every selection.
+func TestOnIssueSelected_BatchesStoreSaves(t *testing.T) {
+	app, _ := newStoreTestApp(t)
+	path := filepath.Join(t.TempDir(), "issues.json")
+	issueStore, err := store.OpenIssueStore(path)
+	if err != nil {
+		t.Fatalf("OpenIssueStore() error = %v", err)
+	}
+	app.SetIssueStore(issueStore)
+	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
+		return linearapi.Issue{ID: id, Identifier: "ABC-1", Title: "Fetched"}, nil
+	}
+
+	onUI(app, func() {
+		app.onIssueSelected(linearapi.Issue{ID: "issue-1", Identifier: "ABC-1"})
+	})
+	waitForCondition(t, time.Second, func() bool {
+		app.storeSaveMu.Lock()
+		defer app.storeSaveMu.Unlock()
+		return app.storeSaveTimer != nil
+	})
+	if stored, _ := issueStore.Issue("issue-1"); stored.Title != "Fetched" {
+		t.Fatalf("stored issue = %+v, want the fetched details", stored)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("issue store written right after selection, stat error = %v", err)
+	}
+}
+
+// TestRefreshIssues_IncrementalSyncMergesChanges verifies only changed issues are fetched.
+func TestRefreshIssues_IncrementalSyncMergesChanges(t *testing.T) {
+	app, issueStore := newStoreTestApp(t)
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	issueStore.CompleteFullSync(store.Scope{}, []linearapi.Issue{
+		{ID: "issue-1", Identifier: "ABC-1", Title: "First", UpdatedAt: base},
+		{ID: "issue-2", Identifier: "ABC-2", Title: "Second", UpdatedAt: base.Add(time.Minute)},
+	}, time.Now())
+
+	paramsCh := make(chan linearapi.FetchIssuesParams, 1)
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		paramsCh <- params
+		return linearapi.IssuePage{Issues: []linearapi.Issue{
+			{ID: "issue-1", Identifier: "ABC-1", Title: "First (edited)", UpdatedAt: base.Add(time.Hour)},
+		}}, nil
+	}
+
+	app.refreshIssues()
+
+	select {
+	case params := <-paramsCh:
+		if !params.UpdatedAfter.Equal(base.Add(time.Minute)) {
+			t.Fatalf("UpdatedAfter = %v, want %v", params.UpdatedAfter, base.Add(time.Minute))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for fetchIssuesPage")
+	}
+
+	waitForCondition(t, time.Second, func() bool {
+		app.issuesMu.RLock()
+		defer app.issuesMu.RUnlock()
+		return len(app.issues) == 2 && app.issues[0].Title == "First (edited)"
+	})
+	waitForCondition(t, time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return !app.isLoading
+	})
+	if app.offline {
+		t.Fatal("offline = true after a successful sync")
+	}
+}
+
+// TestRefreshIssues_IncrementalSyncDropsIssuesLeavingScope verifies the delta covers the whole
+// team, so an issue moved to another status leaves a status view.
+func TestRefreshIssues_IncrementalSyncDropsIssuesLeavingScope(t *testing.T) {
+	app, issueStore := newStoreTestApp(t)
+	app.selectedNavigation = &NavigationNode{IsStatus: true, TeamID: "team-1", StateID: "state-todo"}
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	issueStore.CompleteFullSync(store.Scope{TeamID: "team-1", StateID: "state-todo"}, []linearapi.Issue{
+		{ID: "issue-1", Identifier: "ABC-1", TeamID: "team-1", StateID: "state-todo", UpdatedAt: base},
+		{ID: "issue-2", Identifier: "ABC-2", TeamID: "team-1", StateID: "state-todo", UpdatedAt: base},
+	}, time.Now())
+
+	paramsCh := make(chan linearapi.FetchIssuesParams, 1)
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		paramsCh <- params
+		return linearapi.IssuePage{Issues: []linearapi.Issue{
+			{ID: "issue-1", Identifier: "ABC-1", TeamID: "team-1", StateID: "state-done", UpdatedAt: base.Add(time.Hour)},
+		}}, nil
+	}
+
+	app.refreshIssues()
+
+	select {
+	case params := <-paramsCh:
+		if params.TeamID != "team-1" || params.StateID != "" || !params.IncludeArchived {
+			t.Fatalf("delta params = %+v, want the whole team with archived issues", params)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for fetchIssuesPage")
+	}
+	waitForRefresh(t, app, 1)
+	onUI(app, func() {
+		if got := issueIDs(app); got != "issue-2" {
+			t.Fatalf("issues = %s, want issue-2 only", got)
+		}
+	})
+	if stored, _ := issueStore.Issue("issue-1"); stored.StateID != "state-done" {
+		t.Fatalf("stored state = %s, want state-done", stored.StateID)
+	}
+}
+
+// TestRefreshIssues_OfflineFallsBackToStore verifies stored issues remain visible on fetch errors.
+func TestRefreshIssues_OfflineFallsBackToStore(t *testing.T) {
+	app, issueStore := newStoreTestApp(t)
+	issueStore.UpsertIssues([]linearapi.Issue{
+		{ID: "issue-1", Identifier: "ABC-1", Title: "Cached login bug"},
+		{ID: "issue-2", Identifier: "ABC-2", Title: "Cached other"},
+	})
+
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		return linearapi.IssuePage{}, errors.New("network unreachable")
+	}
+
+	app.searchQuery = "login"
+	app.refreshIssues()
+
+	waitForCondition(t, time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return !app.isLoading && app.offline
+	})
+	app.issuesMu.RLock()
+	defer app.issuesMu.RUnlock()
+	if len(app.issues) != 1 || app.issues[0].ID != "issue-1" {
+		t.Fatalf("issues = %#v, want only the locally matching issue", app.issues)
+	}
+}
+
+// TestRefreshIssues_FullSyncPersistsScope verifies a complete fetch seeds the sync cursor.
+func TestRefreshIssues_FullSyncPersistsScope(t *testing.T) {
+	app, issueStore := newStoreTestApp(t)
+
+	updated := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		return linearapi.IssuePage{Issues: []linearapi.Issue{
+			{ID: "issue-1", Identifier: "ABC-1", TeamID: "team-1", UpdatedAt: updated},
+		}}, nil
+	}
+	app.selectedNavigation = &NavigationNode{ID: "team-1", TeamID: "team-1", IsTeam: true}
+
+	app.refreshIssues()
+
+	waitForCondition(t, time.Second, func() bool {
+		_, ok := issueStore.IncrementalCursor(store.Scope{TeamID: "team-1"}, time.Now())
+		return ok
+	})
+	cursor, _ := issueStore.IncrementalCursor(store.Scope{TeamID: "team-1"}, time.Now())
+	if !cursor.Equal(updated) {
+		t.Fatalf("cursor = %v, want %v", cursor, updated)
+	}
+}
+
+// TestSearchPalette_FilterQuery verifies syntax errors keep the search palette open and
+// valid filter queries are fetched as a filter instead of a full-text search.
+func TestSearchPalette_FilterQuery(t *testing.T) {
+	app, _ := newStoreTestApp(t)
+	paramsCh := make(chan linearapi.FetchIssuesParams, 1)
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		paramsCh <- params
+		return linearapi.IssuePage{}, nil
+	}
+
+	app.openSearchPalette()
+	for _, r := range "label:bug OR" {
+		app.handlePaletteKey(tcell.NewEventKey(tcell.KeyRune, r, 0))
+	}
+	app.handlePaletteKey(tcell.NewEventKey(tcell.KeyEnter, 0, 0))
+	if !app.paletteCtrl.IsSearchMode() || app.searchQuery != "" {
+		t.Fatal("expected an invalid query to keep the search palette open")
+	}
+	if app.paletteList.GetItemCount() != 1 {
+		t.Fatal("expected the syntax error to be listed in the palette")
+	}
+	if text, _ := app.paletteList.GetItemText(0); !strings.Contains(text, "expected a term") {
+		t.Fatalf("palette error = %q", text)
+	}
+
+	app.handlePaletteKey(tcell.NewEventKey(tcell.KeyBackspace2, 0, 0))
+	app.handlePaletteKey(tcell.NewEventKey(tcell.KeyBackspace2, 0, 0))
+	if app.paletteList.GetItemCount() != 0 {
+		t.Fatal("expected the error to clear once the query is valid")
+	}
+	app.handlePaletteKey(tcell.NewEventKey(tcell.KeyEnter, 0, 0))
+
+	select {
+	case params := <-paramsCh:
+		if params.Search != "" || params.Filter == nil {
+			t.Fatalf("params = %+v, want a filter without search text", params)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for fetchIssuesPage")
+	}
+	waitForCondition(t, time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return !app.isLoading
+	})
+}
@@ -122,7 +122,12 @@ func (a *App) buildIssuesTable(title string, section IssuesSection) *tview.Table
 		SetAlign(tview.AlignLeft).
 		SetSelectable(false).
 		SetExpansion(2))
-	table.SetCell(0, 4, tview.NewTableCell("Title").
+	table.SetCell(0, 4, tview.NewTableCell("Cycle").
+		SetStyle(headerStyle).
+		SetAlign(tview.AlignLeft).
+		SetSelectable(false).
+		SetExpansion(1))
+	table.SetCell(0, 5, tview.NewTableCell("Title").
 		SetStyle(headerStyle).
 		SetAlign(tview.AlignLeft).
 		SetSelectable(false).
@@ -270,13 +275,19 @@ func (a *App) setupIssuesTableNavigation(table *tview.Table, section IssuesSecti
 				}
 				return nil
 			case ' ':
-				// Space toggles expand/collapse
+				// Space toggles the issue in the multi-selection; Enter, l and h expand/collapse
 				row, _ := table.GetSelection()
 				if issue := a.getIssueFromRowForSection(row, section); issue != nil {
-					if len(issue.Children) > 0 {
-						a.toggleIssueExpanded(issue.ID)
-						a.activeIssuesSection = section
-					}
+					a.ToggleIssueSelection(issue.ID)
+					a.activeIssuesSection = section
+				}
+				return nil
+			case 'V':
+				// Select from the last toggled issue to this one
+				row, _ := table.GetSelection()
+				if issue := a.getIssueFromRowForSection(row, section); issue != nil {
+					a.SelectIssueRange(section, issue.ID)
+					a.activeIssuesSection = section
 				}
 				return nil
 			}
@@ -369,7 +380,8 @@ func (a *App) getRowForIssueInSection(issueID string, section IssuesSection) int
 }
 
 // renderIssuesTableModel renders a table with the given rows and issue lookup map.
-func renderIssuesTableModel(table *tview.Table, rows []IssueRow, idToIssue map[string]*linearapi.Issue, selectedIssueID string, theme Theme) {
+// badge, if non-nil, returns color-tagged text shown before an issue's title.
+func renderIssuesTableModel(table *tview.Table, rows []IssueRow, idToIssue map[string]*linearapi.Issue, selectedIssueID string, theme Theme, badge func(issueID string) string) {
 	table.Clear()
 
 	// Set column headers with better styling
@@ -398,7 +410,12 @@ func renderIssuesTableModel(table *tview.Table, rows []IssueRow, idToIssue map[s
 		SetAlign(tview.AlignLeft).
 		SetSelectable(false).
 		SetExpansion(2))
-	table.SetCell(0, 4, tview.NewTableCell("Title").
+	table.SetCell(0, 4, tview.NewTableCell("Cycle").
+		SetStyle(headerStyle).
+		SetAlign(tview.AlignLeft).
+		SetSelectable(false).
+		SetExpansion(1))
+	table.SetCell(0, 5, tview.NewTableCell("Title").
 		SetStyle(headerStyle).
 		SetAlign(tview.AlignLeft).
 		SetSelectable(false).
@@ -484,9 +501,21 @@ func renderIssuesTableModel(table *tview.Table, rows []IssueRow, idToIssue map[s
 			SetTextColor(assigneeColor).
 			SetAlign(tview.AlignLeft))
 
+		// Cycle
+		cycle := issue.CycleName
+		if len(cycle) > 12 {
+			cycle = cycle[:12]
+		}
+		table.SetCell(row, 4, tview.NewTableCell(cycle).
+			SetTextColor(theme.SecondaryText).
+			SetAlign(tview.AlignLeft))
+
 		// Title
 		title := issue.Title
-		table.SetCell(row, 4, tview.NewTableCell(title).
+		if badge != nil {
+			title = badge(issue.ID) + title
+		}
+		table.SetCell(row, 5, tview.NewTableCell(title).
 			SetTextColor(theme.Foreground).
 			SetAlign(tview.AlignLeft))
 	}
@@ -514,6 +543,7 @@ func renderIssuesTableModel(table *tview.Table, rows []IssueRow, idToIssue map[s
 			SetAlign(tview.AlignCenter).
 			SetSelectable(false))
 		table.SetCell(1, 4, tview.NewTableCell("").SetSelectable(false))
+		table.SetCell(1, 5, tview.NewTableCell("").SetSelectable(false))
 	}
 }
 
@@ -0,0 +1,123 @@
+package tui
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// Navigation group IDs for Linear's favorites and custom views.
+const (
+	favoritesGroupID   = "favorites"
+	linearViewsGroupID = "linear-views"
+)
+
+// loadLinearViews fetches custom views and favorites and adds them to the navigation tree.
+// Failures only hide the affected group; the rest of the tree still works.
+func (a *App) loadLinearViews(ctx context.Context) {
+	var views []linearapi.CustomView
+	var favorites []linearapi.Favorite
+	var viewsErr, favoritesErr error
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		views, viewsErr = a.api.ListCustomViews(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		favorites, favoritesErr = a.api.ListFavorites(ctx)
+	}()
+	wg.Wait()
+
+	if viewsErr != nil {
+		logger.Warning("tui.linear_views: failed to load custom views error=%v", viewsErr)
+	}
+	if favoritesErr != nil {
+		logger.Warning("tui.linear_views: failed to load favorites error=%v", favoritesErr)
+	}
+	logger.Debug("tui.linear_views: loaded custom_views=%d favorites=%d", len(views), len(favorites))
+
+	a.QueueUpdateDraw(func() {
+		if viewsErr == nil {
+			a.customViews = views
+		}
+		if favoritesErr == nil {
+			a.favorites = favorites
+		}
+		a.refreshNavigationGroups()
+	})
+}
+
+// buildFavoritesGroup builds the "Favorites" navigation group, or returns nil when there are none.
+func (a *App) buildFavoritesGroup() *tview.TreeNode {
+	if len(a.favorites) == 0 {
+		return nil
+	}
+	group := tview.NewTreeNode("Favorites").
+		SetColor(a.theme.Foreground).
+		SetReference(&NavigationNode{ID: favoritesGroupID, Text: "Favorites", IsViewGroup: true}).
+		SetExpanded(true)
+	for _, fav := range a.favorites {
+		group.AddChild(a.buildFavoriteNode(fav, "  "))
+	}
+	return group
+}
+
+// buildFavoriteNode builds the tree node for a favorite; folders nest their favorites.
+func (a *App) buildFavoriteNode(fav linearapi.Favorite, indent string) *tview.TreeNode {
+	navNode := &NavigationNode{
+		ID:           "favorite:" + fav.ID,
+		Text:         fav.Title,
+		IsFavorite:   true,
+		CustomViewID: fav.CustomViewID,
+		Filter:       fav.IssueFilter(),
+	}
+	node := tview.NewTreeNode(indent + fav.Title).
+		SetColor(a.theme.SecondaryText).
+		SetReference(navNode)
+	if fav.Type == linearapi.FavoriteTypeFolder {
+		navNode.IsViewGroup = true
+		node.SetExpanded(true)
+		for _, child := range fav.Children {
+			node.AddChild(a.buildFavoriteNode(child, indent+"  "))
+		}
+	}
+	return node
+}
+
+// buildLinearViewsGroup builds the "Linear Views" navigation group, or returns nil when there are none.
+func (a *App) buildLinearViewsGroup() *tview.TreeNode {
+	if len(a.customViews) == 0 {
+		return nil
+	}
+	group := tview.NewTreeNode("Linear Views").
+		SetColor(a.theme.Foreground).
+		SetReference(&NavigationNode{ID: linearViewsGroupID, Text: "Linear Views", IsViewGroup: true}).
+		SetExpanded(false)
+	for _, view := range a.customViews {
+		group.AddChild(tview.NewTreeNode("  " + view.Name).
+			SetColor(a.theme.SecondaryText).
+			SetReference(&NavigationNode{
+				ID:           "customview:" + view.ID,
+				Text:         view.Name,
+				TeamID:       view.TeamID,
+				CustomViewID: view.ID,
+			}))
+	}
+	return group
+}
+
+// andIssueFilters combines two issue filters, either of which may be nil.
+func andIssueFilters(a, b linearapi.IssueFilter) linearapi.IssueFilter {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	}
+	return linearapi.IssueFilter{"and": []map[string]interface{}{a, b}}
+}
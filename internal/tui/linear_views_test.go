@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// navigationGroupIDs returns the IDs of the root navigation nodes.
+func navigationGroupIDs(tree *tview.TreeView) []string {
+	var ids []string
+	for _, child := range tree.GetRoot().GetChildren() {
+		if navNode, ok := child.GetReference().(*NavigationNode); ok {
+			ids = append(ids, navNode.ID)
+		}
+	}
+	return ids
+}
+
+// TestLinearViews_NavigationAndFetchParams verifies favorites and custom views are listed and fetched.
+func TestLinearViews_NavigationAndFetchParams(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+	app.rebuildNavigationTree([]linearapi.Team{{ID: "team-1", Name: "Engineering"}})
+
+	app.customViews = []linearapi.CustomView{{ID: "view-1", Name: "Triage", TeamID: "team-1"}}
+	app.favorites = []linearapi.Favorite{
+		{ID: "fav-1", Type: linearapi.FavoriteTypeFolder, Title: "Team", Children: []linearapi.Favorite{
+			{ID: "fav-2", Type: linearapi.FavoriteTypeLabel, Title: "bug", LabelID: "label-1"},
+		}},
+	}
+	if !app.refreshNavigationGroups() {
+		t.Fatal("refreshNavigationGroups() = false after teams loaded")
+	}
+
+	want := []string{"all", inboxNodeID, favoritesGroupID, linearViewsGroupID, "team-1"}
+	if got := navigationGroupIDs(app.navigationTree); !reflect.DeepEqual(got, want) {
+		t.Fatalf("navigation roots = %v, want %v", got, want)
+	}
+
+	favorites := app.navigationTree.GetRoot().GetChildren()[2]
+	folder := favorites.GetChildren()[0]
+	label := folder.GetChildren()[0]
+	labelNode := label.GetReference().(*NavigationNode)
+	if !folder.GetReference().(*NavigationNode).IsViewGroup || labelNode.Filter == nil {
+		t.Fatalf("favorite folder/label nodes = %+v / %+v", folder.GetReference(), labelNode)
+	}
+
+	// The cursor stays on the same favorite when the groups are rebuilt
+	app.navigationTree.SetCurrentNode(label)
+	app.refreshNavigationGroups()
+	if current := app.navigationTree.GetCurrentNode(); current.GetReference().(*NavigationNode).ID != labelNode.ID {
+		t.Fatalf("current node = %+v, want %s", current.GetReference(), labelNode.ID)
+	}
+
+	app.selectedNavigation = labelNode
+	app.searchQuery = "assignee:me"
+	params := app.currentFetchParams()
+	and, ok := params.Filter["and"].([]map[string]interface{})
+	if !ok || len(and) != 2 || !reflect.DeepEqual(linearapi.IssueFilter(and[0]), labelNode.Filter) {
+		t.Fatalf("favorite fetch filter = %+v", params.Filter)
+	}
+
+	views := app.navigationTree.GetRoot().GetChildren()[3]
+	app.selectedNavigation = views.GetChildren()[0].GetReference().(*NavigationNode)
+	app.searchQuery = "login"
+	params = app.currentFetchParams()
+	if params.CustomViewID != "view-1" || params.Search != "login" || !isQueryFetch(params) {
+		t.Fatalf("custom view fetch params = %+v", params)
+	}
+}
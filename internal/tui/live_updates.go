@@ -0,0 +1,267 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/live"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// highlightDuration is how long rows changed elsewhere stay marked in the issues tables.
+const highlightDuration = 5 * time.Second
+
+// SetLiveSource attaches a source of changes pushed from Linear, started when the app runs.
+func (a *App) SetLiveSource(source live.Source) {
+	a.liveSource = source
+}
+
+// runLiveUpdates applies events from the live source until ctx is cancelled.
+// Must be called from a background goroutine.
+func (a *App) runLiveUpdates(ctx context.Context) {
+	err := a.liveSource.Run(ctx, func(event live.Event) {
+		a.QueueUpdateDraw(func() {
+			a.applyLiveEvent(event)
+		})
+	})
+	if err != nil && ctx.Err() == nil {
+		logger.ErrorWithErr(err, "tui.live: live updates stopped")
+		a.QueueUpdateDraw(func() {
+			a.updateStatusBarWithError(fmt.Errorf("live updates stopped: %w", err))
+		})
+	}
+}
+
+// applyLiveEvent applies one pushed change. Must be called on the UI goroutine.
+func (a *App) applyLiveEvent(event live.Event) {
+	switch {
+	case event.Issue != nil:
+		a.applyLiveIssue(event.Action, *event.Issue)
+	case event.Comment != nil:
+		a.applyLiveComment(event.Action, *event.Comment)
+	}
+}
+
+// liveIssueInView reports whether issue belongs in the current issue list. known is false
+// for searches, filters and custom views, which cannot be matched locally; those lists
+// only receive changes to issues they already show.
+func (a *App) liveIssueInView(issue linearapi.Issue) (inView, known bool) {
+	params := a.currentFetchParams()
+	if isQueryFetch(params) {
+		return false, false
+	}
+	return !issue.Archived && issueStoreScope(params).Matches(issue), true
+}
+
+// mergeLiveIssue returns incoming with the fields webhook payloads leave out, such as
+// comments, sub-issues and display names, carried over from base.
+func mergeLiveIssue(base, incoming linearapi.Issue) linearapi.Issue {
+	incoming.Comments = base.Comments
+	incoming.Children = base.Children
+	incoming.Relations = base.Relations
+	incoming.Reactions = base.Reactions
+	incoming.Subscribers = base.Subscribers
+	incoming.SLABreachesAt = base.SLABreachesAt
+	if incoming.CreatorID == base.CreatorID {
+		incoming.Creator = base.Creator
+	}
+	if incoming.State == "" && incoming.StateID == base.StateID {
+		incoming.State = base.State
+	}
+	if incoming.Assignee == "" && incoming.AssigneeID == base.AssigneeID {
+		incoming.Assignee = base.Assignee
+	}
+	if incoming.ProjectName == "" && incoming.ProjectID == base.ProjectID {
+		incoming.ProjectName = base.ProjectName
+	}
+	if incoming.CycleName == "" && incoming.CycleID == base.CycleID {
+		incoming.CycleName = base.CycleName
+	}
+	if incoming.Parent != nil && base.Parent != nil && incoming.Parent.ID == base.Parent.ID {
+		incoming.Parent = base.Parent
+	}
+	return incoming
+}
+
+// applyLiveIssue applies a pushed issue change to the issue list and the details view.
+// Updated issues keep their place in the list; new issues are shown first.
+func (a *App) applyLiveIssue(action live.Action, incoming linearapi.Issue) {
+	a.issuesMu.Lock()
+	index := slices.IndexFunc(a.issues, func(issue linearapi.Issue) bool {
+		return issue.ID == incoming.ID
+	})
+	if index >= 0 {
+		if a.issues[index].UpdatedAt.After(incoming.UpdatedAt) {
+			a.issuesMu.Unlock()
+			logger.Debug("tui.live: ignored stale issue change issue=%s", incoming.Identifier)
+			return
+		}
+		incoming = mergeLiveIssue(a.issues[index], incoming)
+	} else if a.issueStore != nil {
+		// Issues outside the list keep what the store knows about them
+		if stored, ok := a.issueStore.Issue(incoming.ID); ok {
+			if stored.UpdatedAt.After(incoming.UpdatedAt) {
+				a.issuesMu.Unlock()
+				logger.Debug("tui.live: ignored stale issue change issue=%s", incoming.Identifier)
+				return
+			}
+			incoming = mergeLiveIssue(stored, incoming)
+		}
+	}
+	if incoming.Parent != nil && incoming.Parent.Identifier == "" {
+		if parent := slices.IndexFunc(a.issues, func(issue linearapi.Issue) bool {
+			return issue.ID == incoming.Parent.ID
+		}); parent >= 0 {
+			incoming.Parent = &linearapi.IssueRef{ID: a.issues[parent].ID, Identifier: a.issues[parent].Identifier, Title: a.issues[parent].Title}
+		}
+	}
+
+	inView, known := a.liveIssueInView(incoming)
+	removed := action == live.ActionRemove || incoming.Archived || (known && !inView)
+	// Copy the list so pointers into the old one, such as the selected issue, stay valid
+	issues := slices.Clone(a.issues)
+	changed := true
+	switch {
+	case removed && index >= 0:
+		issues = slices.Delete(issues, index, index+1)
+	case removed:
+		changed = false
+	case index >= 0:
+		issues[index] = incoming
+	case inView:
+		issues = slices.Insert(issues, 0, incoming)
+		a.newIssuesCount++
+		if a.sortField == SortByPriority {
+			sortIssuesByPriority(issues)
+		}
+	default:
+		changed = false
+	}
+	a.issues = issues
+	selected := a.selectedIssue
+	a.issuesMu.Unlock()
+
+	if a.issueStore != nil {
+		if action == live.ActionRemove {
+			a.issueStore.RemoveIssue(incoming.ID)
+		} else {
+			a.issueStore.UpsertIssue(incoming)
+		}
+		a.schedulePersistIssueStore()
+	}
+	if !changed {
+		logger.Debug("tui.live: issue change outside current view issue=%s action=%s", incoming.Identifier, action)
+		return
+	}
+	logger.Debug("tui.live: applied issue change issue=%s action=%s removed=%t", incoming.Identifier, action, removed)
+
+	if removed {
+		delete(a.multiSelection, incoming.ID)
+	} else {
+		a.highlightIssues(incoming.ID)
+	}
+
+	targetIssueID := ""
+	if selected != nil {
+		targetIssueID = selected.ID
+	}
+	next := a.rebuildIssuesTables(targetIssueID)
+	switch {
+	case selected == nil || selected.ID != incoming.ID:
+		// The selected issue is unchanged; only the tables needed redrawing
+	case !removed:
+		merged := mergeLiveIssue(*selected, incoming)
+		a.issuesMu.Lock()
+		a.selectedIssue = &merged
+		a.issuesMu.Unlock()
+		a.updateDetailsView()
+	case next != nil:
+		a.onIssueSelected(*next)
+	default:
+		a.issuesMu.Lock()
+		a.selectedIssue = nil
+		a.issuesMu.Unlock()
+		a.updateDetailsView()
+	}
+	a.updateStatusBar()
+}
+
+// applyLiveComment applies a pushed comment change to the selected issue's comments and
+// marks the commented issue in the issues tables.
+func (a *App) applyLiveComment(action live.Action, comment linearapi.Comment) {
+	logger.Debug("tui.live: applying comment change issue_id=%s action=%s", comment.IssueID, action)
+	if _, ok := a.idToIssue[comment.IssueID]; ok && action != live.ActionRemove {
+		a.highlightIssues(comment.IssueID)
+		a.redrawIssuesTables()
+	}
+
+	a.issuesMu.Lock()
+	if a.selectedIssue == nil || a.selectedIssue.ID != comment.IssueID {
+		a.issuesMu.Unlock()
+		return
+	}
+	updated := *a.selectedIssue
+	updated.Comments = applyCommentChange(updated.Comments, action, comment)
+	a.selectedIssue = &updated
+	a.issuesMu.Unlock()
+	a.updateDetailsView()
+}
+
+// applyCommentChange returns comments with a pushed create, update or removal applied.
+func applyCommentChange(comments []linearapi.Comment, action live.Action, comment linearapi.Comment) []linearapi.Comment {
+	index := slices.IndexFunc(comments, func(c linearapi.Comment) bool {
+		return c.ID == comment.ID
+	})
+	updated := slices.Clone(comments)
+	switch {
+	case action == live.ActionRemove:
+		if index >= 0 {
+			updated = slices.Delete(updated, index, index+1)
+		}
+	case index >= 0:
+		existing := updated[index]
+		existing.Body = comment.Body
+		existing.UpdatedAt = comment.UpdatedAt
+		updated[index] = existing
+	default:
+		updated = append(updated, comment)
+	}
+	return updated
+}
+
+// highlightIssues marks issues as recently changed until highlightDuration passes.
+func (a *App) highlightIssues(issueIDs ...string) {
+	until := time.Now().Add(highlightDuration)
+	for _, id := range issueIDs {
+		a.highlightedIssues[id] = until
+	}
+	time.AfterFunc(highlightDuration, func() {
+		a.QueueUpdateDraw(a.expireHighlights)
+	})
+}
+
+// expireHighlights removes lapsed change markers and redraws the issues tables if any were shown.
+func (a *App) expireHighlights() {
+	now := time.Now()
+	expired := false
+	for id, until := range a.highlightedIssues {
+		if !now.Before(until) {
+			delete(a.highlightedIssues, id)
+			expired = true
+		}
+	}
+	if expired {
+		a.redrawIssuesTables()
+	}
+}
+
+// highlightBadge returns the marker for issues changed in the last few seconds.
+func (a *App) highlightBadge(issueID string) string {
+	if _, ok := a.highlightedIssues[issueID]; !ok {
+		return ""
+	}
+	return a.themeTags.Warning + Icons.Changed + "[-]"
+}
@@ -0,0 +1,193 @@
+package tui
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/live"
+)
+
+// fakeLiveSource delivers queued events, then waits for cancellation.
+type fakeLiveSource struct {
+	events chan live.Event
+}
+
+// Run hands every queued event to handle until ctx is cancelled.
+func (s *fakeLiveSource) Run(ctx context.Context, handle func(live.Event)) error {
+	for {
+		select {
+		case event := <-s.events:
+			handle(event)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// issueIDs returns the IDs of the app's issues in list order.
+func issueIDs(app *App) string {
+	ids := make([]string, 0, len(app.issues))
+	for _, issue := range app.issues {
+		ids = append(ids, issue.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+// clearHighlightsAtCleanup drops the change markers when the test ends, so highlight
+// timers firing after it have nothing to redraw.
+func clearHighlightsAtCleanup(t *testing.T, app *App) {
+	t.Cleanup(func() {
+		onUI(app, func() { clear(app.highlightedIssues) })
+	})
+}
+
+// TestApplyLiveIssue_UpdatesCreatesAndRemoves verifies pushed issue changes are merged into the list.
+func TestApplyLiveIssue_UpdatesCreatesAndRemoves(t *testing.T) {
+	app, issues := newBulkTestApp(t, 3)
+	clearHighlightsAtCleanup(t, app)
+	now := time.Now()
+
+	edited := issues[1]
+	edited.Title = "Renamed elsewhere"
+	edited.UpdatedAt = now
+	edited.Comments = nil
+	app.issues[1].Comments = []linearapi.Comment{{ID: "c-1", Body: "kept"}}
+	app.applyLiveEvent(live.Event{Action: live.ActionUpdate, Issue: &edited})
+
+	if got := app.issues[1]; got.Title != "Renamed elsewhere" || len(got.Comments) != 1 {
+		t.Fatalf("updated issue = %+v, want new title and kept comments", got)
+	}
+	if title := app.otherIssuesTable.GetCell(2, 5).Text; !strings.Contains(title, Icons.Changed) {
+		t.Fatalf("changed row title = %q, want change marker", title)
+	}
+	if title := app.otherIssuesTable.GetCell(1, 5).Text; strings.Contains(title, Icons.Changed) {
+		t.Fatalf("unchanged row title = %q, want no change marker", title)
+	}
+
+	stale := issues[1]
+	stale.Title = "Older delivery"
+	stale.UpdatedAt = now.Add(-time.Minute)
+	app.applyLiveEvent(live.Event{Action: live.ActionUpdate, Issue: &stale})
+	if got := app.issues[1].Title; got != "Renamed elsewhere" {
+		t.Fatalf("title after stale delivery = %q", got)
+	}
+
+	created := linearapi.Issue{ID: "issue-9", Identifier: "ABC-9", Title: "New", UpdatedAt: now}
+	app.applyLiveEvent(live.Event{Action: live.ActionCreate, Issue: &created})
+	removed := issues[0]
+	app.applyLiveEvent(live.Event{Action: live.ActionRemove, Issue: &removed})
+	archived := issues[2]
+	archived.Archived = true
+	archived.UpdatedAt = now
+	app.applyLiveEvent(live.Event{Action: live.ActionUpdate, Issue: &archived})
+
+	if got := issueIDs(app); got != "issue-9,issue-2" {
+		t.Fatalf("issues = %s, want issue-9,issue-2", got)
+	}
+	if len(app.otherIssueRows) != 2 {
+		t.Fatalf("rows = %d, want 2", len(app.otherIssueRows))
+	}
+}
+
+// TestApplyLiveIssue_KeepsStoredDetails verifies changes to issues missing from the list
+// keep the details the store has for them.
+func TestApplyLiveIssue_KeepsStoredDetails(t *testing.T) {
+	app, issueStore := newStoreTestApp(t)
+	clearHighlightsAtCleanup(t, app)
+	now := time.Now()
+	issueStore.UpsertIssue(linearapi.Issue{
+		ID: "issue-5", Identifier: "ABC-5", Title: "Stored", UpdatedAt: now.Add(-time.Hour),
+		CreatorID: "user-1", Creator: "Ada",
+		Children:  []linearapi.IssueChildRef{{ID: "issue-6", Identifier: "ABC-6"}},
+		Relations: []linearapi.IssueRelation{{ID: "rel-1", Type: linearapi.RelationBlocks}},
+	})
+
+	pushed := linearapi.Issue{ID: "issue-5", Identifier: "ABC-5", Title: "Pushed", UpdatedAt: now, CreatorID: "user-1"}
+	onUI(app, func() {
+		app.applyLiveEvent(live.Event{Action: live.ActionUpdate, Issue: &pushed})
+	})
+
+	stored, ok := issueStore.Issue("issue-5")
+	if !ok || stored.Title != "Pushed" {
+		t.Fatalf("stored issue = %+v, want the pushed title", stored)
+	}
+	if len(stored.Children) != 1 || len(stored.Relations) != 1 || stored.Creator != "Ada" {
+		t.Fatalf("stored issue = %+v, want children, relations and creator kept", stored)
+	}
+
+	stale := linearapi.Issue{ID: "issue-5", Identifier: "ABC-5", Title: "Older delivery", UpdatedAt: now.Add(-2 * time.Hour)}
+	onUI(app, func() {
+		app.issues = nil
+		app.applyLiveEvent(live.Event{Action: live.ActionUpdate, Issue: &stale})
+	})
+	if stored, _ := issueStore.Issue("issue-5"); stored.Title != "Pushed" {
+		t.Fatalf("stored title after stale delivery = %q", stored.Title)
+	}
+}
+
+// TestApplyLiveIssue_QueryViewsOnlyUpdateShownIssues verifies new issues are not guessed into filtered lists.
+func TestApplyLiveIssue_QueryViewsOnlyUpdateShownIssues(t *testing.T) {
+	app, _ := newBulkTestApp(t, 2)
+	clearHighlightsAtCleanup(t, app)
+	app.searchQuery = "login"
+
+	created := linearapi.Issue{ID: "issue-9", Identifier: "ABC-9", Title: "New", UpdatedAt: time.Now()}
+	app.applyLiveEvent(live.Event{Action: live.ActionCreate, Issue: &created})
+	if got := issueIDs(app); got != "issue-1,issue-2" {
+		t.Fatalf("issues = %s, want the search results unchanged", got)
+	}
+}
+
+// TestApplyLiveComment_UpdatesSelectedIssue verifies pushed comment changes reach the details view.
+func TestApplyLiveComment_UpdatesSelectedIssue(t *testing.T) {
+	app, _ := newBulkTestApp(t, 2)
+	clearHighlightsAtCleanup(t, app)
+	app.issuesMu.Lock()
+	app.fetchingIssueID = "" // Ignore the details fetch started by the initial selection
+	app.selectedIssue = &linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", Title: "Task"}
+	app.issuesMu.Unlock()
+
+	comment := linearapi.Comment{ID: "c-1", IssueID: "issue-1", Body: "First take", Author: linearapi.User{Name: "Ada"}}
+	app.applyLiveEvent(live.Event{Action: live.ActionCreate, Comment: &comment})
+	if text := app.detailsCommentsView.GetText(true); !strings.Contains(text, "First take") {
+		t.Fatalf("comments view missing pushed comment:\n%s", text)
+	}
+
+	comment.Body = "Second take"
+	app.applyLiveEvent(live.Event{Action: live.ActionUpdate, Comment: &comment})
+	if got := app.selectedIssue.Comments; len(got) != 1 || got[0].Body != "Second take" || got[0].Author.Name != "Ada" {
+		t.Fatalf("comments after update = %+v", got)
+	}
+
+	app.applyLiveEvent(live.Event{Action: live.ActionRemove, Comment: &comment})
+	if got := app.selectedIssue.Comments; len(got) != 0 {
+		t.Fatalf("comments after remove = %+v, want none", got)
+	}
+}
+
+// TestRunLiveUpdates_AppliesSourceEvents verifies events from the live source are applied on the UI goroutine.
+func TestRunLiveUpdates_AppliesSourceEvents(t *testing.T) {
+	app, issues := newBulkTestApp(t, 2)
+	clearHighlightsAtCleanup(t, app)
+	source := &fakeLiveSource{events: make(chan live.Event, 1)}
+	app.SetLiveSource(source)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go app.runLiveUpdates(ctx)
+
+	edited := issues[0]
+	edited.Title = "Pushed"
+	edited.UpdatedAt = time.Now()
+	source.events <- live.Event{Action: live.ActionUpdate, Issue: &edited}
+
+	waitForCondition(t, time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		app.issuesMu.RLock()
+		defer app.issuesMu.RUnlock()
+		return app.issues[0].Title == "Pushed"
+	})
+}
@@ -2,19 +2,31 @@ package tui
 
 import (
 	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
 )
 
 // NavigationNode represents a node in the navigation tree.
 type NavigationNode struct {
 	ID        string
 	Text      string
-	TeamID    string // For team, project, and status nodes
+	TeamID    string // For team, project, status, and cycle nodes
 	Children  []*NavigationNode
 	IsTeam    bool
 	IsProject bool
 	IsStatus  bool
 	StateID   string
 	StateName string
+	IsCycle   bool
+	CycleID   string
+	// Saved views: View is set on view nodes; IsViewGroup marks collapsible groups of views and favorites
+	View        *config.SavedView
+	IsViewGroup bool
+	// Linear custom views and favorites
+	CustomViewID string
+	Filter       linearapi.IssueFilter // Issue filter for favorited projects, labels, cycles and issues
+	IsFavorite   bool
+	IsInbox      bool // The notifications inbox; selecting it opens the inbox instead of loading issues
 }
 
 // buildNavigationTree creates and configures the navigation tree widget.
@@ -44,6 +56,15 @@ func (a *App) buildNavigationTree() *tview.TreeView {
 		ref := node.GetReference()
 		if ref != nil {
 			if navNode, ok := ref.(*NavigationNode); ok {
+				if navNode.IsInbox {
+					a.ShowInbox()
+					return
+				}
+				// Groups of views and favorites only expand/collapse
+				if navNode.IsViewGroup {
+					node.SetExpanded(!node.IsExpanded())
+					return
+				}
 				// For team nodes, handle expand/collapse
 				if navNode.IsTeam {
 					a.onTeamExpanded(navNode.TeamID, node)
@@ -56,3 +77,89 @@ func (a *App) buildNavigationTree() *tview.TreeView {
 
 	return tree
 }
+
+// buildNavigationGroups builds the saved view, favorite and Linear view groups shown above the teams.
+func (a *App) buildNavigationGroups() []*tview.TreeNode {
+	var groups []*tview.TreeNode
+	for _, group := range []*tview.TreeNode{
+		a.buildSavedViewsGroup(),
+		a.buildFavoritesGroup(),
+		a.buildLinearViewsGroup(),
+	} {
+		if group != nil {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+// refreshNavigationGroups replaces the groups between the inbox and the teams, keeping
+// the cursor on the same node when it still exists. It returns false before teams load.
+func (a *App) refreshNavigationGroups() bool {
+	if a.navigationTree == nil || a.navigationTree.GetRoot() == nil {
+		return false
+	}
+	root := a.navigationTree.GetRoot()
+
+	currentID := ""
+	if current := a.navigationTree.GetCurrentNode(); current != nil {
+		if navNode, ok := current.GetReference().(*NavigationNode); ok {
+			currentID = navNode.ID
+		}
+	}
+
+	var children []*tview.TreeNode
+	expanded := make(map[string]bool)
+	insertAt := -1
+	for _, child := range root.GetChildren() {
+		if navNode, ok := child.GetReference().(*NavigationNode); ok {
+			if navNode.IsViewGroup {
+				expanded[navNode.ID] = child.IsExpanded()
+				continue
+			}
+			if navNode.ID == "all" || navNode.IsInbox {
+				insertAt = len(children) + 1
+			}
+		}
+		children = append(children, child)
+	}
+	// Before teams load there is only a placeholder; groups are added by rebuildNavigationTree
+	if insertAt < 0 {
+		return false
+	}
+
+	groups := a.buildNavigationGroups()
+	for _, group := range groups {
+		if navNode, ok := group.GetReference().(*NavigationNode); ok {
+			if isExpanded, known := expanded[navNode.ID]; known {
+				group.SetExpanded(isExpanded)
+			}
+		}
+	}
+	rest := append([]*tview.TreeNode{}, children[insertAt:]...)
+	children = append(append(children[:insertAt], groups...), rest...)
+	root.SetChildren(children)
+
+	if currentID != "" {
+		for _, group := range groups {
+			if found := findNavigationNode(group, currentID); found != nil {
+				a.navigationTree.SetCurrentNode(found)
+				return true
+			}
+		}
+	}
+	return true
+}
+
+// findNavigationNode returns the node or descendant whose navigation ID matches id.
+func findNavigationNode(node *tview.TreeNode, id string) *tview.TreeNode {
+	if navNode, ok := node.GetReference().(*NavigationNode); ok && navNode.ID == id {
+		return node
+	}
+	for _, child := range node.GetChildren() {
+		if found := findNavigationNode(child, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
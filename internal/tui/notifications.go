@@ -0,0 +1,345 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// inboxNodeID is the navigation ID of the "Inbox" node.
+const inboxNodeID = "inbox"
+
+// notificationPollInterval is how often the inbox is fetched in the background. It does not
+// follow poll_interval, so the unread count and alerts stay current with issue polling off.
+const notificationPollInterval = time.Minute
+
+// notificationClient is the part of the Linear API used by the inbox.
+type notificationClient interface {
+	ListNotifications(ctx context.Context) ([]linearapi.Notification, error)
+	MarkNotificationRead(ctx context.Context, notificationID string, read bool) error
+	SnoozeNotification(ctx context.Context, notificationID string, until time.Time) error
+	ArchiveNotification(ctx context.Context, notificationID string) error
+}
+
+// inboxAPI returns the client used for inbox notifications.
+func (a *App) inboxAPI() notificationClient {
+	if a.notificationAPI != nil {
+		return a.notificationAPI
+	}
+	return a.api
+}
+
+// loadNotifications fetches the inbox in the background and applies it on the UI goroutine.
+// Concurrent calls are coalesced.
+func (a *App) loadNotifications() {
+	if !a.fetchingNotifications.CompareAndSwap(false, true) {
+		return
+	}
+	api := a.inboxAPI()
+
+	go func() {
+		defer a.fetchingNotifications.Store(false)
+		notifications, err := api.ListNotifications(context.Background())
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.Warning("tui.notifications: failed to load notifications error=%v", err)
+				return
+			}
+			a.applyNotifications(notifications)
+		})
+	}()
+}
+
+// runNotificationLoop fetches the inbox every interval until ctx is cancelled.
+func (a *App) runNotificationLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.loadNotifications()
+		}
+	}
+}
+
+// applyNotifications replaces the inbox and announces unread notifications that arrived
+// since the previous fetch. The first fetch only records what is already there.
+// Must be called on the UI goroutine.
+func (a *App) applyNotifications(notifications []linearapi.Notification) {
+	now := time.Now()
+	var arrived []linearapi.Notification
+	for _, notification := range notifications {
+		if a.notificationsLoaded && !a.seenNotifications[notification.ID] &&
+			!notification.IsRead() && !notification.IsSnoozed(now) {
+			arrived = append(arrived, notification)
+		}
+		a.seenNotifications[notification.ID] = true
+	}
+	a.notifications = notifications
+	a.notificationsLoaded = true
+	logger.Debug("tui.notifications: loaded notifications count=%d new=%d", len(notifications), len(arrived))
+
+	a.updateInboxNode()
+	if len(arrived) > 0 {
+		a.announceNotifications(arrived)
+	}
+}
+
+// unreadNotificationCount returns how many inbox notifications are unread and not snoozed.
+func (a *App) unreadNotificationCount() int {
+	now := time.Now()
+	count := 0
+	for _, notification := range a.notifications {
+		if !notification.IsRead() && !notification.IsSnoozed(now) {
+			count++
+		}
+	}
+	return count
+}
+
+// inboxLabel returns the navigation label of the inbox with its unread count.
+func (a *App) inboxLabel() string {
+	if unread := a.unreadNotificationCount(); unread > 0 {
+		return fmt.Sprintf("Inbox (%d)", unread)
+	}
+	return "Inbox"
+}
+
+// updateInboxNode refreshes the unread count shown on the "Inbox" navigation node.
+func (a *App) updateInboxNode() {
+	if a.navigationTree == nil || a.navigationTree.GetRoot() == nil {
+		return
+	}
+	if node := findNavigationNode(a.navigationTree.GetRoot(), inboxNodeID); node != nil {
+		node.SetText(a.inboxLabel())
+	}
+}
+
+// announceNotifications shows newly arrived notifications in the status bar and emits
+// the configured terminal alert.
+func (a *App) announceNotifications(arrived []linearapi.Notification) {
+	logger.Info("tui.notifications: new notifications count=%d", len(arrived))
+	text := arrived[0].Describe()
+	if len(arrived) > 1 {
+		text = fmt.Sprintf("%d new notifications", len(arrived))
+	}
+	a.statusBar.SetText(fmt.Sprintf("%sInbox: %s[-]", a.themeTags.Accent, tview.Escape(text)))
+
+	sequence := notificationAlertSequence(a.config.NotificationAlert, "Linear", text)
+	if sequence == "" || a.alertWriter == nil {
+		return
+	}
+	// Runs on the UI goroutine, so the sequence cannot interleave with a screen update
+	if _, err := fmt.Fprint(a.alertWriter, sequence); err != nil {
+		logger.Warning("tui.notifications: failed to emit alert kind=%s error=%v", a.config.NotificationAlert, err)
+	}
+}
+
+// notificationAlertSequence returns the terminal escape sequence announcing a notification
+// for the given alert kind, or "" when alerts are off.
+func notificationAlertSequence(kind, title, body string) string {
+	title = sanitizeAlertText(title)
+	body = sanitizeAlertText(body)
+	switch kind {
+	case config.NotificationAlertBell:
+		return "\a"
+	case config.NotificationAlertOSC9:
+		return "\x1b]9;" + title + ": " + body + "\a"
+	case config.NotificationAlertOSC777:
+		// Fields are separated by semicolons, so they must not contain any
+		return "\x1b]777;notify;" + strings.ReplaceAll(title, ";", ",") + ";" + strings.ReplaceAll(body, ";", ",") + "\a"
+	default:
+		return ""
+	}
+}
+
+// sanitizeAlertText strips control characters, which would end or corrupt an escape sequence.
+func sanitizeAlertText(text string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return ' '
+		case unicode.IsControl(r):
+			return -1
+		default:
+			return r
+		}
+	}, text)
+}
+
+// ShowInbox lists inbox notifications with unread ones marked and offers actions for the chosen one.
+// Snoozed notifications are hidden until their snooze ends.
+func (a *App) ShowInbox() {
+	// Refresh in the background so the next look is current
+	a.loadNotifications()
+	if !a.notificationsLoaded {
+		a.statusBar.SetText(fmt.Sprintf("%sLoading inbox...[-]", a.themeTags.Warning))
+		return
+	}
+
+	now := time.Now()
+	items := make([]PickerItem, 0, len(a.notifications))
+	for _, notification := range a.notifications {
+		if notification.IsSnoozed(now) {
+			continue
+		}
+		marker := strings.Repeat(" ", len([]rune(Icons.Unread)))
+		if !notification.IsRead() {
+			marker = Icons.Unread
+		}
+		label := fmt.Sprintf("%s%s · %s", marker, notification.Describe(), notification.CreatedAt.Local().Format("Jan 2 15:04"))
+		items = append(items, PickerItem{ID: notification.ID, Label: tview.Escape(label)})
+	}
+	if len(items) == 0 {
+		a.statusBar.SetText(fmt.Sprintf("%sInbox is empty[-]", a.themeTags.SecondaryText))
+		return
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show(fmt.Sprintf("Inbox (%d unread)", a.unreadNotificationCount()), items, func(item PickerItem) {
+		a.pickerActive = false
+		a.showNotificationActions(item.ID)
+	})
+}
+
+// findNotification returns the inbox notification with the given ID.
+func (a *App) findNotification(id string) (linearapi.Notification, bool) {
+	for _, notification := range a.notifications {
+		if notification.ID == id {
+			return notification, true
+		}
+	}
+	return linearapi.Notification{}, false
+}
+
+// tomorrowMorning returns 9:00 on the day after now, in now's location.
+func tomorrowMorning(now time.Time) time.Time {
+	year, month, day := now.Date()
+	return time.Date(year, month, day+1, 9, 0, 0, 0, now.Location())
+}
+
+// showNotificationActions offers open, read state, snooze and archive actions for a notification.
+func (a *App) showNotificationActions(id string) {
+	notification, ok := a.findNotification(id)
+	if !ok {
+		return
+	}
+
+	var items []PickerItem
+	if notification.IssueID != "" {
+		items = append(items, PickerItem{ID: "open", Label: "Open " + tview.Escape(notification.IssueIdentifier)})
+	}
+	if notification.IsRead() {
+		items = append(items, PickerItem{ID: "unread", Label: "Mark as unread"})
+	} else {
+		items = append(items, PickerItem{ID: "read", Label: "Mark as read"})
+	}
+	items = append(items,
+		PickerItem{ID: "snooze_hour", Label: "Snooze for 1 hour"},
+		PickerItem{ID: "snooze_tomorrow", Label: "Snooze until tomorrow 9:00"},
+		PickerItem{ID: "snooze_week", Label: "Snooze for 1 week"},
+		PickerItem{ID: "archive", Label: "Archive"},
+	)
+
+	a.pickerActive = true
+	a.pickerModal.Show(tview.Escape(notification.Describe()), items, func(item PickerItem) {
+		a.pickerActive = false
+		now := time.Now()
+		switch item.ID {
+		case "open":
+			if !notification.IsRead() {
+				a.markNotificationRead(id, true)
+			}
+			a.jumpToIssue(linearapi.IssueRef{
+				ID:         notification.IssueID,
+				Identifier: notification.IssueIdentifier,
+				Title:      notification.IssueTitle,
+			})
+		case "read":
+			a.markNotificationRead(id, true)
+		case "unread":
+			a.markNotificationRead(id, false)
+		case "snooze_hour":
+			a.snoozeNotification(id, now.Add(time.Hour))
+		case "snooze_tomorrow":
+			a.snoozeNotification(id, tomorrowMorning(now))
+		case "snooze_week":
+			a.snoozeNotification(id, now.AddDate(0, 0, 7))
+		case "archive":
+			a.changeNotification(id, "Archived notification", nil, func(ctx context.Context, api notificationClient) error {
+				return api.ArchiveNotification(ctx, id)
+			})
+		}
+	})
+}
+
+// markNotificationRead marks a notification as read or unread.
+func (a *App) markNotificationRead(id string, read bool) {
+	done := "Marked notification as unread"
+	if read {
+		done = "Marked notification as read"
+	}
+	a.changeNotification(id, done, func(notification *linearapi.Notification) {
+		notification.ReadAt = time.Time{}
+		if read {
+			notification.ReadAt = time.Now()
+		}
+	}, func(ctx context.Context, api notificationClient) error {
+		return api.MarkNotificationRead(ctx, id, read)
+	})
+}
+
+// snoozeNotification hides a notification from the inbox until the given time.
+func (a *App) snoozeNotification(id string, until time.Time) {
+	done := fmt.Sprintf("Snoozed notification until %s", until.Format("Jan 2 15:04"))
+	a.changeNotification(id, done, func(notification *linearapi.Notification) {
+		notification.SnoozedUntil = until
+	}, func(ctx context.Context, api notificationClient) error {
+		return api.SnoozeNotification(ctx, id, until)
+	})
+}
+
+// changeNotification applies change to the shown copy of a notification, or removes it when
+// change is nil, then sends the change to Linear with send. A failure reloads the inbox.
+// Must be called on the UI goroutine.
+func (a *App) changeNotification(id, done string, change func(*linearapi.Notification), send func(context.Context, notificationClient) error) {
+	index := slices.IndexFunc(a.notifications, func(notification linearapi.Notification) bool {
+		return notification.ID == id
+	})
+	if index < 0 {
+		return
+	}
+	notifications := slices.Clone(a.notifications)
+	if change == nil {
+		notifications = slices.Delete(notifications, index, index+1)
+	} else {
+		change(&notifications[index])
+	}
+	a.notifications = notifications
+	a.updateInboxNode()
+	api := a.inboxAPI()
+
+	go func() {
+		err := send(context.Background(), api)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.notifications: failed to update notification id=%s", id)
+				a.updateStatusBarWithError(err)
+				a.loadNotifications()
+				return
+			}
+			logger.Debug("tui.notifications: %s id=%s", strings.ToLower(done), id)
+			a.statusBar.SetText(fmt.Sprintf("%s%s[-]", a.themeTags.Accent, done))
+		})
+	}()
+}
@@ -0,0 +1,229 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// fakeNotificationAPI serves an in-memory inbox and records the changes sent to it.
+type fakeNotificationAPI struct {
+	mu            sync.Mutex
+	notifications []linearapi.Notification
+	calls         []string
+}
+
+// ListNotifications returns a copy of the inbox.
+func (f *fakeNotificationAPI) ListNotifications(ctx context.Context) ([]linearapi.Notification, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.notifications), nil
+}
+
+// MarkNotificationRead records the call and updates the inbox.
+func (f *fakeNotificationAPI) MarkNotificationRead(ctx context.Context, notificationID string, read bool) error {
+	return f.update(fmt.Sprintf("read %s %t", notificationID, read), notificationID, func(n *linearapi.Notification) {
+		n.ReadAt = time.Time{}
+		if read {
+			n.ReadAt = time.Now()
+		}
+	})
+}
+
+// SnoozeNotification records the call and updates the inbox.
+func (f *fakeNotificationAPI) SnoozeNotification(ctx context.Context, notificationID string, until time.Time) error {
+	return f.update("snooze "+notificationID, notificationID, func(n *linearapi.Notification) {
+		n.SnoozedUntil = until
+	})
+}
+
+// ArchiveNotification records the call and removes the notification.
+func (f *fakeNotificationAPI) ArchiveNotification(ctx context.Context, notificationID string) error {
+	return f.update("archive "+notificationID, notificationID, nil)
+}
+
+// update records call and applies change to the notification, or removes it when change is nil.
+func (f *fakeNotificationAPI) update(call, notificationID string, change func(*linearapi.Notification)) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, call)
+	for i := range f.notifications {
+		if f.notifications[i].ID != notificationID {
+			continue
+		}
+		if change == nil {
+			f.notifications = slices.Delete(f.notifications, i, i+1)
+		} else {
+			change(&f.notifications[i])
+		}
+		return nil
+	}
+	return fmt.Errorf("notification %s not found", notificationID)
+}
+
+// add appends notifications to the inbox.
+func (f *fakeNotificationAPI) add(notifications ...linearapi.Notification) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.notifications = append(f.notifications, notifications...)
+}
+
+// called reports whether call was sent.
+func (f *fakeNotificationAPI) called(call string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Contains(f.calls, call)
+}
+
+// newInboxTestApp returns an app with a navigation tree and a fake inbox holding notifications.
+func newInboxTestApp(t *testing.T, notifications ...linearapi.Notification) (*App, *fakeNotificationAPI, *bytes.Buffer) {
+	t.Helper()
+	app, _ := newBulkTestApp(t, 2)
+	app.rebuildNavigationTree(nil)
+	api := &fakeNotificationAPI{notifications: notifications}
+	app.notificationAPI = api
+	alerts := &bytes.Buffer{}
+	app.alertWriter = alerts
+	return app, api, alerts
+}
+
+// waitForNotifications waits until the app shows count notifications.
+func waitForNotifications(t *testing.T, app *App, count int) {
+	t.Helper()
+	waitForCondition(t, time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return app.notificationsLoaded && len(app.notifications) == count && !app.fetchingNotifications.Load()
+	})
+}
+
+// inboxNodeText returns the text of the "Inbox" navigation node.
+func inboxNodeText(app *App) string {
+	return findNavigationNode(app.navigationTree.GetRoot(), inboxNodeID).GetText()
+}
+
+// TestLoadNotifications_CountsUnreadAndAlertsOnArrivals verifies the unread count on the inbox
+// node and that only notifications arriving after the first fetch are announced.
+func TestLoadNotifications_CountsUnreadAndAlertsOnArrivals(t *testing.T) {
+	app, api, alerts := newInboxTestApp(t,
+		linearapi.Notification{ID: "n-1", Type: "issueAssignedToYou", Actor: "Ada", IssueIdentifier: "ABC-1", IssueTitle: "Task"},
+		linearapi.Notification{ID: "n-2", Type: "issueNewComment", ReadAt: time.Now()},
+	)
+	app.config.NotificationAlert = config.NotificationAlertOSC777
+
+	app.loadNotifications()
+	waitForNotifications(t, app, 2)
+	if got := inboxNodeText(app); got != "Inbox (1)" {
+		t.Fatalf("inbox node = %q, want Inbox (1)", got)
+	}
+	if alerts.Len() != 0 {
+		t.Fatalf("alerts = %q, want none for the first fetch", alerts.String())
+	}
+
+	api.add(
+		linearapi.Notification{ID: "n-3", Type: "issueMention", Actor: "Grace", IssueIdentifier: "ABC-3", IssueTitle: "Login; broken"},
+		linearapi.Notification{ID: "n-4", Type: "issueMention", SnoozedUntil: time.Now().Add(time.Hour)},
+	)
+	app.loadNotifications()
+	waitForNotifications(t, app, 4)
+
+	if got := inboxNodeText(app); got != "Inbox (2)" {
+		t.Fatalf("inbox node = %q, want Inbox (2)", got)
+	}
+	if want := "\x1b]777;notify;Linear;Grace mentioned you in ABC-3: Login, broken\a"; alerts.String() != want {
+		t.Fatalf("alerts = %q, want %q", alerts.String(), want)
+	}
+	if status := app.statusBar.GetText(true); !strings.Contains(status, "Grace mentioned you in ABC-3") {
+		t.Fatalf("status = %q, want the new notification", status)
+	}
+}
+
+// TestRunNotificationLoop_FetchesWithPollingOff verifies the inbox is fetched on its own ticker
+// when issue polling is disabled.
+func TestRunNotificationLoop_FetchesWithPollingOff(t *testing.T) {
+	app, api, _ := newInboxTestApp(t, linearapi.Notification{ID: "n-1", Type: "issueMention"})
+	app.config.PollInterval = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go app.runNotificationLoop(ctx, 10*time.Millisecond)
+	waitForNotifications(t, app, 1)
+	api.add(linearapi.Notification{ID: "n-2", Type: "issueMention"})
+	waitForNotifications(t, app, 2)
+	onUI(app, func() {
+		if got := inboxNodeText(app); got != "Inbox (2)" {
+			t.Fatalf("inbox node = %q, want Inbox (2)", got)
+		}
+	})
+}
+
+// TestShowInbox_OpensMarksReadAndArchives verifies inbox actions update the list and reach Linear.
+func TestShowInbox_OpensMarksReadAndArchives(t *testing.T) {
+	app, api, _ := newInboxTestApp(t,
+		linearapi.Notification{ID: "n-1", Type: "issueMention", Actor: "Ada", IssueID: "issue-2", IssueIdentifier: "ABC-2", IssueTitle: "Task"},
+		linearapi.Notification{ID: "n-2", Type: "issueNewComment", ReadAt: time.Now(), IssueID: "issue-1", IssueIdentifier: "ABC-1"},
+		linearapi.Notification{ID: "n-3", Type: "issueMention", SnoozedUntil: time.Now().Add(time.Hour)},
+	)
+	app.loadNotifications()
+	waitForNotifications(t, app, 3)
+
+	app.ShowInbox()
+	waitForNotifications(t, app, 3)
+	if title := app.pickerModal.titleView.GetText(true); title != "Inbox (1 unread)" {
+		t.Fatalf("picker title = %q", title)
+	}
+	if len(app.pickerModal.items) != 2 || !strings.HasPrefix(app.pickerModal.items[0].Label, Icons.Unread) {
+		t.Fatalf("picker items = %+v, want the snoozed notification hidden and n-1 marked unread", app.pickerModal.items)
+	}
+
+	app.pickerModal.onSelect(app.pickerModal.items[0])
+	app.pickerModal.onSelect(PickerItem{ID: "open"})
+	waitForCondition(t, time.Second, func() bool {
+		return api.called("read n-1 true")
+	})
+	app.uiUpdateMu.Lock()
+	if selected := app.GetSelectedIssue(); selected == nil || selected.ID != "issue-2" {
+		t.Fatalf("selected = %+v, want the notification's issue", selected)
+	}
+	if got := inboxNodeText(app); got != "Inbox" {
+		t.Fatalf("inbox node = %q, want no unread count", got)
+	}
+	app.uiUpdateMu.Unlock()
+
+	app.showNotificationActions("n-2")
+	app.pickerModal.onSelect(PickerItem{ID: "archive"})
+	waitForCondition(t, time.Second, func() bool {
+		return api.called("archive n-2")
+	})
+	waitForNotifications(t, app, 2)
+	if _, ok := app.findNotification("n-2"); ok {
+		t.Fatal("archived notification still shown")
+	}
+}
+
+// TestNotificationAlertSequence verifies the alert kinds and that control characters are stripped.
+func TestNotificationAlertSequence(t *testing.T) {
+	tests := []struct {
+		kind string
+		want string
+	}{
+		{kind: config.NotificationAlertNone, want: ""},
+		{kind: config.NotificationAlertBell, want: "\a"},
+		{kind: config.NotificationAlertOSC9, want: "\x1b]9;Linear: Ada commented on ABC-1: Fix it\a"},
+		{kind: config.NotificationAlertOSC777, want: "\x1b]777;notify;Linear;Ada commented on ABC-1: Fix it\a"},
+	}
+
+	for _, tt := range tests {
+		if got := notificationAlertSequence(tt.kind, "Linear", "Ada commented on ABC-1:\nFix\x1b it"); got != tt.want {
+			t.Errorf("notificationAlertSequence(%q) = %q, want %q", tt.kind, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+	"github.com/roeyazroel/linear-tui/internal/outbox"
+)
+
+// outboxRetryInterval is how often pending mutations are retried in the background.
+const outboxRetryInterval = 30 * time.Second
+
+// SetOutbox attaches the durable queue used for edits made while the API is unreachable.
+func (a *App) SetOutbox(ob *outbox.Outbox) {
+	a.outbox = ob
+	a.outboxStates = ob.IssueStates()
+}
+
+// queueOfflineMutation queues m if err means the API could not be reached, and
+// reports whether it did. Must be called on the UI goroutine.
+func (a *App) queueOfflineMutation(err error, m outbox.Mutation) bool {
+	if a.outbox == nil || !linearapi.IsUnreachable(err) {
+		return false
+	}
+	queued, qErr := a.outbox.Enqueue(m)
+	if qErr != nil {
+		logger.ErrorWithErr(qErr, "tui.outbox: failed to queue mutation kind=%s", m.Kind)
+		return false
+	}
+	logger.Info("tui.outbox: queued mutation while offline issue=%s kind=%s", queued.Identifier, queued.Kind)
+	a.offline = true
+	a.refreshOutboxMarkers()
+	a.statusBar.SetText(fmt.Sprintf("%sOffline: queued \"%s\"; it will be sent when the connection returns[-]", a.themeTags.Warning, queued.Describe()))
+	return true
+}
+
+// issueIdentifier returns the display identifier of a loaded issue, or its ID.
+// Must be called on the UI goroutine.
+func (a *App) issueIdentifier(issueID string) string {
+	if issue, ok := a.idToIssue[issueID]; ok && issue != nil {
+		return issue.Identifier
+	}
+	return issueID
+}
+
+// refreshOutboxMarkers reloads per-issue outbox states and redraws the tables.
+// Must be called on the UI goroutine.
+func (a *App) refreshOutboxMarkers() {
+	if a.outbox == nil {
+		return
+	}
+	a.outboxStates = a.outbox.IssueStates()
+	a.redrawIssuesTables()
+}
+
+// outboxBadge returns the pending/conflict marker for an issue row.
+func (a *App) outboxBadge(issueID string) string {
+	switch a.outboxStates[issueID] {
+	case outbox.StatePending:
+		return a.themeTags.Warning + Icons.Pending + "[-]"
+	case outbox.StateConflict, outbox.StateFailed:
+		return a.themeTags.Error + Icons.Conflict + "[-]"
+	default:
+		return ""
+	}
+}
+
+// outboxStatusText returns the status bar segment describing queued mutations.
+func (a *App) outboxStatusText() string {
+	if a.outbox == nil {
+		return ""
+	}
+	pending, attention := 0, 0
+	for _, m := range a.outbox.Mutations() {
+		if m.State == outbox.StatePending {
+			pending++
+		} else {
+			attention++
+		}
+	}
+	switch {
+	case attention > 0:
+		return fmt.Sprintf("%s%d pending, %d need attention[-]", a.themeTags.Error, pending, attention)
+	case pending > 0:
+		return fmt.Sprintf("%s%d pending[-]", a.themeTags.Warning, pending)
+	default:
+		return ""
+	}
+}
+
+// hasPendingMutations reports whether any queued mutation is waiting for replay.
+func (a *App) hasPendingMutations() bool {
+	if a.outbox == nil {
+		return false
+	}
+	for _, m := range a.outbox.Mutations() {
+		if m.State == outbox.StatePending {
+			return true
+		}
+	}
+	return false
+}
+
+// replayOutbox sends pending mutations in the background. Concurrent calls are
+// coalesced; applied changes trigger an issues refresh.
+func (a *App) replayOutbox() {
+	if !a.hasPendingMutations() {
+		return
+	}
+	if !a.outboxReplaying.CompareAndSwap(false, true) {
+		return
+	}
+
+	api := a.outboxAPI
+	if api == nil {
+		api = a.api
+	}
+
+	go func() {
+		defer a.outboxReplaying.Store(false)
+
+		logger.Debug("tui.outbox: replaying pending mutations count=%d", a.outbox.Len())
+		result := outbox.Replay(context.Background(), a.outbox, api)
+
+		a.QueueUpdateDraw(func() {
+			if result.Unreachable {
+				logger.Debug("tui.outbox: API still unreachable, keeping queue")
+			}
+			a.refreshOutboxMarkers()
+			a.updateStatusBar()
+			switch {
+			case len(result.Conflicts) > 0:
+				a.statusBar.SetText(fmt.Sprintf("%s%d queued change(s) conflict with remote edits; open \"Pending changes\" to resolve[-]", a.themeTags.Error, len(result.Conflicts)))
+			case len(result.Failed) > 0:
+				a.statusBar.SetText(fmt.Sprintf("%s%d queued change(s) were rejected; open \"Pending changes\" to review[-]", a.themeTags.Error, len(result.Failed)))
+			}
+			if len(result.Applied) > 0 {
+				logger.Info("tui.outbox: replayed queued mutations count=%d", len(result.Applied))
+				issueID := ""
+				if selected := a.GetSelectedIssue(); selected != nil {
+					issueID = selected.ID
+				}
+				go a.refreshIssuesWithFocusChange(false, issueID)
+			}
+		})
+	}()
+}
+
+// runOutboxRetryLoop periodically retries pending mutations while the app runs.
+func (a *App) runOutboxRetryLoop() {
+	ticker := time.NewTicker(outboxRetryInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		a.replayOutbox()
+	}
+}
+
+// ShowPendingChanges lists queued mutations and lets the user retry or discard them.
+func (a *App) ShowPendingChanges() {
+	if a.outbox == nil {
+		return
+	}
+	mutations := a.outbox.Mutations()
+	if len(mutations) == 0 {
+		a.statusBar.SetText(fmt.Sprintf("%sNo pending changes[-]", a.themeTags.SecondaryText))
+		return
+	}
+
+	items := make([]PickerItem, 0, len(mutations))
+	for _, m := range mutations {
+		label := fmt.Sprintf("%s: %s · queued %s", m.State, m.Describe(), m.QueuedAt.Format("Jan 2 15:04"))
+		if m.LastError != "" {
+			label += " · " + m.LastError
+		}
+		items = append(items, PickerItem{ID: m.ID, Label: tview.Escape(label)})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show("Pending Changes", items, func(item PickerItem) {
+		a.pickerActive = false
+		a.showPendingChangeActions(item.ID)
+	})
+}
+
+// showPendingChangeActions offers retry/discard actions for a queued mutation.
+func (a *App) showPendingChangeActions(id string) {
+	m, ok := a.outbox.Get(id)
+	if !ok {
+		return
+	}
+
+	items := []PickerItem{{ID: "retry", Label: "Retry now"}}
+	if m.State == outbox.StateConflict {
+		items = append(items, PickerItem{ID: "force", Label: "Apply anyway (overwrite remote changes)"})
+	}
+	items = append(items, PickerItem{ID: "discard", Label: "Discard change"})
+
+	a.pickerActive = true
+	a.pickerModal.Show(tview.Escape(m.Describe()), items, func(item PickerItem) {
+		a.pickerActive = false
+		var err error
+		switch item.ID {
+		case "retry":
+			err = a.outbox.Requeue(id, false)
+		case "force":
+			err = a.outbox.Requeue(id, true)
+		case "discard":
+			err = a.outbox.Remove(id)
+			logger.Info("tui.outbox: discarded queued mutation issue=%s kind=%s", m.Identifier, m.Kind)
+		}
+		if err != nil {
+			logger.ErrorWithErr(err, "tui.outbox: failed to update queued mutation id=%s", id)
+			a.updateStatusBarWithError(err)
+			return
+		}
+		a.refreshOutboxMarkers()
+		a.updateStatusBar()
+		a.replayOutbox()
+	})
+}
@@ -0,0 +1,120 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/outbox"
+)
+
+// replayAPIStub implements outbox.API for replay tests.
+type replayAPIStub struct {
+	issue   linearapi.Issue
+	updates chan linearapi.UpdateIssueInput
+}
+
+func (s *replayAPIStub) FetchIssueByID(ctx context.Context, id string) (linearapi.Issue, error) {
+	return s.issue, nil
+}
+
+func (s *replayAPIStub) UpdateIssue(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+	s.updates <- input
+	return s.issue, nil
+}
+
+func (s *replayAPIStub) CreateComment(ctx context.Context, input linearapi.CreateCommentInput) (linearapi.Comment, error) {
+	return linearapi.Comment{}, nil
+}
+
+func (s *replayAPIStub) CreateIssue(ctx context.Context, input linearapi.CreateIssueInput) (linearapi.Issue, error) {
+	return linearapi.Issue{}, nil
+}
+
+func (s *replayAPIStub) ArchiveIssue(ctx context.Context, issueID string) error {
+	return nil
+}
+
+// newOutboxTestApp builds an app wired to a temporary outbox.
+func newOutboxTestApp(t *testing.T) (*App, *outbox.Outbox) {
+	t.Helper()
+	app, _ := newStoreTestApp(t)
+	ob, err := outbox.Open(filepath.Join(t.TempDir(), "outbox.json"))
+	if err != nil {
+		t.Fatalf("outbox.Open() error = %v", err)
+	}
+	app.SetOutbox(ob)
+	return app, ob
+}
+
+// TestQueueOfflineMutation_QueuesOnlyUnreachableErrors verifies API rejections are not queued.
+func TestQueueOfflineMutation_QueuesOnlyUnreachableErrors(t *testing.T) {
+	app, ob := newOutboxTestApp(t)
+	issue := linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", UpdatedAt: time.Now()}
+	stateID := "state-done"
+	m := outbox.NewUpdateIssue(issue, linearapi.UpdateIssueInput{ID: issue.ID, StateID: &stateID})
+
+	if app.queueOfflineMutation(errors.New("invalid state"), m) {
+		t.Fatal("queueOfflineMutation(api error) = true, want false")
+	}
+
+	offlineErr := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	if !app.queueOfflineMutation(offlineErr, m) {
+		t.Fatal("queueOfflineMutation(network error) = false, want true")
+	}
+	if ob.Len() != 1 {
+		t.Fatalf("outbox Len() = %d, want 1", ob.Len())
+	}
+	if badge := app.issueBadge("issue-1"); !strings.Contains(badge, Icons.Pending) {
+		t.Fatalf("issueBadge() = %q, want pending marker", badge)
+	}
+	if !strings.Contains(app.outboxStatusText(), "1 pending") {
+		t.Fatalf("outboxStatusText() = %q", app.outboxStatusText())
+	}
+}
+
+// TestReplayOutbox_AppliesAndClearsMarkers verifies queued edits are sent once the API responds.
+func TestReplayOutbox_AppliesAndClearsMarkers(t *testing.T) {
+	app, ob := newOutboxTestApp(t)
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		return linearapi.IssuePage{}, nil
+	}
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	issue := linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", UpdatedAt: base}
+	stub := &replayAPIStub{issue: issue, updates: make(chan linearapi.UpdateIssueInput, 1)}
+	app.outboxAPI = stub
+
+	title := "Renamed offline"
+	if _, err := ob.Enqueue(outbox.NewUpdateIssue(issue, linearapi.UpdateIssueInput{ID: issue.ID, Title: &title})); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	app.SetOutbox(ob)
+
+	app.replayOutbox()
+
+	select {
+	case input := <-stub.updates:
+		if input.Title == nil || *input.Title != title {
+			t.Fatalf("replayed input = %#v", input)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for replayed update")
+	}
+	waitForCondition(t, time.Second, func() bool {
+		return !app.outboxReplaying.Load()
+	})
+	// Applied changes refresh the issue list
+	waitForRefresh(t, app, 1)
+	if ob.Len() != 0 {
+		t.Fatalf("outbox Len() = %d after replay, want 0", ob.Len())
+	}
+	if badge := app.issueBadge("issue-1"); badge != "" {
+		t.Fatalf("issueBadge() = %q after replay, want empty", badge)
+	}
+}
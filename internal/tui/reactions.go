@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// reactionLine formats aggregated reactions as "👍 2  ❤️ 1", highlighting the ones I added.
+func (a *App) reactionLine(reactions []linearapi.Reaction) string {
+	summaries := linearapi.SummarizeReactions(reactions)
+	parts := make([]string, 0, len(summaries))
+	for _, summary := range summaries {
+		color := a.themeTags.SecondaryText
+		if summary.MyReactionID != "" {
+			color = a.themeTags.Accent
+		}
+		parts = append(parts, fmt.Sprintf("%s%s %d[-]", color, linearapi.ReactionGlyph(summary.Emoji), summary.Count))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// reactionPickerItems lists the default reactions plus any others already present.
+// Emoji I already reacted with are marked for removal.
+func reactionPickerItems(reactions []linearapi.Reaction) []PickerItem {
+	summaries := linearapi.SummarizeReactions(reactions)
+	mine := make(map[string]bool)
+	present := make(map[string]bool)
+	for _, summary := range summaries {
+		present[summary.Emoji] = true
+		mine[summary.Emoji] = summary.MyReactionID != ""
+	}
+
+	names := make([]string, 0, len(linearapi.DefaultReactions))
+	offered := make(map[string]bool)
+	for _, emoji := range linearapi.DefaultReactions {
+		names = append(names, emoji.Name)
+		offered[emoji.Name] = true
+	}
+	for _, summary := range summaries {
+		if !offered[summary.Emoji] {
+			names = append(names, summary.Emoji)
+		}
+	}
+
+	items := make([]PickerItem, 0, len(names))
+	for _, name := range names {
+		label := fmt.Sprintf("%s  %s", linearapi.ReactionGlyph(name), name)
+		if mine[name] {
+			label += " - remove my reaction"
+		} else if present[name] {
+			label += " - add"
+		}
+		items = append(items, PickerItem{ID: name, Label: label})
+	}
+	return items
+}
+
+// ShowCommentReactionPicker adds or removes my reaction on the focused comment.
+func (a *App) ShowCommentReactionPicker() {
+	issue := a.GetSelectedIssue()
+	comment, ok := a.selectedComment()
+	if issue == nil || !ok {
+		a.updateStatusBarWithError(fmt.Errorf("no comment selected"))
+		return
+	}
+	a.showReactionPicker("React to Comment", issue.ID, comment.Reactions, func(ctx context.Context, emoji string) error {
+		_, err := a.GetAPI().AddCommentReaction(ctx, comment.ID, emoji)
+		return err
+	})
+}
+
+// ShowIssueReactionPicker adds or removes my reaction on the issue.
+func (a *App) ShowIssueReactionPicker(issue linearapi.Issue) {
+	a.showReactionPicker("React to "+issue.Identifier, issue.ID, issue.Reactions, func(ctx context.Context, emoji string) error {
+		_, err := a.GetAPI().AddIssueReaction(ctx, issue.ID, emoji)
+		return err
+	})
+}
+
+// showReactionPicker toggles the picked emoji: it removes my existing reaction or adds a new one.
+func (a *App) showReactionPicker(title, issueID string, reactions []linearapi.Reaction, add func(ctx context.Context, emoji string) error) {
+	myReactions := make(map[string]string)
+	for _, summary := range linearapi.SummarizeReactions(reactions) {
+		if summary.MyReactionID != "" {
+			myReactions[summary.Emoji] = summary.MyReactionID
+		}
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show(title, reactionPickerItems(reactions), func(item PickerItem) {
+		a.pickerActive = false
+		emoji := item.ID
+		reactionID, remove := myReactions[emoji]
+		go func() {
+			var err error
+			if remove {
+				err = a.GetAPI().DeleteReaction(context.Background(), reactionID)
+			} else {
+				err = add(context.Background(), emoji)
+			}
+			a.QueueUpdateDraw(func() {
+				if err != nil {
+					logger.ErrorWithErr(err, "tui.reactions: failed to toggle reaction emoji=%s remove=%t", emoji, remove)
+					a.updateStatusBarWithError(err)
+					return
+				}
+				logger.Info("tui.reactions: toggled reaction emoji=%s remove=%t issue_id=%s", emoji, remove, issueID)
+				a.refreshSelectedIssueDetails(issueID)
+			})
+		}()
+	})
+}
@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestReactionPickerItems verifies defaults, extra emoji and removal markers.
+func TestReactionPickerItems(t *testing.T) {
+	items := reactionPickerItems([]linearapi.Reaction{
+		{ID: "r-1", Emoji: "+1", IsMine: true},
+		{ID: "r-2", Emoji: "heart"},
+		{ID: "r-3", Emoji: "party_parrot"},
+	})
+	if len(items) != len(linearapi.DefaultReactions)+1 {
+		t.Fatalf("items = %d, want defaults plus party_parrot", len(items))
+	}
+	labels := make(map[string]string, len(items))
+	for _, item := range items {
+		labels[item.ID] = item.Label
+	}
+	if !strings.HasSuffix(labels["+1"], "remove my reaction") || !strings.HasSuffix(labels["heart"], "- add") {
+		t.Fatalf("labels = %v", labels)
+	}
+	if labels["party_parrot"] != ":party_parrot:  party_parrot - add" || strings.Contains(labels["eyes"], " - ") {
+		t.Fatalf("labels = %v", labels)
+	}
+}
+
+// TestCommentsView_ShowsReactions verifies aggregated reaction counts under a comment.
+func TestCommentsView_ShowsReactions(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+	app.selectedIssue = &linearapi.Issue{ID: "issue-1", Comments: []linearapi.Comment{{
+		ID: "c-1", Body: "Shipped", Author: linearapi.User{Name: "Bob"},
+		Reactions: []linearapi.Reaction{{Emoji: "tada"}, {Emoji: "tada", IsMine: true}, {Emoji: "eyes"}},
+	}}}
+	app.updateDetailsView()
+
+	if text := app.detailsCommentsView.GetText(true); !strings.Contains(text, "🎉 2  👀 1") {
+		t.Fatalf("comments view missing reactions:\n%s", text)
+	}
+}
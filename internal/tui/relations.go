@@ -0,0 +1,182 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// relationTypeOrder is the order relation types are offered and listed in.
+var relationTypeOrder = []string{
+	linearapi.RelationBlockedBy,
+	linearapi.RelationBlocks,
+	linearapi.RelationRelated,
+	linearapi.RelationDuplicate,
+	linearapi.RelationDuplicatedBy,
+}
+
+// sortedRelations returns relations grouped in relationTypeOrder, keeping API order within a type.
+func sortedRelations(relations []linearapi.IssueRelation) []linearapi.IssueRelation {
+	sorted := make([]linearapi.IssueRelation, 0, len(relations))
+	for _, relType := range relationTypeOrder {
+		for _, rel := range relations {
+			if rel.Type == relType {
+				sorted = append(sorted, rel)
+			}
+		}
+	}
+	return sorted
+}
+
+// relationPickerLabel formats a relation as "Blocked by ABC-12 - Title".
+func relationPickerLabel(rel linearapi.IssueRelation) string {
+	return fmt.Sprintf("%s %s - %s", linearapi.RelationLabel(rel.Type), rel.Issue.Identifier, rel.Issue.Title)
+}
+
+// blockedBadge returns the issues table marker for issues blocked by an unresolved issue.
+func (a *App) blockedBadge(issueID string) string {
+	issue, ok := a.idToIssue[issueID]
+	if !ok || issue == nil || !issue.IsBlocked() {
+		return ""
+	}
+	return a.themeTags.Error + Icons.Blocked + "[-]"
+}
+
+// ShowAddRelationPicker asks for a relation type, then for the related issue from the current list.
+func (a *App) ShowAddRelationPicker(issue linearapi.Issue) {
+	typeItems := make([]PickerItem, 0, len(relationTypeOrder))
+	for _, relType := range relationTypeOrder {
+		typeItems = append(typeItems, PickerItem{ID: relType, Label: linearapi.RelationLabel(relType)})
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show("Relation Type", typeItems, func(typeItem PickerItem) {
+		a.pickerActive = false
+
+		related := make(map[string]bool)
+		for _, rel := range issue.Relations {
+			if rel.Type == typeItem.ID {
+				related[rel.Issue.ID] = true
+			}
+		}
+		a.issuesMu.RLock()
+		issues := a.issues
+		a.issuesMu.RUnlock()
+		items := make([]PickerItem, 0, len(issues))
+		for _, candidate := range issues {
+			if candidate.ID == issue.ID || related[candidate.ID] {
+				continue
+			}
+			items = append(items, PickerItem{
+				ID:    candidate.ID,
+				Label: candidate.Identifier + " - " + candidate.Title,
+			})
+		}
+		if len(items) == 0 {
+			logger.Warning("tui.relations: no issues available for relation picker")
+			a.updateStatusBarWithError(fmt.Errorf("no issues available to relate"))
+			return
+		}
+
+		a.pickerActive = true
+		a.pickerModal.Show(fmt.Sprintf("%s %s", issue.Identifier, linearapi.RelationLabel(typeItem.ID)), items, func(item PickerItem) {
+			a.pickerActive = false
+			a.addRelation(issue, item.ID, typeItem.ID)
+		})
+	})
+}
+
+// addRelation creates a relation from issue to relatedIssueID and refreshes the issue.
+func (a *App) addRelation(issue linearapi.Issue, relatedIssueID, relationType string) {
+	go func() {
+		err := a.GetAPI().CreateIssueRelation(context.Background(), issue.ID, relatedIssueID, relationType)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.relations: failed to add relation issue=%s type=%s", issue.Identifier, relationType)
+				a.updateStatusBarWithError(err)
+				return
+			}
+			logger.Info("tui.relations: added relation issue=%s type=%s related_issue_id=%s", issue.Identifier, relationType, relatedIssueID)
+			go a.refreshIssues(issue.ID)
+		})
+	}()
+}
+
+// ShowRemoveRelationPicker lists an issue's relations and removes the chosen one.
+func (a *App) ShowRemoveRelationPicker(issue linearapi.Issue) {
+	a.showRelationPicker(issue, "Remove Relation", func(rel linearapi.IssueRelation) {
+		go func() {
+			err := a.GetAPI().DeleteIssueRelation(context.Background(), rel.ID)
+			a.QueueUpdateDraw(func() {
+				if err != nil {
+					logger.ErrorWithErr(err, "tui.relations: failed to remove relation issue=%s relation_id=%s", issue.Identifier, rel.ID)
+					a.updateStatusBarWithError(err)
+					return
+				}
+				logger.Info("tui.relations: removed relation issue=%s type=%s related=%s", issue.Identifier, rel.Type, rel.Issue.Identifier)
+				go a.refreshIssues(issue.ID)
+			})
+		}()
+	})
+}
+
+// ShowJumpToRelatedPicker lists an issue's relations and jumps to the chosen issue.
+func (a *App) ShowJumpToRelatedPicker(issue linearapi.Issue) {
+	a.showRelationPicker(issue, "Go to Related Issue", func(rel linearapi.IssueRelation) {
+		a.jumpToIssue(rel.Issue)
+	})
+}
+
+// showRelationPicker shows a picker of the issue's relations.
+func (a *App) showRelationPicker(issue linearapi.Issue, title string, onSelect func(linearapi.IssueRelation)) {
+	relations := sortedRelations(issue.Relations)
+	if len(relations) == 0 {
+		a.updateStatusBarWithError(fmt.Errorf("%s has no relations", issue.Identifier))
+		return
+	}
+
+	items := make([]PickerItem, 0, len(relations))
+	byID := make(map[string]linearapi.IssueRelation, len(relations))
+	for _, rel := range relations {
+		items = append(items, PickerItem{ID: rel.ID, Label: relationPickerLabel(rel)})
+		byID[rel.ID] = rel
+	}
+
+	a.pickerActive = true
+	a.pickerModal.Show(title, items, func(item PickerItem) {
+		a.pickerActive = false
+		if rel, ok := byID[item.ID]; ok {
+			onSelect(rel)
+		}
+	})
+}
+
+// jumpToIssue selects an issue in the issues tables, or shows it in the details pane
+// when it is not part of the current list.
+func (a *App) jumpToIssue(ref linearapi.IssueRef) {
+	for _, section := range []IssuesSection{IssuesSectionMy, IssuesSectionOther} {
+		row := a.getRowForIssueInSection(ref.ID, section)
+		if row <= 0 {
+			continue
+		}
+		table := a.myIssuesTable
+		if section == IssuesSectionOther {
+			table = a.otherIssuesTable
+		}
+		a.activeIssuesSection = section
+		table.Select(row, 0)
+		if issue := a.getIssueFromRowForSection(row, section); issue != nil {
+			a.onIssueSelected(*issue)
+		}
+		a.focusedPane = FocusIssues
+		a.updateFocus()
+		return
+	}
+
+	logger.Debug("tui.relations: related issue not in list, showing details issue=%s", ref.Identifier)
+	a.onIssueSelected(linearapi.Issue{ID: ref.ID, Identifier: ref.Identifier, Title: ref.Title})
+	a.focusedPane = FocusDetails
+	a.updateFocus()
+}
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestRelations_BadgeAndOrder verifies the blocked marker and the order relations are listed in.
+func TestRelations_BadgeAndOrder(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+
+	blocked := &linearapi.Issue{ID: "i-1", Relations: []linearapi.IssueRelation{
+		{ID: "rel-1", Type: linearapi.RelationRelated, Issue: linearapi.IssueRef{Identifier: "ABC-3"}},
+		{ID: "rel-2", Type: linearapi.RelationBlockedBy, Issue: linearapi.IssueRef{Identifier: "ABC-2"}, StateType: "started"},
+	}}
+	resolved := &linearapi.Issue{ID: "i-2", Relations: []linearapi.IssueRelation{
+		{ID: "rel-3", Type: linearapi.RelationBlockedBy, StateType: "completed"},
+	}}
+	app.idToIssue = map[string]*linearapi.Issue{"i-1": blocked, "i-2": resolved}
+
+	if badge := app.blockedBadge("i-1"); !strings.Contains(badge, Icons.Blocked) {
+		t.Fatalf("blockedBadge(i-1) = %q, want blocked marker", badge)
+	}
+	if badge := app.blockedBadge("i-2"); badge != "" {
+		t.Fatalf("blockedBadge(i-2) = %q, want none for a resolved blocker", badge)
+	}
+
+	sorted := sortedRelations(blocked.Relations)
+	if sorted[0].ID != "rel-2" || sorted[1].ID != "rel-1" {
+		t.Fatalf("sortedRelations() = %+v, want blocked by first", sorted)
+	}
+	if got := relationPickerLabel(sorted[0]); got != "Blocked by ABC-2 - " {
+		t.Fatalf("relationPickerLabel() = %q", got)
+	}
+}
+
+// TestJumpToIssue_SelectsRowOrShowsDetails verifies jumping selects listed issues and opens others in details.
+func TestJumpToIssue_SelectsRowOrShowsDetails(t *testing.T) {
+	app, issueStore := newStoreTestApp(t)
+	issueStore.UpsertIssue(linearapi.Issue{ID: "i-9", Identifier: "ABC-9", Title: "Elsewhere"})
+
+	listed := &linearapi.Issue{ID: "i-2", Identifier: "ABC-2", Title: "Listed"}
+	app.otherIssueRows = []IssueRow{{IssueID: "i-1"}, {IssueID: "i-2"}}
+	app.otherIDToIssue = map[string]*linearapi.Issue{"i-1": {ID: "i-1"}, "i-2": listed}
+
+	onUI(app, func() {
+		app.jumpToIssue(linearapi.IssueRef{ID: "i-2", Identifier: "ABC-2"})
+		if row, _ := app.otherIssuesTable.GetSelection(); row != 2 || app.activeIssuesSection != IssuesSectionOther {
+			t.Fatalf("selection = row %d section %v, want row 2 in Other Issues", row, app.activeIssuesSection)
+		}
+		if selected := app.GetSelectedIssue(); selected == nil || selected.ID != "i-2" {
+			t.Fatalf("selected issue = %+v, want i-2", selected)
+		}
+	})
+
+	onUI(app, func() {
+		app.jumpToIssue(linearapi.IssueRef{ID: "i-9", Identifier: "ABC-9"})
+	})
+	waitForCondition(t, time.Second, func() bool {
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		selected := app.GetSelectedIssue()
+		return selected != nil && selected.Title == "Elsewhere"
+	})
+	onUI(app, func() {
+		if app.focusedPane != FocusDetails {
+			t.Fatalf("focusedPane = %v, want details", app.focusedPane)
+		}
+	})
+}
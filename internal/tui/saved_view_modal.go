@@ -0,0 +1,289 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/config"
+)
+
+// SavedViewModal manages the create/edit saved view form overlay.
+type SavedViewModal struct {
+	app           *App
+	modal         *tview.Flex
+	modalContent  *tview.Flex
+	form          *tview.Form
+	scopeView     *tview.TextView
+	errorView     *tview.TextView
+	nameField     *tview.InputField
+	teamField     *tview.DropDown
+	statesField   *tview.InputField
+	assigneeField *tview.InputField
+	labelsField   *tview.InputField
+	priorityField *tview.InputField
+	searchField   *tview.InputField
+	sortField     *tview.DropDown
+	groupField    *tview.DropDown
+	teamIDs       []string // Team IDs matching teamField options; "" is all teams
+	teamNames     []string
+	sortValues    []string
+	groupValues   []string
+	view          config.SavedView // View being edited, for fields the form does not show
+	originalName  string           // Empty when creating a view
+}
+
+// NewSavedViewModal creates a new saved view modal.
+func NewSavedViewModal(app *App) *SavedViewModal {
+	svm := &SavedViewModal{
+		app:         app,
+		sortValues:  []string{config.SortUpdated, config.SortCreated, config.SortPriority},
+		groupValues: []string{config.GroupByAssignee, config.GroupByState, config.GroupByPriority, config.GroupByNone},
+	}
+
+	svm.form = tview.NewForm()
+	svm.form.SetBackgroundColor(app.theme.HeaderBg)
+	svm.form.SetFieldBackgroundColor(app.theme.InputBg)
+	svm.form.SetFieldTextColor(app.theme.Foreground)
+	svm.form.SetButtonBackgroundColor(app.theme.Accent)
+	svm.form.SetButtonTextColor(app.theme.SelectionText)
+	svm.form.SetLabelColor(app.theme.Foreground)
+	svm.form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			svm.Hide()
+			return nil
+		}
+		return event
+	})
+
+	listSelected := tcell.StyleDefault.Background(app.theme.Accent).Foreground(app.theme.SelectionText)
+	listUnselected := tcell.StyleDefault.Background(app.theme.HeaderBg).Foreground(app.theme.Foreground)
+
+	svm.nameField = tview.NewInputField().SetLabel("Name").SetFieldWidth(40)
+	svm.form.AddFormItem(svm.nameField)
+
+	svm.teamField = tview.NewDropDown().SetLabel("Team")
+	svm.teamField.SetFieldWidth(30)
+	svm.teamField.SetListStyles(listUnselected, listSelected)
+	svm.form.AddFormItem(svm.teamField)
+
+	svm.statesField = tview.NewInputField().
+		SetLabel("States").
+		SetFieldWidth(40).
+		SetPlaceholder("e.g. Todo, In Progress or started")
+	svm.form.AddFormItem(svm.statesField)
+
+	svm.assigneeField = tview.NewInputField().
+		SetLabel("Assignee").
+		SetFieldWidth(40).
+		SetPlaceholder("me, none, or a name or email")
+	svm.form.AddFormItem(svm.assigneeField)
+
+	svm.labelsField = tview.NewInputField().
+		SetLabel("Labels").
+		SetFieldWidth(40).
+		SetPlaceholder("e.g. bug, regression")
+	svm.form.AddFormItem(svm.labelsField)
+
+	svm.priorityField = tview.NewInputField().
+		SetLabel("Priority").
+		SetFieldWidth(40).
+		SetPlaceholder("e.g. urgent or <=2")
+	svm.form.AddFormItem(svm.priorityField)
+
+	svm.searchField = tview.NewInputField().
+		SetLabel("Search").
+		SetFieldWidth(40).
+		SetPlaceholder("free text or a filter query")
+	svm.form.AddFormItem(svm.searchField)
+
+	svm.sortField = tview.NewDropDown().
+		SetLabel("Sort").
+		SetOptions([]string{"Updated", "Created", "Priority"}, nil)
+	svm.sortField.SetFieldWidth(20)
+	svm.sortField.SetListStyles(listUnselected, listSelected)
+	svm.form.AddFormItem(svm.sortField)
+
+	svm.groupField = tview.NewDropDown().
+		SetLabel("Group by").
+		SetOptions([]string{"Assignee (My/Other)", "State", "Priority", "None"}, nil)
+	svm.groupField.SetFieldWidth(20)
+	svm.groupField.SetListStyles(listUnselected, listSelected)
+	svm.form.AddFormItem(svm.groupField)
+
+	svm.form.AddButton("Save", func() {
+		svm.save()
+	})
+	svm.form.AddButton("Cancel", func() {
+		svm.Hide()
+	})
+
+	svm.scopeView = tview.NewTextView().SetDynamicColors(true)
+	svm.scopeView.SetTextColor(app.theme.SecondaryText)
+	svm.scopeView.SetBackgroundColor(app.theme.HeaderBg)
+
+	svm.errorView = tview.NewTextView().SetDynamicColors(true)
+	svm.errorView.SetBackgroundColor(app.theme.HeaderBg)
+
+	// Build modal content
+	svm.modalContent = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(svm.scopeView, 1, 0, false).
+		AddItem(svm.form, 0, 1, true).
+		AddItem(svm.errorView, 1, 0, false)
+	svm.modalContent.SetBackgroundColor(app.theme.HeaderBg).
+		SetBorder(true).
+		SetBorderColor(app.theme.Accent).
+		SetTitleColor(app.theme.Foreground)
+	padding := app.density.ModalPadding
+	svm.modalContent.SetBorderPadding(padding.Top, padding.Bottom, padding.Left, padding.Right)
+
+	// Center the modal on screen
+	svm.modal = tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().
+			SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(svm.modalContent, 28, 0, true).
+			AddItem(nil, 0, 1, false), 70, 0, true).
+		AddItem(nil, 0, 1, false)
+	svm.modal.SetBackgroundColor(app.theme.Background)
+
+	return svm
+}
+
+// Show displays the modal for a view. originalName is the name of the view being
+// edited, or empty to create a new view.
+func (svm *SavedViewModal) Show(view config.SavedView, originalName string) {
+	svm.view = view
+	svm.originalName = originalName
+
+	title := " Save View "
+	if originalName != "" {
+		title = " Edit View "
+	}
+	svm.modalContent.SetTitle(title)
+
+	svm.setTeamOptions(view)
+	svm.nameField.SetText(view.Name)
+	svm.statesField.SetText(strings.Join(view.States, ", "))
+	svm.assigneeField.SetText(view.Assignee)
+	svm.labelsField.SetText(strings.Join(view.Labels, ", "))
+	svm.priorityField.SetText(view.Priority)
+	svm.searchField.SetText(view.Search)
+	svm.sortField.SetCurrentOption(optionIndex(svm.sortValues, view.Sort))
+	svm.groupField.SetCurrentOption(optionIndex(svm.groupValues, view.GroupBy))
+	svm.updateScope()
+	svm.errorView.SetText("")
+	svm.form.SetFocus(0)
+
+	svm.app.pages.AddPage("saved_view", svm.modal, true, true)
+	svm.app.pages.SendToFront("saved_view")
+	svm.app.app.SetFocus(svm.form)
+}
+
+// Hide hides the saved view modal.
+func (svm *SavedViewModal) Hide() {
+	svm.app.pages.RemovePage("saved_view")
+	svm.app.updateFocus()
+}
+
+// HandleKey handles keyboard input for the saved view modal.
+func (svm *SavedViewModal) HandleKey(event *tcell.EventKey) *tcell.EventKey {
+	if event.Key() == tcell.KeyEscape {
+		svm.Hide()
+		return nil
+	}
+	return event
+}
+
+// setTeamOptions fills the team dropdown from the navigation tree and selects the view's team.
+func (svm *SavedViewModal) setTeamOptions(view config.SavedView) {
+	svm.teamIDs = []string{""}
+	svm.teamNames = []string{"All teams"}
+	for _, team := range svm.app.navigationTeams() {
+		svm.teamIDs = append(svm.teamIDs, team.TeamID)
+		svm.teamNames = append(svm.teamNames, team.Text)
+	}
+	// Keep a team that is no longer listed so editing does not silently widen the view
+	if view.TeamID != "" && optionIndex(svm.teamIDs, view.TeamID) == 0 {
+		name := view.TeamName
+		if name == "" {
+			name = view.TeamID
+		}
+		svm.teamIDs = append(svm.teamIDs, view.TeamID)
+		svm.teamNames = append(svm.teamNames, name)
+	}
+	svm.teamField.SetOptions(svm.teamNames, func(string, int) {
+		svm.updateScope()
+	})
+	svm.teamField.SetCurrentOption(optionIndex(svm.teamIDs, view.TeamID))
+}
+
+// selectedTeam returns the team ID and name chosen in the dropdown.
+func (svm *SavedViewModal) selectedTeam() (string, string) {
+	index, _ := svm.teamField.GetCurrentOption()
+	if index <= 0 || index >= len(svm.teamIDs) {
+		return "", ""
+	}
+	return svm.teamIDs[index], svm.teamNames[index]
+}
+
+// updateScope shows the project the view is limited to, which only applies within its team.
+func (svm *SavedViewModal) updateScope() {
+	teamID, teamName := svm.selectedTeam()
+	scope := "All issues"
+	if teamID != "" {
+		scope = teamName
+		if svm.view.ProjectID != "" && teamID == svm.view.TeamID {
+			scope = fmt.Sprintf("%s / %s", teamName, svm.view.ProjectName)
+		}
+	}
+	svm.scopeView.SetText(fmt.Sprintf("Scope: %s", tview.Escape(scope)))
+}
+
+// formView builds the view described by the form fields.
+func (svm *SavedViewModal) formView() config.SavedView {
+	view := config.SavedView{
+		Name:     svm.nameField.GetText(),
+		States:   strings.Split(svm.statesField.GetText(), ","),
+		Assignee: svm.assigneeField.GetText(),
+		Labels:   strings.Split(svm.labelsField.GetText(), ","),
+		Priority: svm.priorityField.GetText(),
+		Search:   svm.searchField.GetText(),
+	}
+	view.TeamID, view.TeamName = svm.selectedTeam()
+	if view.TeamID != "" && view.TeamID == svm.view.TeamID {
+		view.ProjectID = svm.view.ProjectID
+		view.ProjectName = svm.view.ProjectName
+	}
+	if index, _ := svm.sortField.GetCurrentOption(); index >= 0 && index < len(svm.sortValues) {
+		view.Sort = svm.sortValues[index]
+	}
+	if index, _ := svm.groupField.GetCurrentOption(); index >= 0 && index < len(svm.groupValues) {
+		view.GroupBy = svm.groupValues[index]
+	}
+	return view
+}
+
+// save validates and persists the form, keeping the modal open on errors.
+func (svm *SavedViewModal) save() {
+	view := svm.formView()
+	if err := svm.app.saveSavedView(view, svm.originalName); err != nil {
+		svm.errorView.SetText(fmt.Sprintf("%s%s[-]", svm.app.themeTags.Error, tview.Escape(err.Error())))
+		return
+	}
+	svm.Hide()
+	svm.app.statusBar.SetText(fmt.Sprintf("%sSaved view %s[-]", svm.app.themeTags.Accent, tview.Escape(strings.TrimSpace(view.Name))))
+}
+
+// optionIndex returns the index of value in values, or 0 when it is missing.
+func optionIndex(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return 0
+}
@@ -0,0 +1,392 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// savedViewsGroupID identifies the "Views" group node in the navigation tree.
+const savedViewsGroupID = "saved-views"
+
+// SetSavedViews attaches saved views and the file they persist to (empty path disables saving).
+func (a *App) SetSavedViews(path string, views []config.SavedView) {
+	a.savedViewsPath = path
+	a.savedViews = views
+}
+
+// savedViewQuery builds the filter query for a view's fields and search text.
+func savedViewQuery(view config.SavedView) string {
+	var parts []string
+	if len(view.States) > 0 {
+		parts = append(parts, "state:"+quoteQueryValues(view.States))
+	}
+	if view.Assignee != "" {
+		parts = append(parts, "assignee:"+quoteQueryValues([]string{view.Assignee}))
+	}
+	if len(view.Labels) > 0 {
+		parts = append(parts, "label:"+quoteQueryValues(view.Labels))
+	}
+	if view.Priority != "" {
+		parts = append(parts, "priority:"+view.Priority)
+	}
+	return combineQueries(strings.Join(parts, " "), view.Search)
+}
+
+// quoteQueryValues joins field values with commas, quoting values that contain spaces.
+func quoteQueryValues(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		if strings.ContainsAny(value, " \t()") {
+			value = `"` + value + `"`
+		}
+		quoted[i] = value
+	}
+	return strings.Join(quoted, ",")
+}
+
+// combineQueries ANDs two queries, grouping the second so its OR terms stay together.
+func combineQueries(base, extra string) string {
+	base = strings.TrimSpace(base)
+	extra = strings.TrimSpace(extra)
+	switch {
+	case base == "":
+		return extra
+	case extra == "":
+		return base
+	}
+	return base + " (" + extra + ")"
+}
+
+// validateSavedView checks a view's name is unique and its filter fields form a valid query.
+// originalName is the name of the view being edited, or empty for a new view.
+func (a *App) validateSavedView(view config.SavedView, originalName string) error {
+	if err := view.Validate(); err != nil {
+		return err
+	}
+	for _, existing := range a.savedViews {
+		if strings.EqualFold(existing.Name, view.Name) && !strings.EqualFold(existing.Name, originalName) {
+			return fmt.Errorf("a view named %q already exists", view.Name)
+		}
+	}
+	if _, err := linearapi.ParseIssueQuery(savedViewQuery(view), time.Now()); err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	return nil
+}
+
+// saveSavedView adds a view, or replaces the view named originalName, and persists the list.
+func (a *App) saveSavedView(view config.SavedView, originalName string) error {
+	view = config.NormalizeSavedView(view)
+	if err := a.validateSavedView(view, originalName); err != nil {
+		return err
+	}
+
+	views := make([]config.SavedView, 0, len(a.savedViews)+1)
+	replaced := false
+	for _, existing := range a.savedViews {
+		if originalName != "" && strings.EqualFold(existing.Name, originalName) {
+			views = append(views, view)
+			replaced = true
+			continue
+		}
+		views = append(views, existing)
+	}
+	if !replaced {
+		views = append(views, view)
+	}
+
+	if err := a.persistSavedViews(views); err != nil {
+		return err
+	}
+	logger.Info("tui.saved_views: saved view name=%s", view.Name)
+
+	// Keep the selection on the edited view so its issues reload with the new filter
+	if a.selectedNavigation != nil && a.selectedNavigation.View != nil &&
+		strings.EqualFold(a.selectedNavigation.View.Name, originalName) {
+		a.refreshSavedViewsNavigation(view.Name)
+		if a.selectedNavigation.View != nil {
+			a.onNavigationSelected(a.selectedNavigation)
+		}
+		return nil
+	}
+	a.refreshSavedViewsNavigation("")
+	return nil
+}
+
+// deleteSavedView removes the named view and persists the list.
+func (a *App) deleteSavedView(name string) error {
+	views := make([]config.SavedView, 0, len(a.savedViews))
+	for _, existing := range a.savedViews {
+		if !strings.EqualFold(existing.Name, name) {
+			views = append(views, existing)
+		}
+	}
+	if len(views) == len(a.savedViews) {
+		return fmt.Errorf("view %q not found", name)
+	}
+	if err := a.persistSavedViews(views); err != nil {
+		return err
+	}
+	logger.Info("tui.saved_views: deleted view name=%s", name)
+
+	wasSelected := a.selectedNavigation != nil && a.selectedNavigation.View != nil &&
+		strings.EqualFold(a.selectedNavigation.View.Name, name)
+	a.refreshSavedViewsNavigation("")
+	if wasSelected {
+		a.selectAllIssuesNavigation()
+	}
+	return nil
+}
+
+// persistSavedViews writes views to disk, then adopts them as the current list.
+func (a *App) persistSavedViews(views []config.SavedView) error {
+	if a.savedViewsPath != "" {
+		if err := config.SaveSavedViews(a.savedViewsPath, views); err != nil {
+			logger.ErrorWithErr(err, "tui.saved_views: failed to save views path=%s", a.savedViewsPath)
+			return err
+		}
+	}
+	a.savedViews = views
+	return nil
+}
+
+// savedView returns the saved view with the given name.
+func (a *App) savedView(name string) (config.SavedView, bool) {
+	for _, view := range a.savedViews {
+		if strings.EqualFold(view.Name, name) {
+			return view, true
+		}
+	}
+	return config.SavedView{}, false
+}
+
+// showSavedViewPicker lists saved views and calls onSelect with the chosen one.
+func (a *App) showSavedViewPicker(title string, onSelect func(view config.SavedView)) {
+	if len(a.savedViews) == 0 {
+		a.updateStatusBarWithError(fmt.Errorf("no saved views; use \"Save current view\" first"))
+		return
+	}
+	items := make([]PickerItem, 0, len(a.savedViews))
+	for _, view := range a.savedViews {
+		items = append(items, PickerItem{ID: view.Name, Label: tview.Escape(view.Name)})
+	}
+	a.pickerActive = true
+	a.pickerModal.Show(title, items, func(item PickerItem) {
+		a.pickerActive = false
+		if view, ok := a.savedView(item.ID); ok {
+			onSelect(view)
+		}
+	})
+}
+
+// confirmDeleteSavedView asks before deleting the named view.
+func (a *App) confirmDeleteSavedView(name string) {
+	items := []PickerItem{
+		{ID: "delete", Label: "Delete view"},
+		{ID: "cancel", Label: "Cancel"},
+	}
+	a.pickerActive = true
+	a.pickerModal.Show(fmt.Sprintf("Delete %s?", tview.Escape(name)), items, func(item PickerItem) {
+		a.pickerActive = false
+		if item.ID != "delete" {
+			return
+		}
+		if err := a.deleteSavedView(name); err != nil {
+			a.updateStatusBarWithError(err)
+			return
+		}
+		a.statusBar.SetText(fmt.Sprintf("%sDeleted view %s[-]", a.themeTags.Accent, tview.Escape(name)))
+	})
+}
+
+// buildSavedViewsGroup builds the "Views" navigation group, or returns nil when there are no views.
+func (a *App) buildSavedViewsGroup() *tview.TreeNode {
+	if len(a.savedViews) == 0 {
+		return nil
+	}
+	group := tview.NewTreeNode("Views").
+		SetColor(a.theme.Foreground).
+		SetReference(&NavigationNode{ID: savedViewsGroupID, Text: "Views", IsViewGroup: true}).
+		SetExpanded(true)
+	for i := range a.savedViews {
+		view := a.savedViews[i]
+		group.AddChild(tview.NewTreeNode("  " + view.Name).
+			SetColor(a.theme.SecondaryText).
+			SetReference(&NavigationNode{
+				ID:     "view:" + view.Name,
+				Text:   view.Name,
+				TeamID: view.TeamID,
+				View:   &view,
+			}))
+	}
+	return group
+}
+
+// refreshSavedViewsNavigation rebuilds the "Views" group in the navigation tree.
+// If selectName is set and names a view, that view becomes the selection.
+func (a *App) refreshSavedViewsNavigation(selectName string) {
+	if !a.refreshNavigationGroups() || selectName == "" {
+		return
+	}
+	for _, group := range a.navigationTree.GetRoot().GetChildren() {
+		if navNode, ok := group.GetReference().(*NavigationNode); !ok || navNode.ID != savedViewsGroupID {
+			continue
+		}
+		for _, child := range group.GetChildren() {
+			if navNode, ok := child.GetReference().(*NavigationNode); ok && navNode.View != nil &&
+				strings.EqualFold(navNode.View.Name, selectName) {
+				a.navigationTree.SetCurrentNode(child)
+				a.selectedNavigation = navNode
+				return
+			}
+		}
+	}
+}
+
+// selectAllIssuesNavigation moves the navigation selection to "All Issues" and reloads.
+func (a *App) selectAllIssuesNavigation() {
+	if root := a.navigationTree.GetRoot(); root != nil {
+		for _, child := range root.GetChildren() {
+			if navNode, ok := child.GetReference().(*NavigationNode); ok && navNode.ID == "all" {
+				a.navigationTree.SetCurrentNode(child)
+				a.onNavigationSelected(navNode)
+				return
+			}
+		}
+	}
+	a.onNavigationSelected(&NavigationNode{ID: "all", Text: "All Issues"})
+}
+
+// currentViewDraft captures the navigation selection, search, sort and grouping as a new view.
+func (a *App) currentViewDraft() config.SavedView {
+	var view config.SavedView
+	if nav := a.selectedNavigation; nav != nil {
+		switch {
+		case nav.View != nil:
+			view = *nav.View
+			view.Name = ""
+		case nav.IsStatus:
+			view.TeamID = nav.TeamID
+			if nav.StateName != "" {
+				view.States = []string{nav.StateName}
+			}
+		case nav.IsTeam:
+			view.TeamID = nav.TeamID
+		case nav.IsProject:
+			view.TeamID = nav.TeamID
+			view.ProjectID = nav.ID
+			view.ProjectName = nav.Text
+		}
+	}
+	if view.TeamID != "" && view.TeamName == "" {
+		view.TeamName = a.navigationTeamName(view.TeamID)
+	}
+	view.Search = combineQueries(view.Search, a.searchQuery)
+	view.Sort = string(a.sortField)
+	view.GroupBy = a.groupBy
+	return view
+}
+
+// navigationTeams returns the teams shown in the navigation tree.
+func (a *App) navigationTeams() []*NavigationNode {
+	if a.navigationTree == nil || a.navigationTree.GetRoot() == nil {
+		return nil
+	}
+	var teams []*NavigationNode
+	for _, child := range a.navigationTree.GetRoot().GetChildren() {
+		if navNode, ok := child.GetReference().(*NavigationNode); ok && navNode.IsTeam {
+			teams = append(teams, navNode)
+		}
+	}
+	return teams
+}
+
+// navigationTeamName returns the display name of a team in the navigation tree.
+func (a *App) navigationTeamName(teamID string) string {
+	for _, team := range a.navigationTeams() {
+		if team.TeamID == teamID {
+			return team.Text
+		}
+	}
+	return ""
+}
+
+// applySavedViewDisplay adopts a view's sort and grouping.
+func (a *App) applySavedViewDisplay(view config.SavedView) {
+	a.sortField = SortByUpdatedAt
+	if view.Sort != "" {
+		a.sortField = SortField(view.Sort)
+	}
+	a.groupBy = view.GroupBy
+}
+
+// isGroupedByAssignee reports whether issues are split into My Issues and Other Issues.
+func (a *App) isGroupedByAssignee() bool {
+	return a.groupBy == "" || a.groupBy == config.GroupByAssignee
+}
+
+// issuesTableTitle returns the title of the main issues table for the current grouping.
+func (a *App) issuesTableTitle() string {
+	switch a.groupBy {
+	case config.GroupByState:
+		return "Issues by State"
+	case config.GroupByPriority:
+		return "Issues by Priority"
+	case config.GroupByNone:
+		return "Issues"
+	}
+	return "Other Issues"
+}
+
+// groupIssues stably orders issues so each state or priority forms a contiguous block.
+// States follow workflow position when known, otherwise name; no priority sorts last.
+func groupIssues(issues []linearapi.Issue, groupBy string, states []linearapi.WorkflowState) []linearapi.Issue {
+	if groupBy != config.GroupByState && groupBy != config.GroupByPriority {
+		return issues
+	}
+	grouped := make([]linearapi.Issue, len(issues))
+	copy(grouped, issues)
+
+	statePosition := make(map[string]float64, len(states))
+	for _, state := range states {
+		statePosition[state.ID] = state.Position
+	}
+	sort.SliceStable(grouped, func(i, j int) bool {
+		if groupBy == config.GroupByPriority {
+			pi, pj := grouped[i].Priority, grouped[j].Priority
+			if pi == 0 {
+				pi = 5
+			}
+			if pj == 0 {
+				pj = 5
+			}
+			return pi < pj
+		}
+		posI, okI := statePosition[grouped[i].StateID]
+		posJ, okJ := statePosition[grouped[j].StateID]
+		switch {
+		case okI && okJ && posI != posJ:
+			return posI < posJ
+		case okI != okJ:
+			return okI
+		}
+		return strings.ToLower(grouped[i].State) < strings.ToLower(grouped[j].State)
+	})
+	return grouped
+}
+
+// splitIssuesForDisplay partitions issues into the My Issues and main tables.
+// Grouping by assignee splits them; other groupings put every issue in the main table.
+func (a *App) splitIssuesForDisplay(issues []linearapi.Issue, currentUserID string) (my []linearapi.Issue, other []linearapi.Issue) {
+	if a.isGroupedByAssignee() {
+		return splitIssuesByAssignee(issues, currentUserID)
+	}
+	return nil, groupIssues(issues, a.groupBy, a.workflowStates)
+}
@@ -0,0 +1,147 @@
+package tui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestSavedViewQuery verifies view fields become filter query terms.
+func TestSavedViewQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		view config.SavedView
+		want string
+	}{
+		{name: "empty", view: config.SavedView{Name: "All"}, want: ""},
+		{name: "search only", view: config.SavedView{Search: "login bug"}, want: "login bug"},
+		{
+			name: "fields",
+			view: config.SavedView{
+				States:   []string{"Todo", "In Progress"},
+				Assignee: "me",
+				Labels:   []string{"bug", "needs review"},
+				Priority: ">=2",
+			},
+			want: `state:Todo,"In Progress" assignee:me label:bug,"needs review" priority:>=2`,
+		},
+		{
+			name: "fields and search",
+			view: config.SavedView{Assignee: "me", Search: "label:bug OR label:crash"},
+			want: "assignee:me (label:bug OR label:crash)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := savedViewQuery(tt.view)
+			if got != tt.want {
+				t.Fatalf("savedViewQuery() = %q, want %q", got, tt.want)
+			}
+			if _, err := linearapi.ParseIssueQuery(got, time.Now()); err != nil {
+				t.Fatalf("ParseIssueQuery(%q) error = %v", got, err)
+			}
+		})
+	}
+}
+
+// TestGroupIssues_StateAndPriority verifies grouping keeps each group contiguous and stable.
+func TestGroupIssues_StateAndPriority(t *testing.T) {
+	issues := []linearapi.Issue{
+		{ID: "1", State: "Done", StateID: "done", Priority: 0},
+		{ID: "2", State: "Todo", StateID: "todo", Priority: 2},
+		{ID: "3", State: "Done", StateID: "done", Priority: 1},
+		{ID: "4", State: "Triage", StateID: "triage", Priority: 2},
+	}
+	states := []linearapi.WorkflowState{
+		{ID: "todo", Name: "Todo", Position: 1},
+		{ID: "done", Name: "Done", Position: 2},
+	}
+
+	ids := func(issues []linearapi.Issue) string {
+		var out []string
+		for _, issue := range issues {
+			out = append(out, issue.ID)
+		}
+		return strings.Join(out, ",")
+	}
+
+	if got := ids(groupIssues(issues, config.GroupByState, states)); got != "2,1,3,4" {
+		t.Fatalf("group by state = %s, want 2,1,3,4", got)
+	}
+	if got := ids(groupIssues(issues, config.GroupByPriority, states)); got != "3,2,4,1" {
+		t.Fatalf("group by priority = %s, want 3,2,4,1", got)
+	}
+	if got := ids(issues); got != "1,2,3,4" {
+		t.Fatalf("groupIssues modified its input: %s", got)
+	}
+}
+
+// TestSavedViews_SaveSelectDelete verifies views persist, appear in navigation and drive fetches.
+func TestSavedViews_SaveSelectDelete(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "views.json")
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+	app.SetSavedViews(path, nil)
+	app.rebuildNavigationTree([]linearapi.Team{{ID: "team-1", Name: "Engineering"}})
+
+	app.selectedNavigation = &NavigationNode{ID: "team-1", Text: "Engineering", IsTeam: true, TeamID: "team-1"}
+	app.searchQuery = "crash"
+	draft := app.currentViewDraft()
+	if draft.TeamID != "team-1" || draft.TeamName != "Engineering" || draft.Search != "crash" {
+		t.Fatalf("currentViewDraft() = %+v", draft)
+	}
+	app.searchQuery = ""
+
+	draft.Name = "My bugs"
+	draft.Assignee = "me"
+	draft.Labels = []string{"bug"}
+	draft.GroupBy = config.GroupByState
+	if err := app.saveSavedView(draft, ""); err != nil {
+		t.Fatalf("saveSavedView() error = %v", err)
+	}
+	if err := app.saveSavedView(config.SavedView{Name: "my BUGS"}, ""); err == nil {
+		t.Fatal("saveSavedView() with a duplicate name succeeded")
+	}
+	if err := app.saveSavedView(config.SavedView{Name: "Bad", Priority: "soon"}, ""); err == nil {
+		t.Fatal("saveSavedView() with an invalid priority succeeded")
+	}
+
+	stored, err := config.LoadSavedViews(path)
+	if err != nil || len(stored) != 1 || stored[0].Name != "My bugs" {
+		t.Fatalf("LoadSavedViews() = %+v, %v", stored, err)
+	}
+
+	children := app.navigationTree.GetRoot().GetChildren()
+	group, ok := children[2].GetReference().(*NavigationNode)
+	if !ok || group.ID != savedViewsGroupID || len(children[2].GetChildren()) != 1 {
+		t.Fatalf("navigation child 2 = %+v, want the Views group", children[2].GetReference())
+	}
+	viewNode := children[2].GetChildren()[0].GetReference().(*NavigationNode)
+
+	app.selectedNavigation = viewNode
+	app.applySavedViewDisplay(*viewNode.View)
+	params := app.currentFetchParams()
+	if params.TeamID != "team-1" || params.Filter == nil || params.Search != "" {
+		t.Fatalf("currentFetchParams() = %+v", params)
+	}
+	if app.isGroupedByAssignee() || app.issuesTableTitle() != "Issues by State" {
+		t.Fatalf("groupBy = %q, want state grouping", app.groupBy)
+	}
+
+	app.selectedNavigation = &NavigationNode{ID: "all", Text: "All Issues"}
+	if err := app.deleteSavedView("my bugs"); err != nil {
+		t.Fatalf("deleteSavedView() error = %v", err)
+	}
+	for _, child := range app.navigationTree.GetRoot().GetChildren() {
+		if navNode, ok := child.GetReference().(*NavigationNode); ok && navNode.ID == savedViewsGroupID {
+			t.Fatal("Views group still shown after deleting the last view")
+		}
+	}
+	if stored, _ := config.LoadSavedViews(path); len(stored) != 0 {
+		t.Fatalf("LoadSavedViews() after delete = %+v", stored)
+	}
+}
@@ -21,36 +21,50 @@ const (
 
 // SettingsModal manages the settings form overlay.
 type SettingsModal struct {
-	app                 *App
-	modal               *tview.Flex
-	modalBody           *tview.Flex
-	modalContent        *tview.Flex
-	form                *tview.Form
-	endpointField       *tview.InputField
-	timeoutField        *tview.InputField
-	pageSizeField       *tview.InputField
-	cacheTTLField       *tview.InputField
-	logFileField        *tview.InputField
-	logLevelField       *tview.DropDown
-	logLevelOptions     []string
-	themeField          *tview.DropDown
-	themeOptions        []string
-	themeValues         []string
-	densityField        *tview.DropDown
-	densityOptions      []string
-	densityValues       []string
-	agentWorkspaceField *tview.InputField
+	app                   *App
+	modal                 *tview.Flex
+	modalBody             *tview.Flex
+	modalContent          *tview.Flex
+	form                  *tview.Form
+	endpointField         *tview.InputField
+	timeoutField          *tview.InputField
+	pageSizeField         *tview.InputField
+	cacheTTLField         *tview.InputField
+	pollIntervalField     *tview.InputField
+	logFileField          *tview.InputField
+	logLevelField         *tview.DropDown
+	logLevelOptions       []string
+	themeField            *tview.DropDown
+	themeOptions          []string
+	themeValues           []string
+	densityField          *tview.DropDown
+	densityOptions        []string
+	densityValues         []string
+	notificationField     *tview.DropDown
+	notificationOptions   []string
+	notificationValues    []string
+	agentWorkspaceField   *tview.InputField
+	agentConcurrencyField *tview.InputField
+	agentWorktreesField   *tview.Checkbox
+	worktreeRootField     *tview.InputField
 }
 
 // NewSettingsModal creates a new settings modal.
 func NewSettingsModal(app *App) *SettingsModal {
 	sm := &SettingsModal{
-		app:             app,
-		logLevelOptions: []string{"debug", "info", "warning", "error"},
-		themeOptions:    []string{"Linear", "High contrast", "Color-blind friendly"},
-		themeValues:     []string{config.ThemeLinear, config.ThemeHighContrast, config.ThemeColorBlind},
-		densityOptions:  []string{"Comfortable", "Compact"},
-		densityValues:   []string{config.DensityComfortable, config.DensityCompact},
+		app:                 app,
+		logLevelOptions:     []string{"debug", "info", "warning", "error"},
+		themeOptions:        []string{"Linear", "High contrast", "Color-blind friendly"},
+		themeValues:         []string{config.ThemeLinear, config.ThemeHighContrast, config.ThemeColorBlind},
+		densityOptions:      []string{"Comfortable", "Compact"},
+		densityValues:       []string{config.DensityComfortable, config.DensityCompact},
+		notificationOptions: []string{"None", "Terminal bell", "OSC 9 notification", "OSC 777 notification"},
+		notificationValues: []string{
+			config.NotificationAlertNone,
+			config.NotificationAlertBell,
+			config.NotificationAlertOSC9,
+			config.NotificationAlertOSC777,
+		},
 	}
 
 	sm.form = tview.NewForm()
@@ -90,6 +104,11 @@ func NewSettingsModal(app *App) *SettingsModal {
 		SetFieldWidth(20)
 	sm.form.AddFormItem(sm.cacheTTLField)
 
+	sm.pollIntervalField = tview.NewInputField().
+		SetLabel("Poll interval (0 disables)").
+		SetFieldWidth(20)
+	sm.form.AddFormItem(sm.pollIntervalField)
+
 	sm.logFileField = tview.NewInputField().
 		SetLabel("Log file").
 		SetFieldWidth(60)
@@ -125,11 +144,35 @@ func NewSettingsModal(app *App) *SettingsModal {
 	)
 	sm.form.AddFormItem(sm.densityField)
 
+	sm.notificationField = tview.NewDropDown().
+		SetLabel("New notification alert").
+		SetOptions(sm.notificationOptions, nil)
+	sm.notificationField.SetFieldWidth(30)
+	sm.notificationField.SetListStyles(
+		tcell.StyleDefault.Background(app.theme.HeaderBg).Foreground(app.theme.Foreground),
+		tcell.StyleDefault.Background(app.theme.Accent).Foreground(app.theme.SelectionText),
+	)
+	sm.form.AddFormItem(sm.notificationField)
+
 	sm.agentWorkspaceField = tview.NewInputField().
 		SetLabel("Agent workspace (optional; blank uses CWD)").
 		SetFieldWidth(60)
 	sm.form.AddFormItem(sm.agentWorkspaceField)
 
+	sm.agentConcurrencyField = tview.NewInputField().
+		SetLabel("Concurrent agent jobs").
+		SetFieldWidth(10)
+	sm.form.AddFormItem(sm.agentConcurrencyField)
+
+	sm.agentWorktreesField = tview.NewCheckbox().
+		SetLabel("Run agents in per-issue git worktrees")
+	sm.form.AddFormItem(sm.agentWorktreesField)
+
+	sm.worktreeRootField = tview.NewInputField().
+		SetLabel("Worktree root (blank uses ~/.linear-tui/worktrees)").
+		SetFieldWidth(60)
+	sm.form.AddFormItem(sm.worktreeRootField)
+
 	sm.form.AddButton("Save", func() {
 		sm.saveSettings()
 	})
@@ -186,11 +229,16 @@ func (sm *SettingsModal) Show() {
 	sm.timeoutField.SetText(settings.Timeout)
 	sm.pageSizeField.SetText(strconv.Itoa(settings.PageSize))
 	sm.cacheTTLField.SetText(settings.CacheTTL)
+	sm.pollIntervalField.SetText(settings.PollInterval)
 	sm.logFileField.SetText(settings.LogFile)
 	sm.setLogLevelSelection(settings.LogLevel)
 	sm.setThemeSelection(settings.Theme)
 	sm.setDensitySelection(settings.Density)
+	sm.setNotificationAlertSelection(settings.NotificationAlert)
 	sm.agentWorkspaceField.SetText(settings.AgentWorkspace)
+	sm.agentConcurrencyField.SetText(strconv.Itoa(settings.AgentConcurrency))
+	sm.agentWorktreesField.SetChecked(settings.AgentWorktrees)
+	sm.worktreeRootField.SetText(settings.AgentWorktreeRoot)
 
 	sm.updateModalHeight()
 	sm.app.pages.AddPage("settings", sm.modal, true, true)
@@ -274,6 +322,14 @@ func (sm *SettingsModal) saveSettings() {
 		return
 	}
 
+	concurrencyText := strings.TrimSpace(sm.agentConcurrencyField.GetText())
+	agentConcurrency, err := strconv.Atoi(concurrencyText)
+	if err != nil {
+		logger.ErrorWithErr(err, "tui.settings: invalid agent concurrency value=%s", concurrencyText)
+		sm.app.updateStatusBarWithError(fmt.Errorf("concurrent agent jobs must be a number: %w", err))
+		return
+	}
+
 	_, logLevel := sm.logLevelField.GetCurrentOption()
 	if logLevel == "" {
 		logLevel = config.DefaultLogLevel
@@ -289,17 +345,29 @@ func (sm *SettingsModal) saveSettings() {
 		density = config.DefaultDensity
 	}
 
+	notificationAlert := sm.currentNotificationAlertValue()
+	if notificationAlert == "" {
+		notificationAlert = config.DefaultNotificationAlert
+	}
+
 	settings := config.Settings{
-		APIEndpoint:    strings.TrimSpace(sm.endpointField.GetText()),
-		Timeout:        strings.TrimSpace(sm.timeoutField.GetText()),
-		PageSize:       pageSize,
-		CacheTTL:       strings.TrimSpace(sm.cacheTTLField.GetText()),
-		LogFile:        strings.TrimSpace(sm.logFileField.GetText()),
-		LogLevel:       logLevel,
-		Theme:          theme,
-		Density:        density,
-		AgentCommands:  sm.app.config.AgentCommands,
-		AgentWorkspace: strings.TrimSpace(sm.agentWorkspaceField.GetText()),
+		APIEndpoint:       strings.TrimSpace(sm.endpointField.GetText()),
+		Timeout:           strings.TrimSpace(sm.timeoutField.GetText()),
+		PageSize:          pageSize,
+		CacheTTL:          strings.TrimSpace(sm.cacheTTLField.GetText()),
+		PollInterval:      strings.TrimSpace(sm.pollIntervalField.GetText()),
+		LogFile:           strings.TrimSpace(sm.logFileField.GetText()),
+		LogLevel:          logLevel,
+		Theme:             theme,
+		Density:           density,
+		AgentCommands:     sm.app.config.AgentCommands,
+		AgentWorkspace:    strings.TrimSpace(sm.agentWorkspaceField.GetText()),
+		AgentConcurrency:  agentConcurrency,
+		AgentWorktrees:    sm.agentWorktreesField.IsChecked(),
+		AgentWorktreeRoot: strings.TrimSpace(sm.worktreeRootField.GetText()),
+		WebhookListen:     sm.app.config.WebhookListen,
+		WebhookSecret:     sm.app.config.WebhookSecret,
+		NotificationAlert: notificationAlert,
 	}
 
 	newCfg, err := config.ConfigFromSettings(sm.app.config.LinearAPIKey, settings)
@@ -389,3 +457,27 @@ func (sm *SettingsModal) setDensitySelection(density string) {
 	}
 	sm.densityField.SetCurrentOption(selected)
 }
+
+// currentNotificationAlertValue returns the currently selected notification alert value.
+func (sm *SettingsModal) currentNotificationAlertValue() string {
+	index, _ := sm.notificationField.GetCurrentOption()
+	if index >= 0 && index < len(sm.notificationValues) {
+		return sm.notificationValues[index]
+	}
+	return ""
+}
+
+// setNotificationAlertSelection updates the dropdown selection to match the provided alert.
+func (sm *SettingsModal) setNotificationAlertSelection(alert string) {
+	selected := 0
+	for i, value := range sm.notificationValues {
+		if value == config.DefaultNotificationAlert {
+			selected = i
+		}
+		if value == alert {
+			selected = i
+			break
+		}
+	}
+	sm.notificationField.SetCurrentOption(selected)
+}
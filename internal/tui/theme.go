@@ -152,12 +152,25 @@ var Icons = struct {
 	InProgress string
 	Done       string
 	Priority   string
+	Pending    string
+	Conflict   string
+	Blocked    string
+	Changed    string
+	Unread     string
+	// AgentQueued marks issues with an agent job waiting for a slot.
+	AgentQueued string
 }{
-	Team:       "📁 ",
-	Project:    "📄 ",
-	List:       "📑 ",
-	Todo:       "○ ",
-	InProgress: "◐ ",
-	Done:       "✔ ", // or ●
-	Priority:   "⚡",
+	Team:        "📁 ",
+	Project:     "📄 ",
+	List:        "📑 ",
+	Todo:        "○ ",
+	InProgress:  "◐ ",
+	Done:        "✔ ", // or ●
+	Priority:    "⚡",
+	Pending:     "⟳ ",
+	Conflict:    "⚠ ",
+	Blocked:     "⊘ ",
+	Changed:     "✦ ",
+	Unread:      "● ",
+	AgentQueued: "◷ ",
 }
@@ -0,0 +1,294 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+	"github.com/roeyazroel/linear-tui/internal/logger"
+)
+
+// undoLimit is the number of changes kept in the undo history.
+const undoLimit = 50
+
+// undoAction is one mutation of an issue: an update, an archive or an unarchive.
+type undoAction struct {
+	Update    *linearapi.UpdateIssueInput
+	Archive   bool
+	Unarchive bool
+}
+
+// undoChange is a reversible change of one issue.
+type undoChange struct {
+	IssueID    string
+	Identifier string
+	Forward    undoAction // The change as made
+	Inverse    undoAction // Restores the issue as it was before
+}
+
+// undoEntry is one undoable step; bulk operations change several issues in one step.
+type undoEntry struct {
+	Label   string // What changed, e.g. "status change on ABC-1"
+	Changes []undoChange
+}
+
+// inverseUpdate returns the update restoring the fields input changes to their values in issue.
+func inverseUpdate(issue linearapi.Issue, input linearapi.UpdateIssueInput) linearapi.UpdateIssueInput {
+	inverse := linearapi.UpdateIssueInput{ID: issue.ID}
+	if input.Title != nil {
+		inverse.Title = &issue.Title
+	}
+	if input.Description != nil {
+		inverse.Description = &issue.Description
+	}
+	if input.StateID != nil {
+		inverse.StateID = &issue.StateID
+	}
+	if input.AssigneeID != nil {
+		inverse.AssigneeID = &issue.AssigneeID
+	}
+	if input.Priority != nil {
+		inverse.Priority = &issue.Priority
+	}
+	if input.LabelIDs != nil {
+		labelIDs := issueLabelIDs(issue)
+		inverse.LabelIDs = &labelIDs
+	}
+	if input.ParentID != nil {
+		parentID := ""
+		if issue.Parent != nil {
+			parentID = issue.Parent.ID
+		}
+		inverse.ParentID = &parentID
+	}
+	if input.CycleID != nil {
+		inverse.CycleID = &issue.CycleID
+	}
+	if input.ProjectID != nil {
+		inverse.ProjectID = &issue.ProjectID
+	}
+	if input.DueDate != nil {
+		inverse.DueDate = &issue.DueDate
+	}
+	if input.Estimate != nil {
+		estimate := linearapi.NoEstimate
+		if issue.Estimate != nil {
+			estimate = *issue.Estimate
+		}
+		inverse.Estimate = &estimate
+	}
+	return inverse
+}
+
+// issueMatchesAction reports whether issue is in the state action leaves it in, so the
+// opposite action can be applied without overwriting someone else's change.
+func issueMatchesAction(issue linearapi.Issue, action undoAction) bool {
+	switch {
+	case action.Archive:
+		return issue.Archived
+	case action.Unarchive:
+		return !issue.Archived
+	case action.Update == nil:
+		return true
+	}
+	input := action.Update
+	if input.Title != nil && issue.Title != *input.Title {
+		return false
+	}
+	if input.Description != nil && issue.Description != *input.Description {
+		return false
+	}
+	if input.StateID != nil && issue.StateID != *input.StateID {
+		return false
+	}
+	if input.AssigneeID != nil && issue.AssigneeID != *input.AssigneeID {
+		return false
+	}
+	if input.Priority != nil && issue.Priority != *input.Priority {
+		return false
+	}
+	if input.LabelIDs != nil {
+		have, want := issueLabelIDs(issue), slices.Clone(*input.LabelIDs)
+		slices.Sort(have)
+		slices.Sort(want)
+		if !slices.Equal(have, want) {
+			return false
+		}
+	}
+	if input.ParentID != nil {
+		parentID := ""
+		if issue.Parent != nil {
+			parentID = issue.Parent.ID
+		}
+		if parentID != *input.ParentID {
+			return false
+		}
+	}
+	if input.CycleID != nil && issue.CycleID != *input.CycleID {
+		return false
+	}
+	if input.ProjectID != nil && issue.ProjectID != *input.ProjectID {
+		return false
+	}
+	if input.DueDate != nil && issue.DueDate != *input.DueDate {
+		return false
+	}
+	if input.Estimate != nil {
+		estimate := linearapi.NoEstimate
+		if issue.Estimate != nil {
+			estimate = *issue.Estimate
+		}
+		if estimate != *input.Estimate {
+			return false
+		}
+	}
+	return true
+}
+
+// updateChange returns the reversible change of applying input to issue.
+func updateChange(issue linearapi.Issue, input linearapi.UpdateIssueInput) undoChange {
+	inverse := inverseUpdate(issue, input)
+	return undoChange{
+		IssueID:    issue.ID,
+		Identifier: issue.Identifier,
+		Forward:    undoAction{Update: &input},
+		Inverse:    undoAction{Update: &inverse},
+	}
+}
+
+// archiveChange returns the reversible change of archiving issue.
+func archiveChange(issue linearapi.Issue) undoChange {
+	return undoChange{
+		IssueID:    issue.ID,
+		Identifier: issue.Identifier,
+		Forward:    undoAction{Archive: true},
+		Inverse:    undoAction{Unarchive: true},
+	}
+}
+
+// undoLabel describes a change to issues, e.g. "status change on ABC-1" or "archive on 3 issues".
+func undoLabel(what string, changes []undoChange) string {
+	if len(changes) == 1 {
+		return fmt.Sprintf("%s on %s", what, changes[0].Identifier)
+	}
+	return fmt.Sprintf("%s on %d issues", what, len(changes))
+}
+
+// recordUndo adds a completed change to the undo history and clears the redo history.
+// what names the change, e.g. "status change" or "archive".
+func (a *App) recordUndo(what string, changes ...undoChange) {
+	if len(changes) == 0 {
+		return
+	}
+	entry := undoEntry{Label: undoLabel(what, changes), Changes: changes}
+	a.undoStack = append(a.undoStack, entry)
+	if len(a.undoStack) > undoLimit {
+		a.undoStack = a.undoStack[len(a.undoStack)-undoLimit:]
+	}
+	a.redoStack = nil
+	logger.Debug("tui.undo: recorded change label=%q issues=%d", entry.Label, len(changes))
+}
+
+// recordIssueUpdate records a completed update of issue for undo.
+func (a *App) recordIssueUpdate(what string, issue linearapi.Issue, input linearapi.UpdateIssueInput) {
+	a.recordUndo(what, updateChange(issue, input))
+}
+
+// applyUndoAction runs one mutation against Linear.
+func (a *App) applyUndoAction(ctx context.Context, issueID string, action undoAction) error {
+	switch {
+	case action.Archive:
+		return a.GetAPI().ArchiveIssue(ctx, issueID)
+	case action.Unarchive:
+		return a.GetAPI().UnarchiveIssue(ctx, issueID)
+	case action.Update != nil:
+		updateIssue := a.updateIssue
+		if updateIssue == nil {
+			updateIssue = a.GetAPI().UpdateIssue
+		}
+		_, err := updateIssue(ctx, *action.Update)
+		return err
+	}
+	return nil
+}
+
+// Undo reverts the last recorded change, refusing when any of its issues changed on the server since.
+func (a *App) Undo() {
+	a.replayHistory(true)
+}
+
+// Redo reapplies the last undone change, refusing when any of its issues changed on the server since.
+func (a *App) Redo() {
+	a.replayHistory(false)
+}
+
+// replayHistory pops the newest undo (or redo) entry, checks that its issues still look
+// the way the change left them, and applies the opposite mutations. On success the entry
+// moves to the other stack; an entry that conflicts with the server is dropped.
+func (a *App) replayHistory(undo bool) {
+	verb, progress, stack := "redo", "Redoing", &a.redoStack
+	if undo {
+		verb, progress, stack = "undo", "Undoing", &a.undoStack
+	}
+	if a.undoInProgress {
+		return
+	}
+	if len(*stack) == 0 {
+		a.statusBar.SetText(fmt.Sprintf("%sNothing to %s[-]", a.themeTags.SecondaryText, verb))
+		return
+	}
+	entry := (*stack)[len(*stack)-1]
+	*stack = (*stack)[:len(*stack)-1]
+	a.undoInProgress = true
+	a.statusBar.SetText(fmt.Sprintf("%s%s %s...[-]", a.themeTags.Accent, progress, entry.Label))
+
+	go func() {
+		ctx := context.Background()
+		err := a.replayEntry(ctx, entry, undo)
+		a.QueueUpdateDraw(func() {
+			a.undoInProgress = false
+			if err != nil {
+				logger.ErrorWithErr(err, "tui.undo: %s failed label=%q", verb, entry.Label)
+				a.updateStatusBarWithError(fmt.Errorf("cannot %s %s: %w", verb, entry.Label, err))
+				return
+			}
+			if undo {
+				a.redoStack = append(a.redoStack, entry)
+				a.statusBar.SetText(fmt.Sprintf("%sUndid %s; Ctrl-R redoes it[-]", a.themeTags.Accent, entry.Label))
+			} else {
+				a.undoStack = append(a.undoStack, entry)
+				a.statusBar.SetText(fmt.Sprintf("%sRedid %s[-]", a.themeTags.Accent, entry.Label))
+			}
+			logger.Info("tui.undo: %s succeeded label=%q", verb, entry.Label)
+			go a.refreshIssues()
+		})
+	}()
+}
+
+// replayEntry verifies every issue of entry against the server before applying any mutation.
+func (a *App) replayEntry(ctx context.Context, entry undoEntry, undo bool) error {
+	for _, change := range entry.Changes {
+		expected := change.Forward
+		if !undo {
+			expected = change.Inverse
+		}
+		current, err := a.fetchIssueByID(ctx, change.IssueID)
+		if err != nil {
+			return fmt.Errorf("fetch %s: %w", change.Identifier, err)
+		}
+		if !issueMatchesAction(current, expected) {
+			return fmt.Errorf("%s was changed since", change.Identifier)
+		}
+	}
+	for _, change := range entry.Changes {
+		action := change.Inverse
+		if !undo {
+			action = change.Forward
+		}
+		if err := a.applyUndoAction(ctx, change.IssueID, action); err != nil {
+			return fmt.Errorf("%s: %w", change.Identifier, err)
+		}
+	}
+	return nil
+}
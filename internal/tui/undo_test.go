@@ -0,0 +1,121 @@
+package tui
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/roeyazroel/linear-tui/internal/config"
+	"github.com/roeyazroel/linear-tui/internal/linearapi"
+)
+
+// TestInverseUpdate_RestoresChangedFields verifies only changed fields are restored, with clears for unset values.
+func TestInverseUpdate_RestoresChangedFields(t *testing.T) {
+	issue := linearapi.Issue{
+		ID:      "issue-1",
+		StateID: "state-todo",
+		Labels:  []linearapi.IssueLabel{{ID: "label-bug"}},
+		Parent:  &linearapi.IssueRef{ID: "issue-0"},
+	}
+	done, labels, estimate := "state-done", []string{"label-bug", "label-ui"}, 3
+	input := linearapi.UpdateIssueInput{ID: "issue-1", StateID: &done, LabelIDs: &labels, Estimate: &estimate}
+
+	inverse := inverseUpdate(issue, input)
+	if inverse.StateID == nil || *inverse.StateID != "state-todo" {
+		t.Fatalf("inverse state = %v, want state-todo", inverse.StateID)
+	}
+	if inverse.LabelIDs == nil || strings.Join(*inverse.LabelIDs, ",") != "label-bug" {
+		t.Fatalf("inverse labels = %v, want label-bug", inverse.LabelIDs)
+	}
+	if inverse.Estimate == nil || *inverse.Estimate != linearapi.NoEstimate {
+		t.Fatalf("inverse estimate = %v, want NoEstimate", inverse.Estimate)
+	}
+	if inverse.ParentID != nil || inverse.AssigneeID != nil || inverse.Title != nil {
+		t.Fatalf("inverse = %+v, want only changed fields", inverse)
+	}
+
+	issue.StateID, issue.Labels = done, []linearapi.IssueLabel{{ID: "label-ui"}, {ID: "label-bug"}}
+	issue.Estimate = &estimate
+	if !issueMatchesAction(issue, undoAction{Update: &input}) {
+		t.Fatal("issueMatchesAction() = false for an issue with the changed values")
+	}
+}
+
+// TestUndoRedo_RefusesWhenServerChanged verifies undo and redo apply inverse changes and
+// refuse when the issue changed on the server since.
+func TestUndoRedo_RefusesWhenServerChanged(t *testing.T) {
+	app := NewApp(&linearapi.Client{}, config.Config{PageSize: 50, CacheTTL: time.Minute}, nil)
+	app.queueUpdateDraw = func(f func()) { f() }
+	app.fetchIssuesPage = func(ctx context.Context, params linearapi.FetchIssuesParams, after *string) (linearapi.IssuePage, error) {
+		return linearapi.IssuePage{}, nil
+	}
+
+	var mu sync.Mutex
+	server := linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", StateID: "state-done"}
+	var updates []string
+	app.fetchIssueByID = func(ctx context.Context, id string) (linearapi.Issue, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return server, nil
+	}
+	app.updateIssue = func(ctx context.Context, input linearapi.UpdateIssueInput) (linearapi.Issue, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		server.StateID = *input.StateID
+		updates = append(updates, *input.StateID)
+		return server, nil
+	}
+	state := func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		return server.StateID
+	}
+
+	done := "state-done"
+	before := linearapi.Issue{ID: "issue-1", Identifier: "ABC-1", StateID: "state-todo"}
+	app.recordIssueUpdate("status change", before, linearapi.UpdateIssueInput{ID: "issue-1", StateID: &done})
+
+	// stacks returns the undo and redo history sizes once no undo or redo is running.
+	stacks := func() (int, int) {
+		waitForCondition(t, time.Second, func() bool {
+			app.uiUpdateMu.Lock()
+			defer app.uiUpdateMu.Unlock()
+			return !app.undoInProgress
+		})
+		app.uiUpdateMu.Lock()
+		defer app.uiUpdateMu.Unlock()
+		return len(app.undoStack), len(app.redoStack)
+	}
+
+	onUI(app, app.Undo)
+	if undos, redos := stacks(); undos != 0 || redos != 1 || state() != "state-todo" {
+		t.Fatalf("after undo: undo=%d redo=%d state=%s, want 0, 1 and state-todo", undos, redos, state())
+	}
+	// A successful undo refreshes the issue list
+	waitForRefresh(t, app, 1)
+
+	onUI(app, app.Redo)
+	if undos, redos := stacks(); undos != 1 || redos != 0 || state() != "state-done" {
+		t.Fatalf("after redo: undo=%d redo=%d state=%s, want 1, 0 and state-done", undos, redos, state())
+	}
+	waitForRefresh(t, app, 2)
+
+	// Someone else moves the issue on; undo must not overwrite their change
+	mu.Lock()
+	server.StateID = "state-review"
+	mu.Unlock()
+	onUI(app, app.Undo)
+	stacks()
+	onUI(app, func() {
+		if text := app.statusBar.GetText(true); !strings.Contains(text, "cannot undo status change on ABC-1: ABC-1 was changed since") {
+			t.Fatalf("status = %q", text)
+		}
+	})
+	mu.Lock()
+	defer mu.Unlock()
+	if strings.Join(updates, ",") != "state-todo,state-done" || server.StateID != "state-review" {
+		t.Fatalf("updates = %v, server state = %s", updates, server.StateID)
+	}
+}